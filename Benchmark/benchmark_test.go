@@ -1,9 +1,11 @@
 package benchmark
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"encoding/json"
 	"example/common"
+	"example/x/secondarykeys/types"
 	"fmt"
 	"log"
 	"net/http"
@@ -157,7 +159,16 @@ func CountTxsInHeightRange(startHeight, endHeight int64) (int, error) {
 			return 0, err
 		}
 
-		totalTxs += len(result.Result.Block.Data.Txs)
+		for _, tx := range result.Result.Block.Data.Txs {
+			bz, err := base64.StdEncoding.DecodeString(tx)
+			if err != nil {
+				return 0, err
+			}
+			// the signature tx injected by the proposer is not a benchmark tx
+			if !types.IsInjectedTx(bz) {
+				totalTxs++
+			}
+		}
 	}
 
 	return totalTxs, nil
@@ -5,31 +5,39 @@ import (
 	"errors"
 
 	"example/common"
+	voteextension "example/x/secondarykeys/VoteExtension"
 	"example/x/secondarykeys/keeper"
 	secondarykeys "example/x/secondarykeys/module"
+	"example/x/secondarykeys/types"
 	"fmt"
 	"strings"
 
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
-	"github.com/ethereum/go-ethereum/crypto"
-	EthereumK1 "github.com/ethereum/go-ethereum/crypto"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
 )
 
 type HandlerOptions struct {
 	ante.HandlerOptions
 }
 
-// SecondarySignatureVerificationDecorator verifies the secondary signature in the memo
+// SecondarySignatureVerificationDecorator verifies the secondary signatures in
+// the extension options or the memo
 type SecondarySignatureVerificationDecorator struct {
-	k keeper.Keeper
+	ak ante.AccountKeeper
+	k  keeper.Keeper
 }
 
 // NewSecondarySignatureVerificationDecorator creates a new decorator instance
-func NewSecondarySignatureVerificationDecorator(k keeper.Keeper) SecondarySignatureVerificationDecorator {
+func NewSecondarySignatureVerificationDecorator(ak ante.AccountKeeper, k keeper.Keeper) SecondarySignatureVerificationDecorator {
 	return SecondarySignatureVerificationDecorator{
-		k: k,
+		ak: ak,
+		k:  k,
 	}
 }
 
@@ -45,26 +53,151 @@ func NewAnteHandler(options ante.HandlerOptions, secondaryKeeper keeper.Keeper)
 		return nil, errors.New("sign mode handler is required for ante builder")
 	}
 
+	extensionOptionChecker := func(any *codectypes.Any) bool {
+		if SecondarySignatureExtensionChecker(any) {
+			return true
+		}
+		return options.ExtensionOptionChecker != nil && options.ExtensionOptionChecker(any)
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
+		InjectedTxDecorator{},           // the injected signature tx skips every other decorator
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
-		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
+		ante.NewExtensionOptionsDecorator(extensionOptionChecker),
 		ante.NewValidateBasicDecorator(),
+		BlockMaxGasDecorator{},
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxFeeChecker),
-		ante.NewSetPubKeyDecorator(options.AccountKeeper),
+		NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
 		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
 
-		NewSecondarySignatureVerificationDecorator(secondaryKeeper),
+		NewSecondarySignatureVerificationDecorator(options.AccountKeeper, secondaryKeeper),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 	}
 
 	return sdk.ChainAnteDecorators(anteDecorators...), nil
 }
 
+// InjectedTxDecorator lets the signature transaction injected by
+// PrepareProposal through in FinalizeBlock. It has no fee or signatures and
+// ProcessProposal has already validated it. Everywhere else it is rejected, so
+// it never enters the mempool.
+type InjectedTxDecorator struct{}
+
+// AnteHandle implements the ante handler interface
+func (InjectedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if _, ok := tx.(voteextension.InjectedTx); !ok {
+		return next(ctx, tx, simulate)
+	}
+	if simulate || ctx.ExecMode() != sdk.ExecModeFinalize {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "the injected signature transaction can only be included by the proposer")
+	}
+	return ctx.WithGasMeter(storetypes.NewGasMeter(types.InjectedTxGasLimit)), nil
+}
+
+// BlockMaxGasDecorator rejects consensus param updates, including those nested
+// in an authz.MsgExec or a proposal, whose block gas limit leaves no room for
+// the injected signature transaction. Every proposal must carry it once vote
+// extensions are enabled, so such a limit would halt the chain.
+type BlockMaxGasDecorator struct{}
+
+// AnteHandle implements the ante handler interface
+func (BlockMaxGasDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := validateBlockMaxGas(tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+func validateBlockMaxGas(msgs []sdk.Msg) error {
+	for _, msg := range msgs {
+		if msg, ok := msg.(*consensustypes.MsgUpdateParams); ok && msg.Block != nil {
+			if err := types.ValidateBlockMaxGas(msg.Block.MaxGas); err != nil {
+				return err
+			}
+		}
+		nested, err := types.NestedMsgs(msg)
+		if err != nil {
+			return err
+		}
+		if err := validateBlockMaxGas(nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetPubKeyDecorator wraps the SDK's SetPubKeyDecorator, which requires the
+// public key of every signer info to match the signer address. An account
+// recovered with MsgRecoverAccount signs with a new key that does not, so a
+// signer info whose public key is the one stored on the account is accepted
+// as well. SigVerificationDecorator then verifies the signature with it.
+type SetPubKeyDecorator struct {
+	ak    ante.AccountKeeper
+	inner ante.SetPubKeyDecorator
+}
+
+// NewSetPubKeyDecorator creates a new decorator instance
+func NewSetPubKeyDecorator(ak ante.AccountKeeper) SetPubKeyDecorator {
+	return SetPubKeyDecorator{
+		ak:    ak,
+		inner: ante.NewSetPubKeyDecorator(ak),
+	}
+}
+
+// AnteHandle implements the ante handler interface
+func (spkd SetPubKeyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	recovered, err := spkd.usesRecoveredPubKeys(ctx, tx)
+	if err != nil || !recovered {
+		return spkd.inner.AnteHandle(ctx, tx, simulate, next)
+	}
+	// The address check of the inner decorator only runs when signatures are
+	// verified. It is skipped for the inner decorator alone, the following
+	// decorators still verify them.
+	sigverify := ctx.IsSigverifyTx()
+	return spkd.inner.AnteHandle(ctx.WithIsSigverifyTx(false), tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return next(ctx.WithIsSigverifyTx(sigverify), tx, simulate)
+	})
+}
+
+// usesRecoveredPubKeys reports whether tx has a signer info whose public key
+// does not match its signer address, and every such public key is already
+// stored on the signer's account.
+func (spkd SetPubKeyDecorator) usesRecoveredPubKeys(ctx sdk.Context, tx sdk.Tx) (bool, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return false, sdkerrors.ErrTxDecode
+	}
+	pubKeys, err := sigTx.GetPubKeys()
+	if err != nil {
+		return false, err
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return false, err
+	}
+	if len(pubKeys) > len(signers) {
+		return false, sdkerrors.ErrInvalidPubKey
+	}
+
+	recovered := false
+	for i, pk := range pubKeys {
+		if pk == nil || bytes.Equal(pk.Address(), signers[i]) {
+			continue
+		}
+		acc := spkd.ak.GetAccount(ctx, signers[i])
+		if acc == nil || acc.GetPubKey() == nil || !acc.GetPubKey().Equals(pk) {
+			return false, nil
+		}
+		recovered = true
+	}
+	return recovered, nil
+}
+
 // AnteHandle implements the ante handler interface
 func (svd SecondarySignatureVerificationDecorator) AnteHandle(
 	ctx sdk.Context,
@@ -73,60 +206,314 @@ func (svd SecondarySignatureVerificationDecorator) AnteHandle(
 	next sdk.AnteHandler,
 ) (sdk.Context, error) {
 
-	memoTx, ok := tx.(sdk.TxWithMemo)
+	if err := svd.checkLockdown(ctx, tx); err != nil {
+		return ctx, err
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
 		return ctx, sdkerrors.ErrTxDecode
 	}
-	memo := memoTx.GetMemo()
-
-	if memo != "" {
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+	secondSigs, err := secondarySignatures(tx, signers)
+	if err != nil {
+		ctx.Logger().Info("AnteHandle called,decode err", "err", err)
+		return ctx, err
+	}
 
-		var foundPrefix bool
-		memo, foundPrefix = strings.CutPrefix(memo, secondarykeys.AnteHandlerPrefix)
+	// verified holds the signers whose secondary signatures have been
+	// verified. Each signer's signatures are verified against its own key set.
+	var verified []sdk.AccAddress
+	for i, signer := range signers {
+		if len(secondSigs[i]) == 0 {
+			continue
+		}
+		if err := svd.verifySecondarySignatures(ctx, tx, signer, secondSigs[i]); err != nil {
+			return ctx, err
+		}
+		verified = append(verified, signer)
+	}
 
-		// Check if the memo has the prefix.
-		if !foundPrefix {
-			ctx.Logger().Info("AnteHandle called,no prefix")
-			return next(ctx, tx, simulate)
+	// Like primary signatures, secondary signatures are not enforced when
+	// simulating, so clients can estimate gas before signing.
+	if !simulate {
+		if err := svd.checkRequiredSignatures(ctx, tx, signers, verified); err != nil {
+			return ctx, err
 		}
-		// Decode the secondarySignature and publicKey from memo
-		secondSig, err := common.DecodeSecondSigFromMemo([]byte(memo))
+	}
+	return next(ctx, tx, simulate)
+}
+
+// verifySecondarySignatures verifies secondSigs, the secondary signatures tx
+// carries for the signer addr, against the key set of addr, or charges tx to
+// the session key of addr that signed it.
+func (svd SecondarySignatureVerificationDecorator) verifySecondarySignatures(ctx sdk.Context, tx sdk.Tx, addr sdk.AccAddress, secondSigs []types.SecondaryKeySignature) error {
+	exists, err := svd.k.AnteHandlerMap.Has(ctx, addr)
+	if err != nil {
+		return errors.New(common.ErrInvalidSecondaryPublicKey)
+	}
+	if !exists {
+		revoked, err := svd.k.RevokedAccounts.Has(ctx, addr)
 		if err != nil {
-			ctx.Logger().Info("AnteHandle called,decode err", memo)
-			return ctx, sdkerrors.ErrInvalidRequest
+			return err
 		}
-		addr, err := common.GetAddr(tx)
-		if err != nil {
-			return ctx, sdkerrors.ErrLogic
+		if revoked {
+			return types.ErrSecondaryKeyRevoked
 		}
-		exists, err := svd.k.AnteHandlerMap.Has(ctx, addr)
+		return errorsmod.Wrapf(sdkerrors.ErrNotFound, "secondary key of signer %s", addr)
+	}
+	keySet, err := svd.k.GetSecondaryKeySet(ctx, addr)
+	if err != nil {
+		return err
+	}
+	sessionKey, err := svd.isSessionKeySignature(ctx, addr, keySet, secondSigs)
+	if err != nil {
+		return err
+	}
+	for _, secondSig := range secondSigs {
+		if _, ok := keySet.Member(secondSig.PublicKey); !ok && !sessionKey {
+			return errors.New(common.ErrInvalidSecondaryPublicKey)
+		}
+		// Validate the signature structure
+		if len(secondSig.Signature) == 0 {
+			ctx.Logger().Info("AnteHandle called, empty secondsig")
+			return sdkerrors.ErrInvalidRequest
+		}
+	}
+
+	// The secondary signatures commit to this very transaction, so they
+	// cannot be replayed on another tx, sequence or chain.
+	accNum, err := svd.accountNumber(ctx, addr)
+	if err != nil {
+		return err
+	}
+	hsh, err := types.SecondarySignBytesFromTx(tx, ctx.ChainID(), accNum)
+	if err != nil {
+		return err
+	}
+
+	if sessionKey {
+		// A session key signs alone, for the msgs in its scope and
+		// within what is left of its spend limit, which the coins tx
+		// spends are charged to.
+		if err := svd.k.UseSessionKey(ctx, addr, tx, hsh, secondSigs[0]); err != nil {
+			ctx.Logger().Info("AnteHandle called,session key refused")
+			return err
+		}
+	} else if err := svd.k.VerifyKeySetSignatures(ctx, keySet, hsh, secondSigs); err != nil {
+		// Verify each signature with the algorithm of its key, and that
+		// the signing members reach the threshold of the key set
+		ctx.Logger().Info("AnteHandle called,invalid signature")
+		return fmt.Errorf("signature verification failed: %w", err)
+	}
+	ctx.Logger().Info("AnteHandle called,tx valid")
+	return nil
+}
+
+// checkRequiredSignatures rejects tx when one of its signers needs a secondary
+// signature but is not among the verified signers. Cancelling a recovery never
+// needs one: it is how the primary key stops a recovery started with stolen
+// secondary keys. Msgs executed through authz on behalf of an account with a
+// registered key set cannot weaken its protection, since it does not sign tx.
+func (svd SecondarySignatureVerificationDecorator) checkRequiredSignatures(ctx sdk.Context, tx sdk.Tx, signers [][]byte, verified []sdk.AccAddress) error {
+	if onlyCancelsRecoveries(tx) {
+		return nil
+	}
+
+	for _, signer := range signers {
+		if containsAddress(verified, signer) {
+			continue
+		}
+		required, err := svd.k.SecondarySignatureRequired(ctx, signer, tx.GetMsgs())
 		if err != nil {
-			return ctx, errors.New(common.ErrInvalidSecondaryPublicKey)
+			return err
 		}
-		if !exists {
-			return ctx, sdkerrors.ErrNotFound
+		if required {
+			return errorsmod.Wrapf(types.ErrSecondarySignatureRequired, "signer %s", sdk.AccAddress(signer))
+		}
+	}
+
+	weakened, err := svd.k.WeakenedAccounts(tx.GetMsgs())
+	if err != nil {
+		return err
+	}
+	for _, addr := range weakened {
+		if containsAddress(signers, addr) {
+			continue
 		}
-		mappedVal, err := svd.k.GetSecondaryPubKeyAnteHandler(ctx, addr)
+		registered, err := svd.k.AnteHandlerMap.Has(ctx, addr)
 		if err != nil {
-			return ctx, err
+			return err
 		}
-		if !bytes.Equal(mappedVal, secondSig.PublicKey) {
-			return ctx, errors.New(common.ErrInvalidSecondaryPublicKey)
+		if registered {
+			return errorsmod.Wrapf(types.ErrSecondarySignatureRequired, "account %s does not sign the transaction", addr)
 		}
-		// Validate the signature structure
-		if err := secondSig.Validate(); err != nil {
-			ctx.Logger().Info("AnteHandle called, empty secondsig")
-			return ctx, sdkerrors.ErrInvalidRequest
+	}
+	return nil
+}
+
+// containsAddress reports whether addrs contains addr.
+func containsAddress[T ~[]byte](addrs []T, addr []byte) bool {
+	for _, a := range addrs {
+		if bytes.Equal(a, addr) {
+			return true
 		}
+	}
+	return false
+}
+
+// isSessionKeySignature reports whether secondSigs is the single signature of
+// a session key of addr rather than signatures of members of its key set.
+func (svd SecondarySignatureVerificationDecorator) isSessionKeySignature(ctx sdk.Context, addr sdk.AccAddress, keySet types.SecondaryKeySet, secondSigs []types.SecondaryKeySignature) (bool, error) {
+	if len(secondSigs) != 1 {
+		return false, nil
+	}
+	if _, ok := keySet.Member(secondSigs[0].PublicKey); ok {
+		return false, nil
+	}
+	return svd.k.IsSessionKey(ctx, addr, secondSigs[0].PublicKey)
+}
 
-		hsh := crypto.Keccak256([]byte(secondSig.PublicKey))
+// secondarySignatures extracts the secondary signatures of tx for each of its
+// signers, one for each signing member of the signer's key set. They are read
+// from the SecondarySignatureExtensions of tx, which default to the first
+// signer, falling back to the legacy memo encoding of a single signature of
+// the first signer.
+func secondarySignatures(tx sdk.Tx, signers [][]byte) ([][]types.SecondaryKeySignature, error) {
+	sigs := make([][]types.SecondaryKeySignature, len(signers))
+	if len(signers) == 0 {
+		return sigs, nil
+	}
 
-		// Verify the signature
-		if !EthereumK1.VerifySignature(secondSig.PublicKey, hsh, secondSig.Signature) {
-			ctx.Logger().Info("AnteHandle called,invalid signature")
-			return ctx, fmt.Errorf("signature verification failed")
+	found := false
+	if extTx, ok := tx.(ante.HasExtensionOptionsTx); ok {
+		for _, opt := range extTx.GetExtensionOptions() {
+			if !types.IsSecondarySignatureExtension(opt) {
+				continue
+			}
+			var ext types.SecondarySignatureExtension
+			if err := ext.Unmarshal(opt.Value); err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+			}
+			i, err := signerIndex(signers, ext.Signer)
+			if err != nil {
+				return nil, err
+			}
+			for _, sig := range sigs[i] {
+				if bytes.Equal(sig.PublicKey, ext.PublicKey) {
+					return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "duplicate secondary signature")
+				}
+			}
+			sigs[i] = append(sigs[i], types.SecondaryKeySignature{PublicKey: ext.PublicKey, Signature: ext.Signature})
+			found = true
+		}
+		if found {
+			return sigs, nil
 		}
-		ctx.Logger().Info("AnteHandle called,tx valid")
 	}
-	return next(ctx, tx, simulate)
+
+	memoTx, ok := tx.(sdk.TxWithMemo)
+	if !ok {
+		return nil, sdkerrors.ErrTxDecode
+	}
+	memo, foundPrefix := strings.CutPrefix(memoTx.GetMemo(), secondarykeys.AnteHandlerPrefix)
+	if !foundPrefix {
+		return sigs, nil
+	}
+	// Decode the secondarySignature and publicKey from memo
+	secondSig, err := common.DecodeSecondSigFromMemo([]byte(memo))
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+	sigs[0] = []types.SecondaryKeySignature{{PublicKey: secondSig.PublicKey, Signature: secondSig.Signature}}
+	return sigs, nil
+}
+
+// signerIndex returns the index in signers of the signer a
+// SecondarySignatureExtension is for, the first one when signer is empty.
+func signerIndex(signers [][]byte, signer string) (int, error) {
+	if signer == "" {
+		return 0, nil
+	}
+	addr, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid secondary signature signer: %s", err)
+	}
+	for i, s := range signers {
+		if addr.Equals(sdk.AccAddress(s)) {
+			return i, nil
+		}
+	}
+	return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "secondary signature for %s, which does not sign the transaction", signer)
+}
+
+// SecondarySignatureExtensionChecker accepts the SecondarySignatureExtension
+// as a tx extension option.
+func SecondarySignatureExtensionChecker(any *codectypes.Any) bool {
+	return types.IsSecondarySignatureExtension(any)
+}
+
+// accountNumber returns the account number the secondary signature of addr
+// commits to. Like the primary signature, it is zero in genesis transactions.
+func (svd SecondarySignatureVerificationDecorator) accountNumber(ctx sdk.Context, addr sdk.AccAddress) (uint64, error) {
+	if ctx.BlockHeight() == 0 {
+		return 0, nil
+	}
+	acc := svd.ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+	return acc.GetAccountNumber(), nil
+}
+
+// checkLockdown rejects transactions signed by a locked account unless they
+// only register a new secondary key, which lifts the lockdown.
+func (svd SecondarySignatureVerificationDecorator) checkLockdown(ctx sdk.Context, tx sdk.Tx) error {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return sdkerrors.ErrTxDecode
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return err
+	}
+
+	for _, signer := range signers {
+		locked, err := svd.k.LockedAccounts.Has(ctx, signer)
+		if err != nil {
+			return err
+		}
+		if locked && !onlyRegistersSecondaryKeys(tx) {
+			return errorsmod.Wrapf(types.ErrAccountLocked, "account %s", sdk.AccAddress(signer))
+		}
+	}
+	return nil
+}
+
+// onlyRegistersSecondaryKeys reports whether every message of tx registers a
+// secondary key.
+func onlyRegistersSecondaryKeys(tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		switch msg.(type) {
+		case *types.MsgRegisterSecondaryKey, *types.MsgBroadcastData:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// onlyCancelsRecoveries reports whether every message of tx cancels an account
+// recovery.
+func onlyCancelsRecoveries(tx sdk.Tx) bool {
+	msgs := tx.GetMsgs()
+	for _, msg := range msgs {
+		if _, ok := msg.(*types.MsgCancelRecovery); !ok {
+			return false
+		}
+	}
+	return len(msgs) > 0
 }
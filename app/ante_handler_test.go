@@ -1,24 +1,33 @@
 package app_test
 
 import (
+	"crypto/ecdsa"
 	"example/app"
 	"example/common"
+	"strings"
 	"testing"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
 	dbm "github.com/cosmos/cosmos-db"
-	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	CosmosK1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/testutil/sims"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
-	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	EthereumK1 "github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
 
 	"math/rand"
 	"time"
@@ -26,6 +35,8 @@ import (
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cosmos/cosmos-sdk/client"
 
+	"example/testutil/sample"
+	voteextension "example/x/secondarykeys/VoteExtension"
 	"example/x/secondarykeys/keeper"
 	"example/x/secondarykeys/simulation"
 	"example/x/secondarykeys/types"
@@ -67,7 +78,11 @@ func TestAnteHandler(t *testing.T) {
 	}
 
 	var txGen client.TxConfig = myApp.TxConfig()
-	memo, err := common.CreateValidMemo()
+	secondaryPriv, err := EthereumK1.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	memo, err := common.CreateRegistrationMemo(secondaryPriv)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -97,20 +112,16 @@ func TestAnteHandler(t *testing.T) {
 		Sender: addr.String(),
 		Data:   memo,
 	})
-	interfaceRegistry := codectypes.NewInterfaceRegistry()
-	marshaler := codec.NewProtoCodec(interfaceRegistry)
-	txConfig := tx.NewTxConfig(marshaler, tx.DefaultSignModes)
-
+	acc := ak.NewAccountWithAddress(ctx, addr)
+	ak.SetAccount(ctx, acc)
 	// Build transaction
-	txBuilder := txConfig.NewTxBuilder()
+	txBuilder := txGen.NewTxBuilder()
 	msg := testdata.NewTestMsg(addr)
 	err = txBuilder.SetMsgs(msg)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	txBuilder.SetMemo(memo)
-
 	// Sign the transaction
 	sigV2 := signing.SignatureV2{
 		PubKey: &CosmosK1.PubKey{
@@ -128,16 +139,23 @@ func TestAnteHandler(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	// The secondary signature covers the signer infos, so it is added
+	// after they are set and before the primary signature.
+	err = common.SignSecondaryTx(common.NewSecp256k1PrivKey(secondaryPriv), txBuilder, ChainID, acc.GetAccountNumber())
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	// Generate sign bytes
 	signerData := authsigning.SignerData{
 		ChainID:       ChainID,
-		AccountNumber: 0,
+		AccountNumber: acc.GetAccountNumber(),
 		Sequence:      0,
 	}
 
 	signBytes, err := authsigning.GetSignBytesAdapter(
 		ctx,
-		txConfig.SignModeHandler(),
+		txGen.SignModeHandler(),
 		signing.SignMode_SIGN_MODE_DIRECT,
 		signerData,
 		txBuilder.GetTx(),
@@ -172,14 +190,847 @@ func TestAnteHandler(t *testing.T) {
 
 	// Test with your custom decorator only
 	myCustomAnteHandler := sdk.ChainAnteDecorators(
-		app.NewSecondarySignatureVerificationDecorator(k),
+		app.NewSecondarySignatureVerificationDecorator(ak, k),
 	)
 
 	_, err = myCustomAnteHandler(ctx, signedTx, false)
-	if err != nil {
-		t.Logf("Custom ante handler returned error: %v", err)
-		t.Logf("This might be expected if your decorator needs keepers or store access")
-	} else {
-		t.Log("Custom ante handler test passed!")
+	require.NoError(t, err)
+}
+
+func TestAnteHandlerRevokedAccount(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	k := myApp.SecondarykeysKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+
+	addr, secondaryPriv, buildTx := setupSecondaryKeyAccount(t, myApp, ctx)
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	anteHandler := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, k))
+
+	_, err := anteHandler(ctx, buildTx(secondaryPriv, 0, send), false)
+	require.NoError(t, err)
+
+	_, err = msgServer.RevokeSecondaryKey(ctx, &types.MsgRevokeSecondaryKey{Sender: addr.String()})
+	require.NoError(t, err)
+
+	// the revoked key no longer authorises anything
+	_, err = anteHandler(ctx, buildTx(secondaryPriv, 0, send), false)
+	require.ErrorIs(t, err, types.ErrSecondaryKeyRevoked)
+
+	// without lockdown a tx without a secondary signature still goes through
+	_, err = anteHandler(ctx, buildTx(nil, 0, send), false)
+	require.NoError(t, err)
+}
+
+func TestAnteHandlerSecondarySignatureReplay(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+
+	addr, secondaryPriv, buildTx := setupSecondaryKeyAccount(t, myApp, ctx)
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	otherSend := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)))
+
+	anteHandler := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, myApp.SecondarykeysKeeper))
+
+	signed := buildTx(secondaryPriv, 0, send)
+	_, err := anteHandler(ctx, signed, false)
+	require.NoError(t, err)
+
+	// a legacy memo signing only the public key is rejected
+	legacyMemo, err := common.CreateRegistrationMemo(secondaryPriv)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		tx   sdk.Tx
+		ctx  sdk.Context
+	}{
+		{
+			name: "legacy public key signature",
+			tx:   withMemo(t, buildTx(nil, 0, send), myApp, legacyMemo),
+			ctx:  ctx,
+		},
+		{
+			name: "different messages",
+			tx:   withExtensionOptions(t, buildTx(nil, 0, otherSend), myApp, signed),
+			ctx:  ctx,
+		},
+		{
+			name: "different sequence",
+			tx:   withExtensionOptions(t, buildTx(nil, 1, send), myApp, signed),
+			ctx:  ctx,
+		},
+		{
+			name: "different chain",
+			tx:   signed,
+			ctx:  ctx.WithChainID("other-chain"),
+		},
+		{
+			name: "different chain legacy memo",
+			tx:   signLegacyMemo(t, ctx, buildTx(nil, 0, send), myApp, secondaryPriv),
+			ctx:  ctx.WithChainID("other-chain"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := anteHandler(tc.ctx, tc.tx, false)
+			require.ErrorContains(t, err, "signature verification failed")
+		})
+	}
+}
+
+// setupSecondaryKeyAccount creates an account with a registered secondary key
+// and returns a builder for txs signed by it. A nil secondary key leaves the tx
+// without a secondary signature.
+func setupSecondaryKeyAccount(t *testing.T, myApp *app.App, ctx sdk.Context) (sdk.AccAddress, *ecdsa.PrivateKey, func(*ecdsa.PrivateKey, uint64, ...sdk.Msg) sdk.Tx) {
+	t.Helper()
+
+	secondaryPriv, err := EthereumK1.GenerateKey()
+	require.NoError(t, err)
+	addr, buildTx := setupAccountWithSecondaryKey(t, myApp, ctx, common.NewSecp256k1PrivKey(secondaryPriv))
+	return addr, secondaryPriv, func(priv *ecdsa.PrivateKey, sequence uint64, msgs ...sdk.Msg) sdk.Tx {
+		if priv == nil {
+			return buildTx(nil, sequence, msgs...)
+		}
+		return buildTx(common.NewSecp256k1PrivKey(priv), sequence, msgs...)
+	}
+}
+
+// setupAccountWithSecondaryKey is setupSecondaryKeyAccount for a secondary key
+// of any type.
+func setupAccountWithSecondaryKey(t *testing.T, myApp *app.App, ctx sdk.Context, secondaryPriv common.SecondaryPrivKey) (sdk.AccAddress, func(common.SecondaryPrivKey, uint64, ...sdk.Msg) sdk.Tx) {
+	t.Helper()
+
+	priv := secp256k1.GenPrivKey()
+	pub := &CosmosK1.PubKey{Key: priv.PubKey().Bytes()}
+	addr := sdk.AccAddress(pub.Address())
+	acc := myApp.AuthKeeper.NewAccountWithAddress(ctx, addr)
+	myApp.AuthKeeper.SetAccount(ctx, acc)
+
+	pubKey, pop, err := common.SignProofOfPossession(secondaryPriv, addr)
+	require.NoError(t, err)
+	_, err = keeper.NewMsgServerImpl(myApp.SecondarykeysKeeper).RegisterSecondaryKey(ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    addr.String(),
+		KeyType:   secondaryPriv.KeyType(),
+		PublicKey: pubKey,
+		Signature: pop,
+	})
+	require.NoError(t, err)
+
+	buildTx := func(secondaryPriv common.SecondaryPrivKey, sequence uint64, msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := myApp.TxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+			PubKey:   pub,
+			Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+			Sequence: sequence,
+		}))
+		if secondaryPriv != nil {
+			require.NoError(t, common.SignSecondaryTx(secondaryPriv, txBuilder, ctx.ChainID(), acc.GetAccountNumber()))
+		}
+		return txBuilder.GetTx()
+	}
+	return addr, buildTx
+}
+
+// withMemo returns a copy of tx carrying memo.
+func withMemo(t *testing.T, tx sdk.Tx, myApp *app.App, memo string) sdk.Tx {
+	t.Helper()
+
+	txBuilder, err := myApp.TxConfig().WrapTxBuilder(tx)
+	require.NoError(t, err)
+	txBuilder.SetMemo(memo)
+	return txBuilder.GetTx()
+}
+
+// withExtensionOptions returns a copy of tx carrying the extension options of
+// from.
+func withExtensionOptions(t *testing.T, tx sdk.Tx, myApp *app.App, from sdk.Tx) sdk.Tx {
+	t.Helper()
+
+	txBuilder, err := myApp.TxConfig().WrapTxBuilder(tx)
+	require.NoError(t, err)
+	txBuilder.(client.ExtendedTxBuilder).SetExtensionOptions(from.(ante.HasExtensionOptionsTx).GetExtensionOptions()...)
+	return txBuilder.GetTx()
+}
+
+// signLegacyMemo returns a copy of tx carrying a memo encoded secondary
+// signature by secondaryPriv.
+func signLegacyMemo(t *testing.T, ctx sdk.Context, tx sdk.Tx, myApp *app.App, secondaryPriv *ecdsa.PrivateKey) sdk.Tx {
+	t.Helper()
+
+	txBuilder, err := myApp.TxConfig().WrapTxBuilder(tx)
+	require.NoError(t, err)
+	accNum := myApp.AuthKeeper.GetAccount(ctx, tx.(sdk.FeeTx).FeePayer()).GetAccountNumber()
+	require.NoError(t, common.SignSecondaryTxMemo(common.NewSecp256k1PrivKey(secondaryPriv), txBuilder, ctx.ChainID(), accNum))
+	return txBuilder.GetTx()
+}
+
+func TestAnteHandlerSecondarySignatureExtension(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+
+	addr, secondaryPriv, buildTx := setupSecondaryKeyAccount(t, myApp, ctx)
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	otherPriv, err := EthereumK1.GenerateKey()
+	require.NoError(t, err)
+
+	signed := buildTx(secondaryPriv, 0, send)
+	duplicated := withExtensionOptions(t, buildTx(nil, 0, send), myApp, signed)
+	txBuilder, err := myApp.TxConfig().WrapTxBuilder(duplicated)
+	require.NoError(t, err)
+	opts := signed.(ante.HasExtensionOptionsTx).GetExtensionOptions()
+	txBuilder.(client.ExtendedTxBuilder).SetExtensionOptions(append(opts, opts...)...)
+	duplicated = txBuilder.GetTx()
+
+	anteHandler := sdk.ChainAnteDecorators(
+		ante.NewExtensionOptionsDecorator(app.SecondarySignatureExtensionChecker),
+		app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, myApp.SecondarykeysKeeper),
+	)
+
+	testCases := []struct {
+		name      string
+		tx        sdk.Tx
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "extension option",
+			tx:   signed,
+		},
+		{
+			name: "legacy memo",
+			tx:   signLegacyMemo(t, ctx, buildTx(nil, 0, send), myApp, secondaryPriv),
+		},
+		{
+			name:      "unregistered key",
+			tx:        buildTx(otherPriv, 0, send),
+			expErr:    true,
+			expErrMsg: common.ErrInvalidSecondaryPublicKey,
+		},
+		{
+			name:      "duplicate secondary signatures",
+			tx:        duplicated,
+			expErr:    true,
+			expErrMsg: "duplicate secondary signature",
+		},
 	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := anteHandler(ctx, tc.tx, false)
+			if tc.expErr {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	// the SDK rejects every extension option unless the checker accepts it
+	_, err = sdk.ChainAnteDecorators(ante.NewExtensionOptionsDecorator(nil))(ctx, signed, false)
+	require.Error(t, err)
+}
+
+func TestAnteHandlerSecondaryKeyTypes(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+	anteHandler := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, myApp.SecondarykeysKeeper))
+
+	for _, keyType := range []types.KeyType{
+		types.KeyType_KEY_TYPE_SECP256K1,
+		types.KeyType_KEY_TYPE_ED25519,
+		types.KeyType_KEY_TYPE_SECP256R1,
+		types.KeyType_KEY_TYPE_SCHNORR,
+	} {
+		t.Run(keyType.String(), func(t *testing.T) {
+			secondaryPriv, err := common.GenSecondaryPrivKey(keyType)
+			require.NoError(t, err)
+			addr, buildTx := setupAccountWithSecondaryKey(t, myApp, ctx, secondaryPriv)
+			send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+			_, err = anteHandler(ctx, buildTx(secondaryPriv, 0, send), false)
+			require.NoError(t, err)
+
+			// the signature is verified with the algorithm of the registered
+			// key, over this very transaction
+			signed := buildTx(secondaryPriv, 0, send)
+			_, err = anteHandler(ctx, withExtensionOptions(t, buildTx(nil, 1, send), myApp, signed), false)
+			require.ErrorContains(t, err, "signature verification failed")
+		})
+	}
+}
+
+func TestAnteHandlerWebAuthn(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	k := myApp.SecondarykeysKeeper
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+	anteHandler := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, k))
+
+	params := types.DefaultParams()
+	params.WebauthnRpIds = []string{"wallet.example.com"}
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	seed := EthereumK1.Keccak256([]byte("passkey"))
+	passkey, err := common.WebAuthnPrivKeyFromSeed(seed, "wallet.example.com", "https://wallet.example.com")
+	require.NoError(t, err)
+	addr, buildTx := setupAccountWithSecondaryKey(t, myApp, ctx, passkey)
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	_, err = anteHandler(ctx, buildTx(passkey, 0, send), false)
+	require.NoError(t, err)
+
+	// the challenge of the assertion is the sign bytes of this very tx
+	signed := buildTx(passkey, 0, send)
+	_, err = anteHandler(ctx, withExtensionOptions(t, buildTx(nil, 1, send), myApp, signed), false)
+	require.ErrorContains(t, err, "challenge does not match")
+
+	// an assertion for another site is rejected, even with the same key
+	phished, err := common.WebAuthnPrivKeyFromSeed(seed, "wallet.example.org", "https://wallet.example.org")
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, buildTx(phished, 0, send), false)
+	require.ErrorContains(t, err, "relying party ID not allowed")
+
+	// governance removing the relying party ID disables the passkey
+	params.WebauthnRpIds = nil
+	require.NoError(t, k.Params.Set(ctx, params))
+	_, err = anteHandler(ctx, buildTx(passkey, 0, send), false)
+	require.ErrorContains(t, err, "signature verification failed")
+}
+
+func TestAnteHandlerThresholdKeySet(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	k := myApp.SecondarykeysKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+	anteHandler := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, k))
+
+	privs := make([]common.SecondaryPrivKey, 4)
+	for i, keyType := range []types.KeyType{
+		types.KeyType_KEY_TYPE_SECP256K1,
+		types.KeyType_KEY_TYPE_ED25519,
+		types.KeyType_KEY_TYPE_SECP256R1,
+		types.KeyType_KEY_TYPE_ED25519,
+	} {
+		var err error
+		privs[i], err = common.GenSecondaryPrivKey(keyType)
+		require.NoError(t, err)
+	}
+	a, b, c, outsider := privs[0], privs[1], privs[2], privs[3]
+	addr, buildTx := setupAccountWithSecondaryKey(t, myApp, ctx, a)
+
+	// grow the key set to 1 of 2, then to 2 of 3, each change signed by a
+	// alone while the threshold is 1
+	for i, priv := range []common.SecondaryPrivKey{b, c} {
+		threshold := uint32(i + 1)
+		sequence, err := k.GetRotationSequence(ctx, addr)
+		require.NoError(t, err)
+		hash := types.AddKeySetMemberBytes(addr, sequence, priv.KeyType(), priv.PubKey(), 1, threshold)
+		newKeySig, err := priv.Sign(hash)
+		require.NoError(t, err)
+		aSig, err := a.Sign(hash)
+		require.NoError(t, err)
+		_, err = msgServer.AddSecondaryKeySetMember(ctx, &types.MsgAddSecondaryKeySetMember{
+			Sender:          addr.String(),
+			KeyType:         priv.KeyType(),
+			PublicKey:       priv.PubKey(),
+			Weight:          1,
+			Threshold:       threshold,
+			NewKeySignature: newKeySig,
+			Signatures:      []types.SecondaryKeySignature{{PublicKey: a.PubKey(), Signature: aSig}},
+		})
+		require.NoError(t, err)
+	}
+
+	accNum := myApp.AuthKeeper.GetAccount(ctx, addr).GetAccountNumber()
+	signAlso := func(tx sdk.Tx, priv common.SecondaryPrivKey) sdk.Tx {
+		txBuilder, err := myApp.TxConfig().WrapTxBuilder(tx)
+		require.NoError(t, err)
+		require.NoError(t, common.SignSecondaryTx(priv, txBuilder, ctx.ChainID(), accNum))
+		return txBuilder.GetTx()
+	}
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	_, err := anteHandler(ctx, buildTx(a, 0, send), false)
+	require.ErrorContains(t, err, "below the threshold")
+
+	_, err = anteHandler(ctx, signAlso(buildTx(a, 0, send), c), false)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, signAlso(buildTx(c, 0, send), b), false)
+	require.NoError(t, err)
+
+	// signing twice with the same key replaces the first signature
+	_, err = anteHandler(ctx, signAlso(buildTx(a, 0, send), a), false)
+	require.ErrorContains(t, err, "below the threshold")
+
+	_, err = anteHandler(ctx, signAlso(buildTx(a, 0, send), outsider), false)
+	require.ErrorContains(t, err, common.ErrInvalidSecondaryPublicKey)
+}
+
+func TestAnteHandlerSecondarySignaturePolicy(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	k := myApp.SecondarykeysKeeper
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+
+	params := types.DefaultParams()
+	params.RequiredMsgTypeUrls = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	addr, secondaryPriv, buildTx := setupSecondaryKeyAccount(t, myApp, ctx)
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	setRequired := &types.MsgSetSecondarySignatureRequired{Sender: addr.String(), Required: true}
+
+	anteHandler := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, k))
+
+	// a listed msg needs the secondary signature, whether or not it is omitted
+	_, err := anteHandler(ctx, buildTx(nil, 0, send), false)
+	require.ErrorIs(t, err, types.ErrSecondarySignatureRequired)
+	_, err = anteHandler(ctx, buildTx(secondaryPriv, 0, send), false)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, buildTx(nil, 0, send), true)
+	require.NoError(t, err)
+
+	// other msgs only need it once the account opts in
+	_, err = anteHandler(ctx, buildTx(nil, 0, setRequired), false)
+	require.NoError(t, err)
+	_, err = keeper.NewMsgServerImpl(k).SetSecondarySignatureRequired(ctx, setRequired)
+	require.NoError(t, err)
+
+	_, err = anteHandler(ctx, buildTx(nil, 0, setRequired), false)
+	require.ErrorIs(t, err, types.ErrSecondarySignatureRequired)
+	_, err = anteHandler(ctx, buildTx(secondaryPriv, 0, setRequired), false)
+	require.NoError(t, err)
+}
+
+func TestAnteHandlerWeakeningMsgs(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	k := myApp.SecondarykeysKeeper
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+
+	params := types.DefaultParams()
+	params.RequiredMsgTypeUrls = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	addr, secondaryPriv, buildTx := setupSecondaryKeyAccount(t, myApp, ctx)
+	grantee, _, buildGranteeTx := setupSecondaryKeyAccount(t, myApp, ctx)
+
+	anteHandler := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, k))
+
+	// none of these msgs is listed, yet the primary key alone cannot send
+	// them while a key set is registered, whatever the case of the sender
+	upper := strings.ToUpper(addr.String())
+	for _, msg := range []sdk.Msg{
+		&types.MsgRevokeSecondaryKey{Sender: addr.String()},
+		&types.MsgSetSecondarySignatureRequired{Sender: addr.String(), Required: false},
+		&types.MsgRegisterSessionKey{Sender: addr.String()},
+		&types.MsgRevokeSecondaryKey{Sender: upper},
+		&types.MsgSetSecondarySignatureRequired{Sender: upper, Required: false},
+		&types.MsgRegisterSessionKey{Sender: upper},
+	} {
+		_, err := anteHandler(ctx, buildTx(nil, 0, msg), false)
+		require.ErrorIs(t, err, types.ErrSecondarySignatureRequired)
+		_, err = anteHandler(ctx, buildTx(secondaryPriv, 0, msg), false)
+		require.NoError(t, err)
+
+		// nor can another account execute them on its behalf through authz
+		exec := authz.NewMsgExec(grantee, []sdk.Msg{msg})
+		_, err = anteHandler(ctx, buildGranteeTx(nil, 0, &exec), false)
+		require.ErrorIs(t, err, types.ErrSecondarySignatureRequired)
+	}
+}
+
+func TestAnteHandlerMultipleSigners(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	k := myApp.SecondarykeysKeeper
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+
+	params := types.DefaultParams()
+	params.RequiredMsgTypeUrls = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	a, aPriv, buildTx := setupSecondaryKeyAccount(t, myApp, ctx)
+	b, bPriv, _ := setupSecondaryKeyAccount(t, myApp, ctx)
+	c, _, _ := setupSecondaryKeyAccount(t, myApp, ctx)
+	bAccNum := myApp.AuthKeeper.GetAccount(ctx, b).GetAccountNumber()
+
+	// signFor adds the signature of priv for signer to tx.
+	signFor := func(tx sdk.Tx, priv *ecdsa.PrivateKey, signer sdk.AccAddress, accNum uint64) sdk.Tx {
+		txBuilder, err := myApp.TxConfig().WrapTxBuilder(tx)
+		require.NoError(t, err)
+		require.NoError(t, common.SignSecondaryTxFor(common.NewSecp256k1PrivKey(priv), signer, txBuilder, ctx.ChainID(), accNum))
+		return txBuilder.GetTx()
+	}
+	sendA := banktypes.NewMsgSend(a, b, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	sendB := banktypes.NewMsgSend(b, a, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	anteHandler := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, k))
+
+	// each signer needs the signature of its own key set
+	_, err := anteHandler(ctx, signFor(buildTx(aPriv, 0, sendA, sendB), bPriv, b, bAccNum), false)
+	require.NoError(t, err)
+	_, err = anteHandler(ctx, buildTx(aPriv, 0, sendA, sendB), false)
+	require.ErrorIs(t, err, types.ErrSecondarySignatureRequired)
+	_, err = anteHandler(ctx, signFor(buildTx(nil, 0, sendA, sendB), bPriv, b, bAccNum), false)
+	require.ErrorIs(t, err, types.ErrSecondarySignatureRequired)
+
+	// a signature of one signer's key does not count for another signer
+	_, err = anteHandler(ctx, signFor(buildTx(aPriv, 0, sendA, sendB), aPriv, b, bAccNum), false)
+	require.ErrorContains(t, err, common.ErrInvalidSecondaryPublicKey)
+
+	// and each signature commits to the account number of its signer
+	_, err = anteHandler(ctx, signFor(buildTx(aPriv, 0, sendA, sendB), bPriv, b, bAccNum+1), false)
+	require.ErrorContains(t, err, "signature verification failed")
+
+	// signatures for an account that does not sign the tx are rejected
+	_, err = anteHandler(ctx, signFor(buildTx(aPriv, 0, sendA), bPriv, c, bAccNum), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
+
+func TestAnteHandlerLockedAccount(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	k := myApp.SecondarykeysKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+
+	addr, _, buildTx := setupSecondaryKeyAccount(t, myApp, ctx)
+	_, err := msgServer.RevokeSecondaryKey(ctx, &types.MsgRevokeSecondaryKey{Sender: addr.String(), Lockdown: true})
+	require.NoError(t, err)
+
+	secondaryPriv, err := EthereumK1.GenerateKey()
+	require.NoError(t, err)
+	pubKey, pop, err := common.SignProofOfPossession(common.NewSecp256k1PrivKey(secondaryPriv), addr)
+	require.NoError(t, err)
+	register := &types.MsgRegisterSecondaryKey{
+		Sender:    addr.String(),
+		KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+		PublicKey: pubKey,
+		Signature: pop,
+	}
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	anteHandler := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, k))
+
+	_, err = anteHandler(ctx, buildTx(nil, 0, send), false)
+	require.ErrorIs(t, err, types.ErrAccountLocked)
+
+	_, err = anteHandler(ctx, buildTx(nil, 0, register, send), false)
+	require.ErrorIs(t, err, types.ErrAccountLocked)
+
+	// registering a new key is the only way out of the lockdown
+	_, err = anteHandler(ctx, buildTx(nil, 0, register), false)
+	require.NoError(t, err)
+	_, err = msgServer.RegisterSecondaryKey(ctx, register)
+	require.NoError(t, err)
+
+	_, err = anteHandler(ctx, buildTx(secondaryPriv, 0, send), false)
+	require.NoError(t, err)
+}
+
+func TestAnteHandlerInjectedTx(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+
+	injected := types.InjectedVoteExtensionTx{
+		ValidatorSignatures: []types.VoteExtensionSignature{{ValidatorAddress: []byte{1}, Signature: []byte{2}}},
+	}
+	bz, err := types.EncodeInjectedVoteExtensionTx(&injected)
+	require.NoError(t, err)
+
+	// the injected tx decodes into an unsigned tx with a MsgInjectAttestations
+	tx, err := myApp.TxDecode(bz)
+	require.NoError(t, err)
+	require.IsType(t, voteextension.InjectedTx{}, tx)
+	require.Len(t, tx.GetMsgs(), 1)
+	msg, ok := tx.GetMsgs()[0].(*types.MsgInjectAttestations)
+	require.True(t, ok)
+	require.Equal(t, sdk.AccAddress(types.InjectedTxAuthority).String(), msg.Authority)
+	require.Equal(t, injected, msg.InjectedTx)
+	_, err = tx.GetMsgsV2()
+	require.NoError(t, err)
+
+	_, err = myApp.TxDecode(types.InjectedTxMagic)
+	require.ErrorIs(t, err, sdkerrors.ErrTxDecode)
+
+	anteHandler, err := app.NewAnteHandler(ante.HandlerOptions{
+		AccountKeeper:   myApp.AuthKeeper,
+		BankKeeper:      myApp.BankKeeper,
+		SignModeHandler: myApp.TxConfig().SignModeHandler(),
+	}, myApp.SecondarykeysKeeper)
+	require.NoError(t, err)
+
+	// it only runs in FinalizeBlock, without fee or signatures
+	newCtx, err := anteHandler(ctx.WithExecMode(sdk.ExecModeFinalize), tx, false)
+	require.NoError(t, err)
+	require.NotZero(t, newCtx.GasMeter().Limit())
+	_, err = anteHandler(ctx.WithExecMode(sdk.ExecModeCheck), tx, false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, err = anteHandler(ctx.WithExecMode(sdk.ExecModeFinalize), tx, true)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	handler := myApp.MsgServiceRouter().Handler(msg)
+	require.NotNil(t, handler)
+	_, err = handler(newCtx, msg)
+	require.NoError(t, err)
+}
+
+func TestBlockMaxGasDecorator(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{ChainID: ChainID})
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	updateParams := func(maxGas int64) *consensustypes.MsgUpdateParams {
+		return &consensustypes.MsgUpdateParams{
+			Authority: sample.AccAddress(),
+			Block:     &tmproto.BlockParams{MaxBytes: 1 << 20, MaxGas: maxGas},
+		}
+	}
+	txWith := func(msgs ...sdk.Msg) sdk.Tx {
+		builder := myApp.TxConfig().NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		return builder.GetTx()
+	}
+
+	for _, maxGas := range []int64{-1, 0, types.InjectedTxGasLimit + 1} {
+		_, err := app.BlockMaxGasDecorator{}.AnteHandle(ctx, txWith(updateParams(maxGas)), false, next)
+		require.NoError(t, err, "max gas %d", maxGas)
+	}
+
+	// a block gas limit without room for the injected tx is rejected, also
+	// when nested in an authz.MsgExec
+	exec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(sample.AccAddress()), []sdk.Msg{updateParams(types.InjectedTxGasLimit)})
+	for _, tx := range []sdk.Tx{txWith(updateParams(1)), txWith(updateParams(types.InjectedTxGasLimit)), txWith(&exec)} {
+		_, err := app.BlockMaxGasDecorator{}.AnteHandle(ctx, tx, false, next)
+		require.ErrorIs(t, err, types.ErrInvalidParams)
+	}
+}
+
+func TestAnteHandlerAccountRecovery(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	k := myApp.SecondarykeysKeeper
+	ak := myApp.AuthKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+
+	lostPriv := CosmosK1.GenPrivKey()
+	addr := sdk.AccAddress(lostPriv.PubKey().Address())
+	acc := ak.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetPubKey(lostPriv.PubKey()))
+	ak.SetAccount(ctx, acc)
+
+	secondaryPriv, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_ED25519)
+	require.NoError(t, err)
+	pubKey, pop, err := common.SignProofOfPossession(secondaryPriv, addr)
+	require.NoError(t, err)
+	_, err = msgServer.RegisterSecondaryKey(ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    addr.String(),
+		KeyType:   secondaryPriv.KeyType(),
+		PublicKey: pubKey,
+		Signature: pop,
+	})
+	require.NoError(t, err)
+	_, err = msgServer.SetSecondarySignatureRequired(ctx, &types.MsgSetSecondarySignatureRequired{Sender: addr.String(), Required: true})
+	require.NoError(t, err)
+
+	// signTx signs msgs with priv, putting its public key in the signer info,
+	// and with secondaryPriv unless it is nil.
+	signTx := func(priv cryptotypes.PrivKey, secondaryPriv common.SecondaryPrivKey, msgs ...sdk.Msg) sdk.Tx {
+		acc := ak.GetAccount(ctx, addr)
+		sigData := &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT}
+		sig := signing.SignatureV2{PubKey: priv.PubKey(), Data: sigData, Sequence: acc.GetSequence()}
+		txBuilder := myApp.TxConfig().NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		require.NoError(t, txBuilder.SetSignatures(sig))
+		if secondaryPriv != nil {
+			require.NoError(t, common.SignSecondaryTx(secondaryPriv, txBuilder, ChainID, acc.GetAccountNumber()))
+		}
+		signBytes, err := authsigning.GetSignBytesAdapter(ctx, myApp.TxConfig().SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, authsigning.SignerData{
+			ChainID:       ChainID,
+			AccountNumber: acc.GetAccountNumber(),
+			Sequence:      acc.GetSequence(),
+			PubKey:        priv.PubKey(),
+			Address:       addr.String(),
+		}, txBuilder.GetTx())
+		require.NoError(t, err)
+		sigData.Signature, err = priv.Sign(signBytes)
+		require.NoError(t, err)
+		require.NoError(t, txBuilder.SetSignatures(sig))
+		return txBuilder.GetTx()
+	}
+	secondaryDecorator := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(ak, k))
+	sigVerification := sdk.ChainAnteDecorators(
+		app.NewSetPubKeyDecorator(ak),
+		ante.NewSigVerificationDecorator(ak, myApp.TxConfig().SignModeHandler()),
+	)
+
+	newPriv := CosmosK1.GenPrivKey()
+	recoverySig, err := common.SignRecoverAccount(secondaryPriv, acc, newPriv.PubKey())
+	require.NoError(t, err)
+	newPubKey, err := codectypes.NewAnyWithValue(newPriv.PubKey())
+	require.NoError(t, err)
+	recoverMsg := &types.MsgRecoverAccount{
+		Submitter:    sample.AccAddress(),
+		Account:      addr.String(),
+		NewPublicKey: newPubKey,
+		Signatures:   []types.SecondaryKeySignature{recoverySig},
+	}
+	res, err := msgServer.RecoverAccount(ctx, recoverMsg)
+	require.NoError(t, err)
+
+	// the primary key cancels without the secondary signature its account
+	// otherwise requires
+	cancel := &types.MsgCancelRecovery{Sender: addr.String()}
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	_, err = secondaryDecorator(ctx, signTx(lostPriv, nil, cancel), false)
+	require.NoError(t, err)
+	_, err = secondaryDecorator(ctx, signTx(lostPriv, nil, cancel, send), false)
+	require.ErrorIs(t, err, types.ErrSecondarySignatureRequired)
+
+	// once the timelock expires the new key signs for the account
+	require.NoError(t, k.ExecuteRecoveries(ctx.WithBlockTime(res.ExecuteTime)))
+	require.True(t, newPriv.PubKey().Equals(ak.GetAccount(ctx, addr).GetPubKey()))
+
+	_, err = sigVerification(ctx, signTx(newPriv, nil, send), false)
+	require.NoError(t, err)
+	_, err = sigVerification(ctx, signTx(lostPriv, nil, send), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = sigVerification(ctx, signTx(CosmosK1.GenPrivKey(), nil, send), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+
+	// the secondary signature is still looked up by the account address,
+	// which no longer matches the address of the new key
+	recovered := signTx(newPriv, secondaryPriv, send)
+	_, err = sigVerification(ctx, recovered, false)
+	require.NoError(t, err)
+	_, err = secondaryDecorator(ctx, recovered, false)
+	require.NoError(t, err)
+	_, err = secondaryDecorator(ctx, signTx(newPriv, nil, send), false)
+	require.ErrorIs(t, err, types.ErrSecondarySignatureRequired)
+}
+
+func TestAnteHandlerSessionKey(t *testing.T) {
+	myApp := app.New(log.NewNopLogger(), dbm.NewMemDB(), nil, true, sims.EmptyAppOptions{})
+	k := myApp.SecondarykeysKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	ctx := myApp.BaseApp.NewUncachedContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: ChainID,
+		Time:    time.Now(),
+	})
+	anteHandler := sdk.ChainAnteDecorators(app.NewSecondarySignatureVerificationDecorator(myApp.AuthKeeper, k))
+
+	secondaryPriv, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_ED25519)
+	require.NoError(t, err)
+	sessionPriv, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_SECP256R1)
+	require.NoError(t, err)
+	addr, buildTx := setupAccountWithSecondaryKey(t, myApp, ctx, secondaryPriv)
+
+	msg := &types.MsgRegisterSessionKey{
+		Sender:       addr.String(),
+		KeyType:      sessionPriv.KeyType(),
+		PublicKey:    sessionPriv.PubKey(),
+		MsgTypeUrls:  []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		SpendLimit:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		ExpiryHeight: 100,
+	}
+	sequence, err := k.GetRotationSequence(ctx, addr)
+	require.NoError(t, err)
+	sessionSig, err := common.SignRegisterSessionKey(sessionPriv, msg, sequence)
+	require.NoError(t, err)
+	msg.SessionKeySignature = sessionSig.Signature
+	secondarySig, err := common.SignRegisterSessionKey(secondaryPriv, msg, sequence)
+	require.NoError(t, err)
+	msg.Signatures = []types.SecondaryKeySignature{secondarySig}
+	_, err = msgServer.RegisterSessionKey(ctx, msg)
+	require.NoError(t, err)
+
+	// sessionTx signs msgs paying fee with the session key alone.
+	accNum := myApp.AuthKeeper.GetAccount(ctx, addr).GetAccountNumber()
+	sessionTx := func(fee sdk.Coins, msgs ...sdk.Msg) sdk.Tx {
+		txBuilder, err := myApp.TxConfig().WrapTxBuilder(buildTx(nil, 0, msgs...))
+		require.NoError(t, err)
+		txBuilder.SetFeeAmount(fee)
+		require.NoError(t, common.SignSecondaryTx(sessionPriv, txBuilder, ctx.ChainID(), accNum))
+		return txBuilder.GetTx()
+	}
+	remaining := func() sdk.Coins {
+		allowance, err := k.SessionKeyAllowances.Get(ctx, collections.Join(addr, sessionPriv.PubKey()))
+		require.NoError(t, err)
+		return allowance.Remaining
+	}
+	send := func(amount int64) sdk.Msg {
+		return banktypes.NewMsgSend(addr, sdk.MustAccAddressFromBech32(sample.AccAddress()), sdk.NewCoins(sdk.NewInt64Coin("stake", amount)))
+	}
+
+	// the fee is charged to the allowance along with the coins sent
+	_, err = anteHandler(ctx, sessionTx(sdk.NewCoins(sdk.NewInt64Coin("stake", 10)), send(30)), false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), remaining())
+
+	_, err = anteHandler(ctx, sessionTx(nil, send(30), send(31)), false)
+	require.ErrorIs(t, err, types.ErrSessionKeySpendLimit)
+	_, err = anteHandler(ctx, sessionTx(nil, send(1), &types.MsgSetSecondarySignatureRequired{Sender: addr.String(), Required: true}), false)
+	require.ErrorIs(t, err, types.ErrSessionKeyScope)
+	exec := authz.NewMsgExec(addr, []sdk.Msg{send(1000)})
+	_, err = anteHandler(ctx, sessionTx(nil, &exec), false)
+	require.ErrorIs(t, err, types.ErrSessionKeyScope)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), remaining())
+
+	// the account's key set still signs anything, without touching the
+	// allowance
+	_, err = anteHandler(ctx, buildTx(secondaryPriv, 0, send(1000)), false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 60)), remaining())
+
+	_, err = anteHandler(ctx.WithBlockHeight(100), sessionTx(nil, send(1)), false)
+	require.ErrorIs(t, err, types.ErrSessionKeyExpired)
 }
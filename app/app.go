@@ -7,6 +7,7 @@ import (
 	examplemodulekeeper "example/x/example/keeper"
 	voteextension "example/x/secondarykeys/VoteExtension"
 	secondarykeysmodulekeeper "example/x/secondarykeys/keeper"
+	secondarykeysmoduletypes "example/x/secondarykeys/types"
 
 	clienthelpers "cosmossdk.io/client/v2/helpers"
 	"cosmossdk.io/core/appmodule"
@@ -214,12 +215,13 @@ func New(
 
 	app.sm.RegisterStoreDecoders()
 
-	app.voteExtHandler = voteextension.NewVoteExtensionHandler(&app.SecondarykeysKeeper)
-
-	app.proposalHandler = &voteextension.ProposalHandler{
-		Logger: logger,
-		Keeper: app.SecondarykeysKeeper,
+	secondarySigner, err := loadSecondarySigner(logger, appOpts)
+	if err != nil {
+		panic(err)
 	}
+	app.voteExtHandler = voteextension.NewVoteExtensionHandler(&app.SecondarykeysKeeper, secondarySigner)
+
+	app.proposalHandler = voteextension.NewProposalHandler(logger, app.SecondarykeysKeeper, app.StakingKeeper, app.Mempool(), app.BaseApp)
 	// Vote Extension handlers
 	app.SetExtendVoteHandler(app.voteExtHandler.ExtendVoteHandler())
 	app.SetVerifyVoteExtensionHandler(app.voteExtHandler.VerifyVoteExtensionHandler())
@@ -228,6 +230,27 @@ func New(
 	app.SetPrepareProposal(app.proposalHandler.PrepareProposal())
 	app.SetProcessProposal(app.proposalHandler.ProcessProposal())
 
+	// Decode the injected signature transaction into an unsigned tx carrying
+	// MsgInjectAttestations, so every tx of a block is decodable.
+	injectedTxAuthority, err := app.AuthKeeper.AddressCodec().BytesToString(secondarykeysmoduletypes.InjectedTxAuthority)
+	if err != nil {
+		panic(err)
+	}
+	app.SetTxDecoder(voteextension.NewTxDecoder(app.txConfig, injectedTxAuthority))
+
+	// Run the module pre-blockers, then record the attestation carried by the
+	// block's injected signature transaction.
+	app.SetPreBlocker(func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		res, err := app.App.PreBlocker(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if err := app.proposalHandler.PreBlocker(ctx, req); err != nil {
+			return nil, err
+		}
+		return res, nil
+	})
+
 	// Create the ante handler
 	anteHandler, err := NewAnteHandler(
 		ante.HandlerOptions{
@@ -252,6 +275,11 @@ func New(
 		if err := app.UpgradeKeeper.SetModuleVersionMap(ctx, app.ModuleManager.GetVersionMap()); err != nil {
 			return nil, err
 		}
+		if params := req.ConsensusParams; params != nil && params.Block != nil {
+			if err := secondarykeysmoduletypes.ValidateBlockMaxGas(params.Block.MaxGas); err != nil {
+				return nil, err
+			}
+		}
 		return app.App.InitChainer(ctx, req)
 	})
 
@@ -0,0 +1,70 @@
+package app
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+
+	voteextension "example/x/secondarykeys/VoteExtension"
+	"example/x/secondarykeys/remotesigner"
+)
+
+const (
+	// FlagSecondaryKeyFile is the app option pointing at the validator's
+	// secondary key file. Relative paths are resolved against the node's home
+	// directory.
+	FlagSecondaryKeyFile = "secondary-key-file"
+
+	// FlagSecondarySignerAddr is the app option pointing at the tcp:// or
+	// unix:// address of a secondary signer daemon. When it is set, the
+	// secondary key file is not read.
+	FlagSecondarySignerAddr = "secondary-signer-addr"
+)
+
+// SecondaryKeyFilePath returns the path of the validator's secondary key file,
+// or an empty string if appOpts point at neither a key file nor a home
+// directory.
+func SecondaryKeyFilePath(appOpts servertypes.AppOptions) string {
+	home := cast.ToString(appOpts.Get(flags.FlagHome))
+	path := cast.ToString(appOpts.Get(FlagSecondaryKeyFile))
+	switch {
+	case path == "" && home == "":
+		return ""
+	case path == "":
+		return filepath.Join(home, "config", voteextension.SecondaryKeyFileName)
+	case !filepath.IsAbs(path) && home != "":
+		return filepath.Join(home, path)
+	default:
+		return path
+	}
+}
+
+// loadSecondarySigner returns the signer of the validator's vote extensions:
+// a client of the secondary signer daemon if appOpts point at one, or else the
+// secondary key file. A missing key file is not an error: the node then runs
+// without extending its votes.
+func loadSecondarySigner(logger log.Logger, appOpts servertypes.AppOptions) (voteextension.Signer, error) {
+	if addr := cast.ToString(appOpts.Get(FlagSecondarySignerAddr)); addr != "" {
+		logger.Info("signing vote extensions with the secondary signer", "address", addr)
+		return remotesigner.NewSignerClient(addr, remotesigner.DefaultTimeout)
+	}
+
+	path := SecondaryKeyFilePath(appOpts)
+	if path == "" {
+		return nil, nil
+	}
+
+	key, err := voteextension.LoadSecondaryKeyFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		logger.Error("secondary key file not found, vote extensions are disabled", "path", path)
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return voteextension.NewLocalSigner(key), nil
+}
@@ -30,6 +30,8 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	secondarykeystypes "example/x/secondarykeys/types"
 )
 
 const (
@@ -248,9 +250,10 @@ func TestAppImportExport(t *testing.T) {
 			stakingtypes.HistoricalInfoKey, stakingtypes.UnbondingIDKey, stakingtypes.UnbondingIndexKey,
 			stakingtypes.UnbondingTypeKey, stakingtypes.ValidatorUpdatesKey,
 		},
-		authzkeeper.StoreKey:   {authzkeeper.GrantQueuePrefix},
-		feegrant.StoreKey:      {feegrant.FeeAllowanceQueueKeyPrefix},
-		slashingtypes.StoreKey: {slashingtypes.ValidatorMissedBlockBitmapKeyPrefix},
+		authzkeeper.StoreKey:        {authzkeeper.GrantQueuePrefix},
+		feegrant.StoreKey:           {feegrant.FeeAllowanceQueueKeyPrefix},
+		slashingtypes.StoreKey:      {slashingtypes.ValidatorMissedBlockBitmapKeyPrefix},
+		secondarykeystypes.StoreKey: {secondarykeystypes.LastBlockHashKey, secondarykeystypes.AttestationsKey},
 	}
 
 	storeKeys := bApp.GetStoreKeys()
@@ -275,6 +278,14 @@ func TestAppImportExport(t *testing.T) {
 
 		require.Equal(t, 0, len(failedKVAs), simtestutil.GetSimulationLog(keyName, bApp.SimulationManager().StoreDecoders, failedKVAs, failedKVBs))
 	}
+
+	// the secondary key registries must survive the round trip as well
+	secondaryKeysA, err := bApp.SecondarykeysKeeper.ExportGenesis(ctxA)
+	require.NoError(t, err)
+	secondaryKeysB, err := newApp.SecondarykeysKeeper.ExportGenesis(ctxB)
+	require.NoError(t, err)
+	require.Equal(t, secondaryKeysA, secondaryKeysB)
+	t.Logf("compared %d account and %d validator secondary keys", len(secondaryKeysA.AccountKeys), len(secondaryKeysA.ValidatorKeys))
 }
 
 func TestAppSimulationAfterImport(t *testing.T) {
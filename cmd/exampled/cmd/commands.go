@@ -3,6 +3,7 @@ package cmd
 import (
 	"errors"
 	"io"
+	"path/filepath"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -25,6 +26,8 @@ import (
 	genutilcli "github.com/cosmos/cosmos-sdk/x/genutil/client/cli"
 
 	"example/app"
+	voteextension "example/x/secondarykeys/VoteExtension"
+	secondarykeystypes "example/x/secondarykeys/types"
 )
 
 func initRootCmd(
@@ -33,13 +36,14 @@ func initRootCmd(
 	basicManager module.BasicManager,
 ) {
 	rootCmd.AddCommand(
-		genutilcli.InitCmd(basicManager, app.DefaultNodeHome),
+		initCmd(basicManager),
 		NewInPlaceTestnetCmd(),
 		NewTestnetMultiNodeCmd(basicManager, banktypes.GenesisBalancesIterator{}),
 		debug.Cmd(),
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(newApp, app.DefaultNodeHome),
 		snapshot.Cmd(newApp),
+		secondarySignerCmd(),
 	)
 
 	server.AddCommandsWithStartCmdOptions(rootCmd, app.DefaultNodeHome, newApp, appExport, server.StartCmdOptions{
@@ -56,8 +60,29 @@ func initRootCmd(
 	)
 }
 
+// initCmd wraps the genutil init command so that it also creates the
+// validator's secondary key file.
+func initCmd(basicManager module.BasicManager) *cobra.Command {
+	cmd := genutilcli.InitCmd(basicManager, app.DefaultNodeHome)
+	cmd.PostRunE = func(cmd *cobra.Command, _ []string) error {
+		secondaryKeyType, _ := cmd.Flags().GetString(flagSecondaryKeyType)
+		keyType, err := secondarykeystypes.ParseKeyType(secondaryKeyType)
+		if err != nil {
+			return err
+		}
+		home := client.GetClientContextFromCmd(cmd).HomeDir
+		path := filepath.Join(home, "config", voteextension.SecondaryKeyFileName)
+		_, err = voteextension.LoadOrGenSecondaryKeyFile(path, keyType)
+		return err
+	}
+	cmd.Flags().String(flagSecondaryKeyType, "secp256k1", "Type of the validator's secondary key (secp256k1|bls12_381)")
+	return cmd
+}
+
 // addModuleInitFlags adds more flags to the start command.
 func addModuleInitFlags(startCmd *cobra.Command) {
+	startCmd.Flags().String(app.FlagSecondaryKeyFile, "", "Path to the validator's secondary key file (default: config/"+voteextension.SecondaryKeyFileName+" in the node home)")
+	startCmd.Flags().String(app.FlagSecondarySignerAddr, "", "tcp:// or unix:// address of the secondary signer daemon signing the validator's vote extensions instead of the secondary key file")
 }
 
 func queryCommand() *cobra.Command {
@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	voteextension "example/x/secondarykeys/VoteExtension"
+	"example/x/secondarykeys/remotesigner"
+)
+
+const (
+	flagSignerListenAddr = "laddr"
+	flagSignerKeyFile    = "key-file"
+	flagSignerStateFile  = "state-file"
+
+	// secondarySignerStateFileName is the name of the secondary signer's
+	// state file in the data directory, next to priv_validator_state.json.
+	secondarySignerStateFileName = "secondary_signer_state.json"
+)
+
+// secondarySignerCmd runs a remote signer holding the validator's secondary
+// key, so that the node signs its vote extensions without loading the key.
+func secondarySignerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "secondary-signer",
+		Short: "Run a remote signer for the validator's secondary key",
+		Long: `Run a remote signer for the validator's secondary key. Nodes started with
+--secondary-signer-addr pointing at --laddr request their vote extension
+signatures from it. The signer records the last height and block it signed in
+its state file and refuses to sign another block at the same height, or any
+lower height. TCP connections are encrypted like CometBFT's privval socket,
+but not authenticated: only expose them on a private network.
+
+Example:
+	exampled secondary-signer --chain-id example --laddr unix:///var/run/secondary_signer.sock`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			home := clientCtx.HomeDir
+
+			chainID, _ := cmd.Flags().GetString(flags.FlagChainID)
+			if chainID == "" {
+				chainID = clientCtx.ChainID
+			}
+			if chainID == "" {
+				return errors.New("--chain-id is required")
+			}
+			laddr, _ := cmd.Flags().GetString(flagSignerListenAddr)
+			if laddr == "" {
+				laddr = "unix://" + filepath.Join(home, "secondary_signer.sock")
+			}
+			keyFile, _ := cmd.Flags().GetString(flagSignerKeyFile)
+			stateFile, _ := cmd.Flags().GetString(flagSignerStateFile)
+
+			key, err := voteextension.LoadSecondaryKeyFile(homePath(home, keyFile))
+			if err != nil {
+				return err
+			}
+			state, err := remotesigner.LoadOrGenSignState(homePath(home, stateFile))
+			if err != nil {
+				return err
+			}
+			ln, err := remotesigner.Listen(laddr)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+			go func() {
+				<-ctx.Done()
+				ln.Close()
+			}()
+
+			logger := log.NewLogger(cmd.ErrOrStderr())
+			logger.Info("secondary signer listening", "address", laddr, "chain_id", chainID, "key_type", key.KeyType().String(), "height", state.Height)
+			return remotesigner.NewSignerServer(logger, chainID, key, state).Serve(ln)
+		},
+	}
+
+	cmd.Flags().String(flags.FlagChainID, "", "Chain ID the signer signs vote extensions for (default: the chain ID of client.toml)")
+	cmd.Flags().String(flagSignerListenAddr, "", "tcp:// or unix:// address to listen on for nodes (default: unix://secondary_signer.sock in the node home)")
+	cmd.Flags().String(flagSignerKeyFile, filepath.Join("config", voteextension.SecondaryKeyFileName), "Path to the secondary key file, relative to the node home")
+	cmd.Flags().String(flagSignerStateFile, filepath.Join("data", secondarySignerStateFileName), "Path to the signer's state file, relative to the node home")
+	return cmd
+}
+
+// homePath resolves path against home unless it is absolute.
+func homePath(home, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(home, path)
+}
@@ -37,6 +37,9 @@ import (
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	runtime "github.com/cosmos/cosmos-sdk/runtime"
+
+	voteextension "example/x/secondarykeys/VoteExtension"
+	secondarykeystypes "example/x/secondarykeys/types"
 )
 
 var (
@@ -46,6 +49,7 @@ var (
 	flagOutputDir             = "output-dir"
 	flagValidatorsStakeAmount = "validators-stake-amount"
 	flagStartingIPAddress     = "starting-ip-address"
+	flagSecondaryKeyType      = "secondary-key-type"
 )
 
 const nodeDirPerm = 0o755
@@ -59,6 +63,7 @@ type initArgs struct {
 	numValidators          int
 	outputDir              string
 	startingIPAddress      string
+	secondaryKeyType       secondarykeystypes.KeyType
 	validatorsStakesAmount map[int]sdk.Coin
 	ports                  map[int]string
 }
@@ -97,6 +102,10 @@ Example:
 			args.startingIPAddress, _ = cmd.Flags().GetString(flagStartingIPAddress)
 			args.numValidators, _ = cmd.Flags().GetInt(flagNumValidators)
 			args.algo, _ = cmd.Flags().GetString(flags.FlagKeyType)
+			secondaryKeyType, _ := cmd.Flags().GetString(flagSecondaryKeyType)
+			if args.secondaryKeyType, err = secondarykeystypes.ParseKeyType(secondaryKeyType); err != nil {
+				return err
+			}
 
 			args.ports = map[int]string{}
 			args.validatorsStakesAmount = make(map[int]sdk.Coin)
@@ -138,6 +147,7 @@ Example:
 	cmd.Flags().String(flagValidatorsStakeAmount, "100000000,100000000,100000000,100000000", "Amount of stake for each validator")
 	cmd.Flags().String(flagStartingIPAddress, "localhost", "Starting IP address (192.168.0.1 results in persistent peers list ID0@192.168.0.1:46656, ID1@192.168.0.2:46656, ...)")
 	cmd.Flags().String(flags.FlagKeyringBackend, "test", "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flagSecondaryKeyType, "secp256k1", "Type of the validators' secondary keys (secp256k1|bls12_381)")
 
 	return cmd
 }
@@ -186,6 +196,7 @@ func initTestnetFiles(
 		genAccounts     []authtypes.GenesisAccount
 		genBalances     []banktypes.Balance
 		genFiles        []string
+		genValKeys      []secondarykeystypes.ValidatorKey
 		persistentPeers string
 		gentxsFiles     []string
 	)
@@ -211,6 +222,17 @@ func initTestnetFiles(
 			_ = os.RemoveAll(args.outputDir)
 			return err
 		}
+		secondaryKey, err := voteextension.GenSecondaryKeyFile(filepath.Join(nodeDir, "config", voteextension.SecondaryKeyFileName), args.secondaryKeyType)
+		if err != nil {
+			_ = os.RemoveAll(args.outputDir)
+			return err
+		}
+		// register the secondary keys in genesis so that the validators'
+		// vote extensions are verified from the first block
+		genValKeys = append(genValKeys, secondarykeystypes.ValidatorKey{
+			ConsensusAddress: sdk.ConsAddress(valPubKeys[i].Address()).String(),
+			PublicKey:        secondaryKey.PubKey(),
+		})
 
 		memo := fmt.Sprintf("%s@%s:"+strconv.Itoa(26656-3*i), nodeIDs[i], args.startingIPAddress)
 
@@ -312,7 +334,7 @@ func initTestnetFiles(
 		srvconfig.WriteConfigFile(filepath.Join(nodeDir, "config", "app.toml"), appConfig)
 	}
 
-	if err := initGenFiles(clientCtx, mbm, args.chainID, genAccounts, genBalances, genFiles, args.numValidators); err != nil {
+	if err := initGenFiles(clientCtx, mbm, args.chainID, genAccounts, genBalances, genValKeys, genFiles, args.numValidators); err != nil {
 		return err
 	}
 	// copy gentx file
@@ -361,7 +383,7 @@ func writeFile(file, dir string, contents []byte) error {
 func initGenFiles(
 	clientCtx client.Context, mbm module.BasicManager, chainID string,
 	genAccounts []authtypes.GenesisAccount, genBalances []banktypes.Balance,
-	genFiles []string, numValidators int,
+	genValKeys []secondarykeystypes.ValidatorKey, genFiles []string, numValidators int,
 ) error {
 	appGenState := mbm.DefaultGenesis(clientCtx.Codec)
 
@@ -387,6 +409,13 @@ func initGenFiles(
 	}
 	appGenState[banktypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&bankGenState)
 
+	// set the validator secondary keys in the genesis state
+	var secondaryKeysGenState secondarykeystypes.GenesisState
+	clientCtx.Codec.MustUnmarshalJSON(appGenState[secondarykeystypes.ModuleName], &secondaryKeysGenState)
+
+	secondaryKeysGenState.ValidatorKeys = genValKeys
+	appGenState[secondarykeystypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(&secondaryKeysGenState)
+
 	appGenStateJSON, err := json.MarshalIndent(appGenState, "", "  ")
 	if err != nil {
 		return err
@@ -2,6 +2,7 @@ package common
 
 import (
 	"bytes"
+	"crypto/ecdsa"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -10,8 +11,12 @@ import (
 	"strconv"
 	"time"
 
+	"example/x/secondarykeys/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	CosmosK1 "github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
@@ -23,13 +28,16 @@ import (
 	EthereumK1 "github.com/ethereum/go-ethereum/crypto"
 )
 
+// GetAddr returns the address of the first signer of tx. It is the account
+// address rather than the address of the signer's public key, which differs
+// once the account has been recovered to a new primary key.
 func GetAddr(tx sdk.Tx) ([]byte, error) {
 
 	sigTx, ok := tx.(authsigning.SigVerifiableTx)
 	if !ok {
 		return nil, sdkerrors.ErrTxDecode
 	}
-	signers, err := sigTx.GetSignaturesV2()
+	signers, err := sigTx.GetSigners()
 	if err != nil {
 		log.Println(err)
 		return nil, sdkerrors.ErrPanic
@@ -37,22 +45,24 @@ func GetAddr(tx sdk.Tx) ([]byte, error) {
 	if len(signers) == 0 {
 		return nil, sdkerrors.ErrNoSignatures
 	}
-
-	pubKey := signers[0].PubKey
-	if pubKey == nil {
-		return nil, sdkerrors.ErrInvalidPubKey.Wrap("signature has no public key")
-	}
-	return signers[0].PubKey.Address(), nil
+	return signers[0], nil
 
 }
 
+// CreateValidMemo returns a MsgBroadcastData registration payload for a
+// freshly generated secondary key.
 func CreateValidMemo() (string, error) {
 	// Generate a random Ethereum private key
 	secondaryPrivKey, err := EthereumK1.GenerateKey()
 	if err != nil {
 		return "", err
 	}
+	return CreateRegistrationMemo(secondaryPrivKey)
+}
 
+// CreateRegistrationMemo returns the MsgBroadcastData registration payload for
+// the secondary key priv.
+func CreateRegistrationMemo(secondaryPrivKey *ecdsa.PrivateKey) (string, error) {
 	// Get the public key (uncompressed format, 65 bytes)
 	secondaryPubKey := crypto.FromECDSAPub(&secondaryPrivKey.PublicKey)
 
@@ -88,6 +98,159 @@ func CreateValidMemo() (string, error) {
 	return memo, nil
 }
 
+// SignSecondaryTx signs the transaction being built with the secondary key priv
+// of its first signer and attaches the signature as a
+// SecondarySignatureExtension. The signer infos must already be set on
+// txBuilder, and the primary signatures must be computed afterwards.
+// Signatures of other keys are kept, so the members of a key set sign one
+// after another; a previous signature of priv is replaced.
+func SignSecondaryTx(priv SecondaryPrivKey, txBuilder client.TxBuilder, chainID string, accountNumber uint64) error {
+	return SignSecondaryTxFor(priv, nil, txBuilder, chainID, accountNumber)
+}
+
+// SignSecondaryTxFor is SignSecondaryTx for the signer with the given address
+// and account number, so that each signer of a transaction with several ones
+// adds the signatures of its own key set. A nil signer is the first signer.
+func SignSecondaryTxFor(priv SecondaryPrivKey, signer sdk.AccAddress, txBuilder client.TxBuilder, chainID string, accountNumber uint64) error {
+	extBuilder, ok := txBuilder.(client.ExtendedTxBuilder)
+	if !ok {
+		return fmt.Errorf("tx builder %T does not support extension options", txBuilder)
+	}
+	hsh, err := types.SecondarySignBytesFromTx(txBuilder.GetTx(), chainID, accountNumber)
+	if err != nil {
+		return err
+	}
+
+	signature, err := priv.Sign(hsh)
+	if err != nil {
+		return err
+	}
+
+	var signerAddr string
+	if signer != nil {
+		signerAddr = signer.String()
+	}
+	ext, err := codectypes.NewAnyWithValue(&types.SecondarySignatureExtension{
+		PublicKey: priv.PubKey(),
+		Signature: signature,
+		Signer:    signerAddr,
+	})
+	if err != nil {
+		return err
+	}
+
+	var opts []*codectypes.Any
+	if extTx, ok := txBuilder.GetTx().(interface{ GetExtensionOptions() []*codectypes.Any }); ok {
+		for _, opt := range extTx.GetExtensionOptions() {
+			if !types.IsSecondarySignatureExtension(opt) {
+				opts = append(opts, opt)
+				continue
+			}
+			var other types.SecondarySignatureExtension
+			if err := other.Unmarshal(opt.Value); err != nil {
+				return err
+			}
+			if !bytes.Equal(other.PublicKey, priv.PubKey()) || other.Signer != signerAddr {
+				opts = append(opts, opt)
+			}
+		}
+	}
+	extBuilder.SetExtensionOptions(append(opts, ext)...)
+	return nil
+}
+
+// SignSecondaryTxMemo is the legacy form of SignSecondaryTx which stores the
+// secondary signature in the memo.
+func SignSecondaryTxMemo(priv SecondaryPrivKey, txBuilder client.TxBuilder, chainID string, accountNumber uint64) error {
+	txBuilder.SetMemo("")
+	hsh, err := types.SecondarySignBytesFromTx(txBuilder.GetTx(), chainID, accountNumber)
+	if err != nil {
+		return err
+	}
+
+	signature, err := priv.Sign(hsh)
+	if err != nil {
+		return err
+	}
+
+	memoBytes, err := EncodeMemoWithSecondSig(SecondarySignature{
+		PublicKey: priv.PubKey(),
+		Signature: signature,
+	})
+	if err != nil {
+		return err
+	}
+	txBuilder.SetMemo(types.AnteHandlerPrefix + string(memoBytes))
+	return nil
+}
+
+// SignProofOfPossession returns the secondary public key of priv together with
+// the proof of possession MsgRegisterSecondaryKey expects for sender.
+func SignProofOfPossession(priv SecondaryPrivKey, sender sdk.AccAddress) ([]byte, []byte, error) {
+	pubKey := priv.PubKey()
+	signature, err := priv.Sign(types.ProofOfPossessionBytes(sender, pubKey))
+	if err != nil {
+		return nil, nil, err
+	}
+	return pubKey, signature, nil
+}
+
+// SignRecoverAccount returns the signature of priv, a member of the secondary
+// key set of acc, authorising MsgRecoverAccount to recover acc to newPubKey.
+func SignRecoverAccount(priv SecondaryPrivKey, acc sdk.AccountI, newPubKey cryptotypes.PubKey) (types.SecondaryKeySignature, error) {
+	hash, err := types.RecoverAccountBytes(acc.GetAddress(), acc.GetAccountNumber(), acc.GetSequence(), acc.GetPubKey(), newPubKey)
+	if err != nil {
+		return types.SecondaryKeySignature{}, err
+	}
+	signature, err := priv.Sign(hash)
+	if err != nil {
+		return types.SecondaryKeySignature{}, err
+	}
+	return types.SecondaryKeySignature{PublicKey: priv.PubKey(), Signature: signature}, nil
+}
+
+// SignRegisterSessionKey returns the signature of priv authorising msg to
+// register its session key, at the rotation sequence of the sender. Members
+// of the sender's key set reaching its threshold and the session key itself
+// sign.
+func SignRegisterSessionKey(priv SecondaryPrivKey, msg *types.MsgRegisterSessionKey, sequence uint64) (types.SecondaryKeySignature, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return types.SecondaryKeySignature{}, err
+	}
+	signature, err := priv.Sign(types.RegisterSessionKeyBytes(sender, sequence, msg.SessionKey()))
+	if err != nil {
+		return types.SecondaryKeySignature{}, err
+	}
+	return types.SecondaryKeySignature{PublicKey: priv.PubKey(), Signature: signature}, nil
+}
+
+// SignValidatorProofOfPossession returns the public key of priv and its
+// proof of possession for registering it as the secondary key of operator.
+func SignValidatorProofOfPossession(priv *ecdsa.PrivateKey, operator sdk.ValAddress) ([]byte, []byte, error) {
+	pubKey := crypto.FromECDSAPub(&priv.PublicKey)
+	signature, err := EthereumK1.Sign(types.ValidatorProofOfPossessionBytes(operator, pubKey), priv)
+	if err != nil {
+		return nil, nil, err
+	}
+	return pubKey, signature, nil
+}
+
+// SignValidatorBLS12381ProofOfPossession returns the public key of the
+// BLS12-381 private key priv and its proof of possession for registering it
+// as the secondary key of operator.
+func SignValidatorBLS12381ProofOfPossession(priv []byte, operator sdk.ValAddress) ([]byte, []byte, error) {
+	pubKey, err := types.BLS12381PubKey(priv)
+	if err != nil {
+		return nil, nil, err
+	}
+	signature, err := types.SignBLS12381ProofOfPossession(priv, types.ValidatorProofOfPossessionBytes(operator, pubKey))
+	if err != nil {
+		return nil, nil, err
+	}
+	return pubKey, signature, nil
+}
+
 func (s *SecondarySignature) Validate() error {
 	if len(s.PublicKey) == 0 {
 		return fmt.Errorf("missing public key")
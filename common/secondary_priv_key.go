@@ -0,0 +1,266 @@
+package common
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	EthereumK1 "github.com/ethereum/go-ethereum/crypto"
+
+	"example/x/secondarykeys/types"
+)
+
+// SecondaryPrivKey is the private key of an account's secondary key, of any
+// type accounts can register.
+type SecondaryPrivKey interface {
+	// KeyType returns the type of the key.
+	KeyType() types.KeyType
+
+	// PubKey returns the public key as registered with
+	// MsgRegisterSecondaryKey.
+	PubKey() []byte
+
+	// Sign signs the 32 byte digest hash, in the form
+	// types.SecondaryPubKey.VerifySignature verifies for the key type.
+	Sign(hash []byte) ([]byte, error)
+}
+
+// GenSecondaryPrivKey generates a secondary private key of the given type.
+func GenSecondaryPrivKey(keyType types.KeyType) (SecondaryPrivKey, error) {
+	seed := make([]byte, 32)
+	if _, err := rand.Read(seed); err != nil {
+		return nil, err
+	}
+	return SecondaryPrivKeyFromSeed(keyType, seed)
+}
+
+// SecondaryPrivKeyFromSeed derives the secondary private key of the given
+// type from the 32 byte seed. The seed is the private key itself, which for
+// the elliptic curve keys has to be below the order of the curve.
+func SecondaryPrivKeyFromSeed(keyType types.KeyType, seed []byte) (SecondaryPrivKey, error) {
+	if len(seed) != 32 {
+		return nil, fmt.Errorf("expected a 32 byte seed, got %d bytes", len(seed))
+	}
+	switch keyType {
+	case types.KeyType_KEY_TYPE_SECP256K1:
+		priv, err := EthereumK1.ToECDSA(seed)
+		if err != nil {
+			return nil, err
+		}
+		return NewSecp256k1PrivKey(priv), nil
+	case types.KeyType_KEY_TYPE_ED25519:
+		return NewEd25519PrivKey(ed25519.NewKeyFromSeed(seed)), nil
+	case types.KeyType_KEY_TYPE_SECP256R1:
+		priv, err := p256PrivKeyFromSeed(seed)
+		if err != nil {
+			return nil, err
+		}
+		return NewSecp256r1PrivKey(priv)
+	case types.KeyType_KEY_TYPE_SCHNORR:
+		var scalar btcec.ModNScalar
+		if overflow := scalar.SetByteSlice(seed); overflow || scalar.IsZero() {
+			return nil, errors.New("invalid secp256k1 private key")
+		}
+		return NewSchnorrPrivKey(btcec.PrivKeyFromScalar(&scalar)), nil
+	default:
+		return nil, fmt.Errorf("unsupported account key type %s", keyType)
+	}
+}
+
+func p256PrivKeyFromSeed(seed []byte) (*ecdsa.PrivateKey, error) {
+	curve := elliptic.P256()
+	d := new(big.Int).SetBytes(seed)
+	if d.Sign() == 0 || d.Cmp(curve.Params().N) >= 0 {
+		return nil, errors.New("invalid P-256 private key")
+	}
+	priv := &ecdsa.PrivateKey{PublicKey: ecdsa.PublicKey{Curve: curve}, D: d}
+	priv.X, priv.Y = curve.ScalarBaseMult(seed)
+	return priv, nil
+}
+
+// secp256k1PrivKey is an Ethereum style secp256k1 SecondaryPrivKey.
+type secp256k1PrivKey struct {
+	priv *ecdsa.PrivateKey
+}
+
+// NewSecp256k1PrivKey returns the SecondaryPrivKey of the secp256k1 key priv.
+func NewSecp256k1PrivKey(priv *ecdsa.PrivateKey) SecondaryPrivKey {
+	return secp256k1PrivKey{priv: priv}
+}
+
+func (k secp256k1PrivKey) KeyType() types.KeyType { return types.KeyType_KEY_TYPE_SECP256K1 }
+
+func (k secp256k1PrivKey) PubKey() []byte { return EthereumK1.FromECDSAPub(&k.priv.PublicKey) }
+
+// Sign returns the signature without its recovery byte.
+func (k secp256k1PrivKey) Sign(hash []byte) ([]byte, error) {
+	signature, err := EthereumK1.Sign(hash, k.priv)
+	if err != nil {
+		return nil, err
+	}
+	return signature[:64], nil
+}
+
+// ed25519PrivKey is an ed25519 SecondaryPrivKey.
+type ed25519PrivKey struct {
+	priv ed25519.PrivateKey
+}
+
+// NewEd25519PrivKey returns the SecondaryPrivKey of priv.
+func NewEd25519PrivKey(priv ed25519.PrivateKey) SecondaryPrivKey {
+	return ed25519PrivKey{priv: priv}
+}
+
+func (k ed25519PrivKey) KeyType() types.KeyType { return types.KeyType_KEY_TYPE_ED25519 }
+
+func (k ed25519PrivKey) PubKey() []byte { return k.priv.Public().(ed25519.PublicKey) }
+
+func (k ed25519PrivKey) Sign(hash []byte) ([]byte, error) { return ed25519.Sign(k.priv, hash), nil }
+
+// secp256r1PrivKey is a P-256 SecondaryPrivKey.
+type secp256r1PrivKey struct {
+	priv *ecdsa.PrivateKey
+}
+
+// NewSecp256r1PrivKey returns the SecondaryPrivKey of the P-256 key priv.
+func NewSecp256r1PrivKey(priv *ecdsa.PrivateKey) (SecondaryPrivKey, error) {
+	if priv.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("expected a P-256 key, got %s", priv.Curve.Params().Name)
+	}
+	return secp256r1PrivKey{priv: priv}, nil
+}
+
+func (k secp256r1PrivKey) KeyType() types.KeyType { return types.KeyType_KEY_TYPE_SECP256R1 }
+
+func (k secp256r1PrivKey) PubKey() []byte {
+	return elliptic.MarshalCompressed(elliptic.P256(), k.priv.X, k.priv.Y)
+}
+
+// Sign returns the r || s signature with s in the lower half of the order,
+// the only form the module accepts.
+func (k secp256r1PrivKey) Sign(hash []byte) ([]byte, error) {
+	r, s, err := ecdsa.Sign(rand.Reader, k.priv, hash)
+	if err != nil {
+		return nil, err
+	}
+	order := elliptic.P256().Params().N
+	if s.Cmp(new(big.Int).Rsh(order, 1)) > 0 {
+		s.Sub(order, s)
+	}
+	signature := make([]byte, 64)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:])
+	return signature, nil
+}
+
+// schnorrPrivKey is a BIP-340 Schnorr SecondaryPrivKey.
+type schnorrPrivKey struct {
+	priv *btcec.PrivateKey
+}
+
+// NewSchnorrPrivKey returns the SecondaryPrivKey of the secp256k1 key priv
+// signing BIP-340 Schnorr signatures.
+func NewSchnorrPrivKey(priv *btcec.PrivateKey) SecondaryPrivKey {
+	return schnorrPrivKey{priv: priv}
+}
+
+func (k schnorrPrivKey) KeyType() types.KeyType { return types.KeyType_KEY_TYPE_SCHNORR }
+
+func (k schnorrPrivKey) PubKey() []byte { return schnorr.SerializePubKey(k.priv.PubKey()) }
+
+func (k schnorrPrivKey) Sign(hash []byte) ([]byte, error) {
+	signature, err := schnorr.Sign(k.priv, hash)
+	if err != nil {
+		return nil, err
+	}
+	return signature.Serialize(), nil
+}
+
+// webAuthnPrivKey is a software WebAuthn authenticator holding a single
+// passkey, for tests and tools that cannot reach a real authenticator.
+type webAuthnPrivKey struct {
+	priv   *ecdsa.PrivateKey
+	rpID   string
+	origin string
+
+	signCount atomic.Uint32
+}
+
+// webAuthnClientData is the client data JSON of an assertion, with its
+// members in the order browsers serialize them.
+type webAuthnClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin"`
+}
+
+// NewWebAuthnPrivKey returns a software authenticator signing WebAuthn
+// assertions with the P-256 key priv for the relying party ID rpID, as a
+// browser at origin would request them.
+func NewWebAuthnPrivKey(priv *ecdsa.PrivateKey, rpID, origin string) (SecondaryPrivKey, error) {
+	if priv.Curve != elliptic.P256() {
+		return nil, fmt.Errorf("expected a P-256 key, got %s", priv.Curve.Params().Name)
+	}
+	return &webAuthnPrivKey{priv: priv, rpID: rpID, origin: origin}, nil
+}
+
+// WebAuthnPrivKeyFromSeed derives the P-256 key of a software authenticator,
+// see NewWebAuthnPrivKey, from the 32 byte seed.
+func WebAuthnPrivKeyFromSeed(seed []byte, rpID, origin string) (SecondaryPrivKey, error) {
+	if len(seed) != 32 {
+		return nil, fmt.Errorf("expected a 32 byte seed, got %d bytes", len(seed))
+	}
+	priv, err := p256PrivKeyFromSeed(seed)
+	if err != nil {
+		return nil, err
+	}
+	return NewWebAuthnPrivKey(priv, rpID, origin)
+}
+
+func (k *webAuthnPrivKey) KeyType() types.KeyType { return types.KeyType_KEY_TYPE_WEBAUTHN }
+
+func (k *webAuthnPrivKey) PubKey() []byte {
+	return elliptic.MarshalCompressed(elliptic.P256(), k.priv.X, k.priv.Y)
+}
+
+// Sign returns the encoded types.WebAuthnAssertion whose challenge is hash,
+// with the user present and verified flags set and an incremented signature
+// counter.
+func (k *webAuthnPrivKey) Sign(hash []byte) ([]byte, error) {
+	clientDataJSON, err := json.Marshal(webAuthnClientData{
+		Type:      types.WebAuthnTypeGet,
+		Challenge: base64.RawURLEncoding.EncodeToString(hash),
+		Origin:    k.origin,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	authData := types.WebAuthnRPIDHash(k.rpID)
+	authData = append(authData, types.WebAuthnFlagUserPresent|types.WebAuthnFlagUserVerified)
+	authData = binary.BigEndian.AppendUint32(authData, k.signCount.Add(1))
+
+	digest := sha256.Sum256(types.WebAuthnSignedBytes(authData, clientDataJSON))
+	signature, err := ecdsa.SignASN1(rand.Reader, k.priv, digest[:])
+	if err != nil {
+		return nil, err
+	}
+	assertion := types.WebAuthnAssertion{
+		AuthenticatorData: authData,
+		ClientDataJson:    clientDataJSON,
+		Signature:         signature,
+	}
+	return assertion.Marshal()
+}
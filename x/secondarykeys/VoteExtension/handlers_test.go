@@ -0,0 +1,1167 @@
+package voteextension
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"example/x/secondarykeys/keeper"
+	module "example/x/secondarykeys/module"
+	"example/x/secondarykeys/types"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmted25519 "github.com/cometbft/cometbft/crypto/ed25519"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/libs/protoio"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+type testValidator struct {
+	address      []byte
+	consKey      cmted25519.PrivKey
+	power        int64
+	voteHandler  *VoteExtensionHandler
+	secondaryKey SecondaryKey
+}
+
+// testValStore serves the consensus keys of the test validators.
+type testValStore map[string]cmtprotocrypto.PublicKey
+
+func (s testValStore) GetPubKeyByConsAddr(_ context.Context, addr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error) {
+	pubKey, ok := s[string(addr)]
+	if !ok {
+		return cmtprotocrypto.PublicKey{}, fmt.Errorf("unknown validator %s", addr)
+	}
+	return pubKey, nil
+}
+
+// testMaxTxBytes is the MaxTxBytes of the proposals in the tests.
+const testMaxTxBytes = 1 << 20
+
+// testTxGas is the gas limit of every regular tx in the tests.
+const testTxGas = 1000
+
+// testTx is a regular tx of a proposal.
+type testTx struct{}
+
+func (testTx) GetMsgs() []sdk.Msg                    { return nil }
+func (testTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (testTx) GetGas() uint64                        { return testTxGas }
+
+// testTxVerifier accepts any bytes as a testTx.
+type testTxVerifier struct{}
+
+func (testTxVerifier) PrepareProposalVerifyTx(sdk.Tx) ([]byte, error) {
+	return nil, errors.New("no mempool txs")
+}
+func (testTxVerifier) ProcessProposalVerifyTx([]byte) (sdk.Tx, error) { return testTx{}, nil }
+func (testTxVerifier) TxDecode([]byte) (sdk.Tx, error)                { return testTx{}, nil }
+func (testTxVerifier) TxEncode(sdk.Tx) ([]byte, error)                { return nil, errors.New("not supported") }
+
+// newTestProposalHandler returns a proposal handler selecting the regular txs
+// of a proposal from mp, or the txs passed by CometBFT if mp is nil.
+func newTestProposalHandler(k keeper.Keeper, valStore baseapp.ValidatorStore, mp mempool.Mempool) *ProposalHandler {
+	return NewProposalHandler(log.NewNopLogger(), k, valStore, mp, testTxVerifier{})
+}
+
+// testStakingKeeper serves every consensus address as a validator and jails
+// them in place of the slashing keeper.
+type testStakingKeeper struct {
+	jailed map[string]bool
+}
+
+func (testStakingKeeper) ValidatorAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+}
+
+func (testStakingKeeper) GetValidator(context.Context, sdk.ValAddress) (stakingtypes.Validator, error) {
+	return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+}
+
+func (s testStakingKeeper) ValidatorByConsAddr(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+	return stakingtypes.Validator{Status: stakingtypes.Bonded, Jailed: s.jailed[string(consAddr)]}, nil
+}
+
+func (s testStakingKeeper) Jail(_ context.Context, consAddr sdk.ConsAddress) error {
+	s.jailed[string(consAddr)] = true
+	return nil
+}
+
+func (testStakingKeeper) JailUntil(context.Context, sdk.ConsAddress, time.Time) error {
+	return nil
+}
+
+func (testStakingKeeper) SlashWithInfractionReason(context.Context, sdk.ConsAddress, math.LegacyDec, int64, int64, stakingtypes.Infraction) error {
+	return errors.New("not slashing")
+}
+
+func newTestKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	ctx, k, _ := newTestKeeperWithStaking(t)
+	return ctx, k
+}
+
+// newTestKeeperWithStaking returns a keeper and the staking keeper that jails
+// its validators.
+func newTestKeeperWithStaking(t *testing.T) (sdk.Context, keeper.Keeper, testStakingKeeper) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	stakingKeeper := testStakingKeeper{jailed: make(map[string]bool)}
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addressCodec,
+		authtypes.NewModuleAddress(types.GovModuleName),
+		nil, // the vote extension handlers never recover accounts
+		stakingKeeper,
+		stakingKeeper,
+	)
+	ctx = ctx.WithChainID(ChainID).WithLogger(log.NewNopLogger()).WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+	})
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+	return ctx, k, stakingKeeper
+}
+
+// newTestValidators returns n validators whose secp256k1 secondary keys are
+// registered, in the order CometBFT sorts them, and the store of their
+// consensus keys.
+func newTestValidators(t *testing.T, ctx sdk.Context, k *keeper.Keeper, n int) ([]testValidator, testValStore) {
+	t.Helper()
+
+	return newTestValidatorsWithKeyType(t, ctx, k, n, types.KeyType_KEY_TYPE_SECP256K1)
+}
+
+// newTestSecondaryKey returns a new secondary key of the given type.
+func newTestSecondaryKey(t *testing.T, keyType types.KeyType) SecondaryKey {
+	t.Helper()
+
+	switch keyType {
+	case types.KeyType_KEY_TYPE_BLS12_381:
+		priv, err := types.GenBLS12381PrivKey()
+		require.NoError(t, err)
+		key, err := NewBLS12381SecondaryKey(priv)
+		require.NoError(t, err)
+		return key
+	default:
+		priv, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		return NewSecp256k1SecondaryKey(priv)
+	}
+}
+
+// newTestValidatorsWithKeyType returns n validators whose secondary keys of
+// the given type are registered, like newTestValidators.
+func newTestValidatorsWithKeyType(t *testing.T, ctx sdk.Context, k *keeper.Keeper, n int, keyType types.KeyType) ([]testValidator, testValStore) {
+	t.Helper()
+
+	valStore := make(testValStore)
+	validators := make([]testValidator, n)
+	for i := range validators {
+		secondaryKey := newTestSecondaryKey(t, keyType)
+		consKey := cmted25519.GenPrivKey()
+		consPubKey, err := cryptoenc.PubKeyToProto(consKey.PubKey())
+		require.NoError(t, err)
+		address := consKey.PubKey().Address().Bytes()
+		valStore[string(address)] = consPubKey
+
+		require.NoError(t, k.SetSecondaryPubKeyVoteExtension(ctx, address, secondaryKey.PubKey()))
+		validators[i] = testValidator{
+			address:      address,
+			consKey:      consKey,
+			power:        int64(10 * (n - i)),
+			voteHandler:  NewVoteExtensionHandler(k, NewLocalSigner(secondaryKey)),
+			secondaryKey: secondaryKey,
+		}
+	}
+	return validators, valStore
+}
+
+// blockHash returns a distinct hash for the block at height.
+func blockHash(height int64) []byte {
+	return crypto.Keccak256([]byte{byte(height)})
+}
+
+// extendedCommit returns the commit of the validators on the block at height,
+// with their vote extensions signed by their consensus keys.
+func extendedCommit(t *testing.T, ctx sdk.Context, validators []testValidator, height int64, extensions [][]byte) abci.ExtendedCommitInfo {
+	t.Helper()
+
+	var commit abci.ExtendedCommitInfo
+	for i, val := range validators {
+		signBytes, err := protoio.MarshalDelimited(&cmtproto.CanonicalVoteExtension{
+			Extension: extensions[i],
+			Height:    height,
+			ChainId:   ctx.ChainID(),
+		})
+		require.NoError(t, err)
+		extSig, err := val.consKey.Sign(signBytes)
+		require.NoError(t, err)
+		commit.Votes = append(commit.Votes, abci.ExtendedVoteInfo{
+			Validator:          abci.Validator{Address: val.address, Power: val.power},
+			VoteExtension:      extensions[i],
+			ExtensionSignature: extSig,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		})
+	}
+	return commit
+}
+
+// lastCommit returns the commit info CometBFT passes along with commit.
+func lastCommit(commit abci.ExtendedCommitInfo) abci.CommitInfo {
+	lastCommit := abci.CommitInfo{Round: commit.Round}
+	for _, vote := range commit.Votes {
+		lastCommit.Votes = append(lastCommit.Votes, abci.VoteInfo{Validator: vote.Validator, BlockIdFlag: vote.BlockIdFlag})
+	}
+	return lastCommit
+}
+
+// proposalContext returns the context of a proposal at height whose last
+// commit is commit.
+func proposalContext(ctx sdk.Context, height int64, commit abci.ExtendedCommitInfo) sdk.Context {
+	return ctx.
+		WithBlockHeight(height).
+		WithHeaderInfo(header.Info{Height: height, ChainID: ctx.ChainID()}).
+		WithCometInfo(baseapp.NewBlockInfo(nil, nil, nil, lastCommit(commit)))
+}
+
+// extendVotes runs ExtendVote for every validator on the block at height.
+func extendVotes(t *testing.T, ctx sdk.Context, validators []testValidator, height int64, hash []byte) [][]byte {
+	t.Helper()
+
+	extensions := make([][]byte, len(validators))
+	for i, val := range validators {
+		extendRes, err := val.voteHandler.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: height, Hash: hash})
+		require.NoError(t, err)
+		extensions[i] = extendRes.VoteExtension
+	}
+	return extensions
+}
+
+func TestVoteExtensionHandlersAcrossHeights(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	validators, valStore := newTestValidators(t, ctx, &k, 3)
+	proposalHandler := newTestProposalHandler(k, valStore, nil)
+
+	// a validator without a registered secondary key votes without counting
+	// towards the attestation
+	unregistered, unregisteredStore := newTestValidators(t, ctx, &k, 1)
+	require.NoError(t, k.VoteExtensionMap.Remove(ctx, unregistered[0].address))
+	unregistered[0].power = 5
+	for addr, pubKey := range unregisteredStore {
+		valStore[addr] = pubKey
+	}
+	validators = append(validators, unregistered[0])
+
+	for height := int64(2); height <= 5; height++ {
+		hash := blockHash(height)
+		ctx := ctx.WithBlockHeight(height).WithHeaderHash(hash)
+		// The block at height records its hash when it is finalized.
+		require.NoError(t, k.LastBlockHash.Set(ctx, hash))
+
+		extensions := extendVotes(t, ctx, validators, height, hash)
+		for i, val := range validators {
+			// every validator accepts the extension
+			for _, verifier := range validators {
+				verifyRes, err := verifier.voteHandler.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{
+					Height:           height,
+					Hash:             hash,
+					ValidatorAddress: val.address,
+					VoteExtension:    extensions[i],
+				})
+				require.NoError(t, err)
+				require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyRes.Status, "height %d", height)
+			}
+			if i == len(validators)-1 {
+				continue
+			}
+
+			// an empty extension is accepted, a malformed one is not
+			verifyRes, err := val.voteHandler.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{
+				Height:           height,
+				Hash:             hash,
+				ValidatorAddress: val.address,
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyRes.Status)
+			verifyRes, err = val.voteHandler.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{
+				Height:           height,
+				Hash:             hash,
+				ValidatorAddress: val.address,
+				VoteExtension:    extensions[i][:len(extensions[i])-1],
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verifyRes.Status)
+
+			// the extension does not verify for another height, block or chain
+			for _, req := range []*abci.RequestVerifyVoteExtension{
+				{Height: height + 1, Hash: hash},
+				{Height: height, Hash: blockHash(height + 1)},
+			} {
+				req.ValidatorAddress = val.address
+				req.VoteExtension = extensions[i]
+				verifyRes, err := val.voteHandler.VerifyVoteExtensionHandler()(ctx, req)
+				require.NoError(t, err)
+				require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verifyRes.Status)
+			}
+			verifyRes, err = val.voteHandler.VerifyVoteExtensionHandler()(ctx.WithChainID("other"), &abci.RequestVerifyVoteExtension{
+				Height:           height,
+				Hash:             hash,
+				ValidatorAddress: val.address,
+				VoteExtension:    extensions[i],
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verifyRes.Status)
+		}
+
+		// The extensions are included in the proposal of the next block.
+		commit := extendedCommit(t, ctx, validators, height, extensions)
+		nextCtx := proposalContext(ctx, height+1, commit)
+		prepareRes, err := proposalHandler.PrepareProposal()(nextCtx, &abci.RequestPrepareProposal{
+			Height:          height + 1,
+			MaxTxBytes:      testMaxTxBytes,
+			LocalLastCommit: commit,
+		})
+		require.NoError(t, err)
+		require.Len(t, prepareRes.Txs, 1)
+
+		processRes, err := proposalHandler.ProcessProposal()(nextCtx, &abci.RequestProcessProposal{
+			Height: height + 1,
+			Txs:    prepareRes.Txs,
+		})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Status, "height %d", height)
+
+		// The block records the attestation, weighted by the voting power in
+		// the last commit.
+		require.NoError(t, proposalHandler.PreBlocker(nextCtx, &abci.RequestFinalizeBlock{
+			Height:            height + 1,
+			Txs:               prepareRes.Txs,
+			DecidedLastCommit: lastCommit(commit),
+		}))
+		attestation, err := k.Attestations.Get(ctx, height)
+		require.NoError(t, err)
+		require.Equal(t, height, attestation.Height)
+		require.Equal(t, hash, attestation.BlockHash)
+		require.Len(t, attestation.Signatures, 3)
+		require.Equal(t, int64(60), attestation.SignedVotingPower)
+		require.Equal(t, int64(65), attestation.TotalVotingPower)
+		for i, sig := range attestation.Signatures {
+			require.Equal(t, sdk.ConsAddress(validators[i].address).String(), sig.ConsensusAddress)
+			require.Equal(t, validators[i].power, sig.VotingPower)
+		}
+
+		// The same extensions are rejected in a proposal for any other height,
+		// block or chain.
+		processRes, err = proposalHandler.ProcessProposal()(nextCtx, &abci.RequestProcessProposal{
+			Height: height + 2,
+			Txs:    prepareRes.Txs,
+		})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+
+		processRes, err = proposalHandler.ProcessProposal()(nextCtx.WithChainID("other"), &abci.RequestProcessProposal{
+			Height: height + 1,
+			Txs:    prepareRes.Txs,
+		})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+
+		require.NoError(t, k.LastBlockHash.Set(ctx, blockHash(height+1)))
+		processRes, err = proposalHandler.ProcessProposal()(nextCtx, &abci.RequestProcessProposal{
+			Height: height + 1,
+			Txs:    prepareRes.Txs,
+		})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+	}
+}
+
+func TestProcessProposalValidatesInjectedTx(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	validators, valStore := newTestValidators(t, ctx, &k, 3)
+	proposalHandler := newTestProposalHandler(k, valStore, nil)
+	height := int64(2)
+	hash := blockHash(height)
+	require.NoError(t, k.LastBlockHash.Set(ctx, hash))
+
+	extensions := extendVotes(t, ctx, validators, height, hash)
+	commit := extendedCommit(t, ctx, validators, height, extensions)
+	nextCtx := proposalContext(ctx, height+1, commit)
+
+	prepareRes, err := proposalHandler.PrepareProposal()(nextCtx, &abci.RequestPrepareProposal{
+		Height:          height + 1,
+		MaxTxBytes:      testMaxTxBytes,
+		LocalLastCommit: commit,
+	})
+	require.NoError(t, err)
+	require.Len(t, prepareRes.Txs, 1)
+
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	otherSig, err := crypto.Sign(types.VoteExtensionSignBytes(ChainID, height, hash), other)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		malleate func(tx *types.InjectedVoteExtensionTx)
+		status   abci.ResponseProcessProposal_ProposalStatus
+	}{
+		{
+			name:     "valid",
+			malleate: func(*types.InjectedVoteExtensionTx) {},
+			status:   abci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			name: "duplicate validator",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.ValidatorSignatures = append(tx.ValidatorSignatures, tx.ValidatorSignatures[0])
+			},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name: "no more than 2/3 of the voting power",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				// 30 of 60
+				tx.ValidatorSignatures = tx.ValidatorSignatures[1:]
+			},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name: "signature not in the vote extension",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.ValidatorSignatures[0].Signature = otherSig
+			},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name: "validator outside the last commit",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.ValidatorSignatures[0].ValidatorAddress = []byte("unknown")
+			},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name: "forged vote extension",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				ext, err := types.EncodeVoteExtension(&types.VoteExtension{Signature: otherSig})
+				require.NoError(t, err)
+				tx.ExtendedCommitInfo.Votes[0].VoteExtension = ext
+				tx.ValidatorSignatures[0].Signature = otherSig
+			},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name: "forged voting power",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.ExtendedCommitInfo.Votes[0].Validator.Power = 100
+			},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name: "validator left out of the commit",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.ExtendedCommitInfo.Votes = tx.ExtendedCommitInfo.Votes[:2]
+			},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+		{
+			name: "last commit only",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.ValidatorSignatures = nil
+			},
+			status: abci.ResponseProcessProposal_ACCEPT,
+		},
+		{
+			name: "last commit only, validator left out",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.ValidatorSignatures = nil
+				tx.ExtendedCommitInfo.Votes = tx.ExtendedCommitInfo.Votes[:2]
+			},
+			status: abci.ResponseProcessProposal_REJECT,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			injectedTx, err := types.DecodeInjectedVoteExtensionTx(prepareRes.Txs[0])
+			require.NoError(t, err)
+			tc.malleate(&injectedTx)
+			tx, err := types.EncodeInjectedVoteExtensionTx(&injectedTx)
+			require.NoError(t, err)
+
+			processRes, err := proposalHandler.ProcessProposal()(nextCtx, &abci.RequestProcessProposal{
+				Height: height + 1,
+				Txs:    [][]byte{tx},
+			})
+			require.NoError(t, err)
+			require.Equal(t, tc.status, processRes.Status)
+		})
+	}
+
+	// A first tx with the magic prefix that does not decode is rejected, and
+	// so is a proposal without a signature tx.
+	for _, txs := range [][][]byte{
+		{prepareRes.Txs[0][:len(prepareRes.Txs[0])-1]},
+		{types.InjectedTxMagic},
+		{[]byte(`{"validator_signatures":[]}`)},
+		nil,
+	} {
+		processRes, err := proposalHandler.ProcessProposal()(nextCtx, &abci.RequestProcessProposal{
+			Height: height + 1,
+			Txs:    txs,
+		})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+	}
+
+	// Before vote extensions are enabled a proposal has no signature tx.
+	disabledCtx := nextCtx.WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: height + 1},
+	})
+	for txs, status := range map[string]abci.ResponseProcessProposal_ProposalStatus{
+		"":                            abci.ResponseProcessProposal_ACCEPT,
+		`{"validator_signatures":[]}`: abci.ResponseProcessProposal_ACCEPT,
+		string(prepareRes.Txs[0]):     abci.ResponseProcessProposal_REJECT,
+	} {
+		req := &abci.RequestProcessProposal{Height: height + 1}
+		if txs != "" {
+			req.Txs = [][]byte{[]byte(txs)}
+		}
+		processRes, err := proposalHandler.ProcessProposal()(disabledCtx, req)
+		require.NoError(t, err)
+		require.Equal(t, status, processRes.Status)
+	}
+	disabledRes, err := proposalHandler.PrepareProposal()(disabledCtx, &abci.RequestPrepareProposal{
+		Height:          height + 1,
+		MaxTxBytes:      testMaxTxBytes,
+		LocalLastCommit: commit,
+	})
+	require.NoError(t, err)
+	require.Empty(t, disabledRes.Txs)
+
+	// the signature tx can only be the first tx
+	processRes, err := proposalHandler.ProcessProposal()(nextCtx, &abci.RequestProcessProposal{
+		Height: height + 1,
+		Txs:    [][]byte{[]byte(`{"validator_signatures":[]}`), prepareRes.Txs[0]},
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+}
+
+func TestAggregatedVoteExtensions(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	// three validators with BLS12-381 keys, 30 + 20 + 10, and one with a
+	// secp256k1 key, 5
+	validators, valStore := newTestValidatorsWithKeyType(t, ctx, &k, 3, types.KeyType_KEY_TYPE_BLS12_381)
+	secpValidators, secpStore := newTestValidators(t, ctx, &k, 1)
+	secpValidators[0].power = 5
+	for addr, pubKey := range secpStore {
+		valStore[addr] = pubKey
+	}
+	validators = append(validators, secpValidators[0])
+	proposalHandler := newTestProposalHandler(k, valStore, nil)
+
+	height := int64(2)
+	hash := blockHash(height)
+	require.NoError(t, k.LastBlockHash.Set(ctx, hash))
+
+	extensions := extendVotes(t, ctx, validators, height, hash)
+	commit := extendedCommit(t, ctx, validators, height, extensions)
+	nextCtx := proposalContext(ctx, height+1, commit)
+
+	prepareRes, err := proposalHandler.PrepareProposal()(nextCtx, &abci.RequestPrepareProposal{
+		Height:          height + 1,
+		MaxTxBytes:      testMaxTxBytes,
+		LocalLastCommit: commit,
+	})
+	require.NoError(t, err)
+	require.Len(t, prepareRes.Txs, 1)
+
+	// the BLS12-381 signatures are aggregated, the secp256k1 one is not
+	injectedTx, err := types.DecodeInjectedVoteExtensionTx(prepareRes.Txs[0])
+	require.NoError(t, err)
+	require.Len(t, injectedTx.ValidatorSignatures, 1)
+	require.Equal(t, validators[3].address, injectedTx.ValidatorSignatures[0].ValidatorAddress)
+	require.NotNil(t, injectedTx.AggregateSignature)
+	require.Equal(t, []byte{0b0111}, injectedTx.AggregateSignature.Signers)
+	require.Len(t, injectedTx.AggregateSignature.Signature, types.BLS12381SignatureSize)
+
+	// the aggregate is smaller than the signatures it replaces
+	individualTx := injectedTx
+	individualTx.AggregateSignature = nil
+	for i, val := range validators[:3] {
+		voteExt, err := types.DecodeVoteExtension(extensions[i])
+		require.NoError(t, err)
+		individualTx.ValidatorSignatures = append(individualTx.ValidatorSignatures, types.VoteExtensionSignature{
+			ValidatorAddress: val.address,
+			Signature:        voteExt.Signature,
+		})
+	}
+	individual, err := types.EncodeInjectedVoteExtensionTx(&individualTx)
+	require.NoError(t, err)
+	require.Less(t, len(prepareRes.Txs[0]), len(individual))
+
+	// both are accepted
+	for _, tx := range [][]byte{prepareRes.Txs[0], individual} {
+		processRes, err := proposalHandler.ProcessProposal()(nextCtx, &abci.RequestProcessProposal{
+			Height: height + 1,
+			Txs:    [][]byte{tx},
+		})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Status)
+	}
+
+	require.NoError(t, proposalHandler.PreBlocker(nextCtx, &abci.RequestFinalizeBlock{
+		Height:            height + 1,
+		Txs:               prepareRes.Txs,
+		DecidedLastCommit: lastCommit(commit),
+	}))
+	attestation, err := k.Attestations.Get(ctx, height)
+	require.NoError(t, err)
+	require.Len(t, attestation.Signatures, 1)
+	require.Equal(t, injectedTx.AggregateSignature.Signature, attestation.AggregateSignature)
+	require.Equal(t, int64(65), attestation.SignedVotingPower)
+	require.Equal(t, int64(65), attestation.TotalVotingPower)
+
+	// external verifiers check the aggregate against the signers' keys
+	pubKeys := make([][]byte, len(attestation.AggregateSigners))
+	for i, signer := range attestation.AggregateSigners {
+		require.Equal(t, sdk.ConsAddress(validators[i].address).String(), signer.ConsensusAddress)
+		require.Equal(t, validators[i].power, signer.VotingPower)
+		pubKeys[i] = validators[i].secondaryKey.PubKey()
+	}
+	signBytes := types.VoteExtensionSignBytes(ChainID, height, hash)
+	require.True(t, types.VerifyBLS12381Aggregate(pubKeys, signBytes, attestation.AggregateSignature))
+
+	otherSig, err := newTestSecondaryKey(t, types.KeyType_KEY_TYPE_BLS12_381).Sign(signBytes)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name     string
+		malleate func(tx *types.InjectedVoteExtensionTx)
+	}{
+		{
+			name: "signer left out of the bitmap",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.AggregateSignature.Signers = []byte{0b0011}
+			},
+		},
+		{
+			name: "secp256k1 validator in the bitmap",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.AggregateSignature.Signers = []byte{0b1111}
+			},
+		},
+		{
+			name: "bit beyond the votes",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.AggregateSignature.Signers = []byte{0b10111}
+			},
+		},
+		{
+			name: "bitmap too long",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.AggregateSignature.Signers = []byte{0b0111, 0}
+			},
+		},
+		{
+			name: "forged aggregate",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.AggregateSignature.Signature = otherSig
+			},
+		},
+		{
+			name: "truncated aggregate",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.AggregateSignature.Signature = tx.AggregateSignature.Signature[1:]
+			},
+		},
+		{
+			name: "signer also signing individually",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.ValidatorSignatures = append(tx.ValidatorSignatures, individualTx.ValidatorSignatures[1])
+			},
+		},
+		{
+			name: "no more than 2/3 of the voting power",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				// 35 of 65
+				sig, err := types.DecodeVoteExtension(extensions[2])
+				require.NoError(t, err)
+				tx.AggregateSignature = &types.AggregateSignature{Signers: []byte{0b0100}, Signature: sig.Signature}
+				tx.ValidatorSignatures = append(tx.ValidatorSignatures, individualTx.ValidatorSignatures[2])
+			},
+		},
+		{
+			name: "signer that did not vote for the block",
+			malleate: func(tx *types.InjectedVoteExtensionTx) {
+				tx.ExtendedCommitInfo.Votes[2].BlockIdFlag = cmtproto.BlockIDFlagNil
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			injectedTx, err := types.DecodeInjectedVoteExtensionTx(prepareRes.Txs[0])
+			require.NoError(t, err)
+			tc.malleate(&injectedTx)
+			tx, err := types.EncodeInjectedVoteExtensionTx(&injectedTx)
+			require.NoError(t, err)
+
+			processRes, err := proposalHandler.ProcessProposal()(nextCtx, &abci.RequestProcessProposal{
+				Height: height + 1,
+				Txs:    [][]byte{tx},
+			})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+		})
+	}
+}
+
+func TestPreBlockerSkipsInvalidAggregate(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	validators, valStore := newTestValidatorsWithKeyType(t, ctx, &k, 3, types.KeyType_KEY_TYPE_BLS12_381)
+	proposalHandler := newTestProposalHandler(k, valStore, nil)
+	height := int64(2)
+	hash := blockHash(height)
+	require.NoError(t, k.LastBlockHash.Set(ctx, hash))
+
+	extensions := extendVotes(t, ctx, validators, height, hash)
+	commit := extendedCommit(t, ctx, validators, height, extensions)
+	nextCtx := proposalContext(ctx, height+1, commit)
+
+	prepareRes, err := proposalHandler.PrepareProposal()(nextCtx, &abci.RequestPrepareProposal{
+		Height:          height + 1,
+		MaxTxBytes:      testMaxTxBytes,
+		LocalLastCommit: commit,
+	})
+	require.NoError(t, err)
+	require.Len(t, prepareRes.Txs, 1)
+
+	// a node catching up does not run ProcessProposal; the aggregate is
+	// verified again before it is recorded
+	injectedTx, err := types.DecodeInjectedVoteExtensionTx(prepareRes.Txs[0])
+	require.NoError(t, err)
+	injectedTx.AggregateSignature.Signers = []byte{0b011}
+	tx, err := types.EncodeInjectedVoteExtensionTx(&injectedTx)
+	require.NoError(t, err)
+
+	require.NoError(t, proposalHandler.PreBlocker(nextCtx, &abci.RequestFinalizeBlock{
+		Height:            height + 1,
+		Txs:               [][]byte{tx},
+		DecidedLastCommit: lastCommit(commit),
+	}))
+	_, err = k.Attestations.Get(ctx, height)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestPrepareProposalRequiresVotingPower(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	validators, valStore := newTestValidators(t, ctx, &k, 3)
+	proposalHandler := newTestProposalHandler(k, valStore, nil)
+	height := int64(2)
+	hash := blockHash(height)
+	require.NoError(t, k.LastBlockHash.Set(ctx, hash))
+
+	// the validator with the most voting power has no registered key
+	require.NoError(t, k.VoteExtensionMap.Remove(ctx, validators[0].address))
+
+	extensions := extendVotes(t, ctx, validators, height, hash)
+	commit := extendedCommit(t, ctx, validators, height, extensions)
+	nextCtx := proposalContext(ctx, height+1, commit)
+
+	prepareRes, err := proposalHandler.PrepareProposal()(nextCtx, &abci.RequestPrepareProposal{
+		Height:          height + 1,
+		MaxTxBytes:      testMaxTxBytes,
+		LocalLastCommit: commit,
+		Txs:             [][]byte{[]byte("tx")},
+	})
+	require.NoError(t, err)
+	require.Len(t, prepareRes.Txs, 2)
+	require.Equal(t, []byte("tx"), prepareRes.Txs[1])
+
+	// the signature tx only carries the last commit, and is accepted
+	injectedTx, err := types.DecodeInjectedVoteExtensionTx(prepareRes.Txs[0])
+	require.NoError(t, err)
+	require.Empty(t, injectedTx.ValidatorSignatures)
+	require.Nil(t, injectedTx.AggregateSignature)
+	require.Equal(t, commit, injectedTx.ExtendedCommitInfo)
+	processRes, err := proposalHandler.ProcessProposal()(nextCtx, &abci.RequestProcessProposal{
+		Height: height + 1,
+		Txs:    prepareRes.Txs,
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, processRes.Status)
+
+	// the block attests nothing, but the vote extensions are still tracked
+	require.NoError(t, proposalHandler.PreBlocker(nextCtx, &abci.RequestFinalizeBlock{
+		Height:            height + 1,
+		Txs:               prepareRes.Txs,
+		DecidedLastCommit: lastCommit(commit),
+	}))
+	_, err = k.Attestations.Get(ctx, height)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	for i, val := range validators {
+		info, err := k.ExtensionSigningInfos.Get(ctx, val.address)
+		if i == 0 {
+			require.ErrorIs(t, err, collections.ErrNotFound)
+			continue
+		}
+		require.NoError(t, err)
+		require.Zero(t, info.MissedBlocksCounter)
+	}
+}
+
+func TestProposalHandlerRespectsBlockLimits(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	validators, valStore := newTestValidators(t, ctx, &k, 3)
+	proposalHandler := newTestProposalHandler(k, valStore, nil)
+	height := int64(2)
+	hash := blockHash(height)
+	require.NoError(t, k.LastBlockHash.Set(ctx, hash))
+
+	extensions := extendVotes(t, ctx, validators, height, hash)
+	commit := extendedCommit(t, ctx, validators, height, extensions)
+	nextCtx := proposalContext(ctx, height+1, commit)
+	withMaxGas := func(maxGas int64) sdk.Context {
+		params := nextCtx.ConsensusParams()
+		params.Block = &cmtproto.BlockParams{MaxGas: maxGas}
+		return nextCtx.WithConsensusParams(params)
+	}
+
+	prepareRes, err := proposalHandler.PrepareProposal()(nextCtx, &abci.RequestPrepareProposal{
+		Height:          height + 1,
+		MaxTxBytes:      testMaxTxBytes,
+		LocalLastCommit: commit,
+	})
+	require.NoError(t, err)
+	require.Len(t, prepareRes.Txs, 1)
+	injectedTx := prepareRes.Txs[0]
+	injectedTxSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{injectedTx})
+
+	txs := make([][]byte, 10)
+	for i := range txs {
+		txs[i] = bytes.Repeat([]byte{byte(i)}, 100)
+	}
+	txSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{txs[0]})
+
+	testCases := []struct {
+		name       string
+		ctx        sdk.Context
+		maxTxBytes int64
+		expTxs     [][]byte
+		errMsg     string
+	}{
+		{
+			name:       "no limits",
+			ctx:        nextCtx,
+			maxTxBytes: testMaxTxBytes,
+			expTxs:     append([][]byte{injectedTx}, txs...),
+		},
+		{
+			name:       "user txs trimmed to max tx bytes",
+			ctx:        nextCtx,
+			maxTxBytes: injectedTxSize + 3*txSize,
+			expTxs:     append([][]byte{injectedTx}, txs[:3]...),
+		},
+		{
+			name:       "signature tx larger than max tx bytes",
+			ctx:        nextCtx,
+			maxTxBytes: injectedTxSize - 1,
+			errMsg:     "exceeds max tx bytes",
+		},
+		{
+			name:       "user txs trimmed to the block gas limit",
+			ctx:        withMaxGas(types.InjectedTxGasLimit + 2*testTxGas),
+			maxTxBytes: testMaxTxBytes,
+			expTxs:     append([][]byte{injectedTx}, txs[:2]...),
+		},
+		{
+			name:       "no room for the signature tx gas",
+			ctx:        withMaxGas(5 * testTxGas),
+			maxTxBytes: testMaxTxBytes,
+			errMsg:     "leaves no room for the 100000 gas of the signature tx",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			prepareRes, err := proposalHandler.PrepareProposal()(tc.ctx, &abci.RequestPrepareProposal{
+				Height:          height + 1,
+				MaxTxBytes:      tc.maxTxBytes,
+				LocalLastCommit: commit,
+				Txs:             txs,
+			})
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expTxs, prepareRes.Txs)
+
+			var size int64
+			for _, tx := range prepareRes.Txs {
+				size += cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{tx})
+			}
+			require.LessOrEqual(t, size, tc.maxTxBytes)
+		})
+	}
+
+	// With an app mempool, ProcessProposal checks the gas of the user txs
+	// against what the signature tx leaves of the block gas limit.
+	proposalHandler = newTestProposalHandler(k, valStore, mempool.NewSenderNonceMempool())
+	gasCtx := withMaxGas(types.InjectedTxGasLimit + 2*testTxGas)
+	for n, status := range map[int]abci.ResponseProcessProposal_ProposalStatus{
+		2: abci.ResponseProcessProposal_ACCEPT,
+		3: abci.ResponseProcessProposal_REJECT,
+	} {
+		processRes, err := proposalHandler.ProcessProposal()(gasCtx, &abci.RequestProcessProposal{
+			Height: height + 1,
+			Txs:    append([][]byte{injectedTx}, txs[:n]...),
+		})
+		require.NoError(t, err)
+		require.Equal(t, status, processRes.Status, "%d txs", n)
+	}
+	processRes, err := proposalHandler.ProcessProposal()(withMaxGas(types.InjectedTxGasLimit), &abci.RequestProcessProposal{
+		Height: height + 1,
+		Txs:    [][]byte{injectedTx},
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+}
+
+func TestVoteExtensionHandlersDoNotRegisterKeys(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	validators, valStore := newTestValidators(t, ctx, &k, 2)
+	proposalHandler := newTestProposalHandler(k, valStore, nil)
+	height := int64(2)
+	hash := blockHash(height)
+	require.NoError(t, k.LastBlockHash.Set(ctx, hash))
+
+	// the first validator is not registered, the second one signs with a key
+	// other than its registered one
+	require.NoError(t, k.VoteExtensionMap.Remove(ctx, validators[0].address))
+	other, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	registered := crypto.FromECDSAPub(&other.PublicKey)
+	require.NoError(t, k.SetSecondaryPubKeyVoteExtension(ctx, validators[1].address, registered))
+
+	extensions := extendVotes(t, ctx, validators, height, hash)
+	commit := extendedCommit(t, ctx, validators, height, extensions)
+	nextCtx := proposalContext(ctx, height+1, commit)
+
+	// The extension of the unregistered validator is ignored, the other one is
+	// rejected.
+	for i, status := range []abci.ResponseVerifyVoteExtension_VerifyStatus{
+		abci.ResponseVerifyVoteExtension_ACCEPT,
+		abci.ResponseVerifyVoteExtension_REJECT,
+	} {
+		verifyRes, err := validators[i].voteHandler.VerifyVoteExtensionHandler()(ctx, &abci.RequestVerifyVoteExtension{
+			Height:           height,
+			Hash:             hash,
+			ValidatorAddress: validators[i].address,
+			VoteExtension:    extensions[i],
+		})
+		require.NoError(t, err)
+		require.Equal(t, status, verifyRes.Status)
+	}
+
+	// Neither extension is included in a proposal, and a proposal that
+	// includes them is rejected.
+	prepareRes, err := proposalHandler.PrepareProposal()(nextCtx, &abci.RequestPrepareProposal{
+		Height:          height + 1,
+		MaxTxBytes:      testMaxTxBytes,
+		LocalLastCommit: commit,
+	})
+	require.NoError(t, err)
+	require.Len(t, prepareRes.Txs, 1)
+	injectedTx, err := types.DecodeInjectedVoteExtensionTx(prepareRes.Txs[0])
+	require.NoError(t, err)
+	require.Empty(t, injectedTx.ValidatorSignatures)
+
+	injectedTx.ExtendedCommitInfo = commit
+	for i, val := range validators {
+		voteExt, err := types.DecodeVoteExtension(extensions[i])
+		require.NoError(t, err)
+		injectedTx.ValidatorSignatures = append(injectedTx.ValidatorSignatures, types.VoteExtensionSignature{
+			ValidatorAddress: val.address,
+			Signature:        voteExt.Signature,
+		})
+	}
+	tx, err := types.EncodeInjectedVoteExtensionTx(&injectedTx)
+	require.NoError(t, err)
+	processRes, err := proposalHandler.ProcessProposal()(nextCtx, &abci.RequestProcessProposal{
+		Height: height + 1,
+		Txs:    [][]byte{tx},
+	})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_REJECT, processRes.Status)
+
+	// the registry is unchanged
+	exists, err := k.VoteExtensionMap.Has(ctx, validators[0].address)
+	require.NoError(t, err)
+	require.False(t, exists)
+	pubKey, err := k.VoteExtensionMap.Get(ctx, validators[1].address)
+	require.NoError(t, err)
+	require.Equal(t, registered, pubKey)
+}
+
+func TestPreBlockerCountsValidatorsOnce(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	validators, valStore := newTestValidators(t, ctx, &k, 2)
+	proposalHandler := newTestProposalHandler(k, valStore, nil)
+	height := int64(2)
+	hash := blockHash(height)
+	require.NoError(t, k.LastBlockHash.Set(ctx, hash))
+
+	var injected types.InjectedVoteExtensionTx
+	for i, extension := range extendVotes(t, ctx, validators, height, hash) {
+		voteExt, err := types.DecodeVoteExtension(extension)
+		require.NoError(t, err)
+		injected.ValidatorSignatures = append(injected.ValidatorSignatures, types.VoteExtensionSignature{
+			ValidatorAddress: validators[i].address,
+			Signature:        voteExt.Signature,
+		})
+	}
+	// the first signature is repeated, and the second validator is not in
+	// the last commit
+	injected.ValidatorSignatures = append(injected.ValidatorSignatures, injected.ValidatorSignatures[0])
+	tx, err := types.EncodeInjectedVoteExtensionTx(&injected)
+	require.NoError(t, err)
+
+	require.NoError(t, proposalHandler.PreBlocker(ctx, &abci.RequestFinalizeBlock{
+		Height: height + 1,
+		Txs:    [][]byte{tx},
+		DecidedLastCommit: abci.CommitInfo{Votes: []abci.VoteInfo{
+			{Validator: abci.Validator{Address: validators[0].address, Power: 10}},
+		}},
+	}))
+	attestation, err := k.Attestations.Get(ctx, height)
+	require.NoError(t, err)
+	require.Len(t, attestation.Signatures, 1)
+	require.Equal(t, int64(10), attestation.SignedVotingPower)
+	require.Equal(t, int64(10), attestation.TotalVotingPower)
+
+	// blocks without an injected transaction record no attestation
+	require.NoError(t, proposalHandler.PreBlocker(ctx, &abci.RequestFinalizeBlock{Height: height + 2, Txs: [][]byte{[]byte("tx")}}))
+	_, err = k.Attestations.Get(ctx, height+1)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestPreBlockerJailsValidatorsMissingVoteExtensions(t *testing.T) {
+	ctx, k, stakingKeeper := newTestKeeperWithStaking(t)
+	validators, valStore := newTestValidators(t, ctx, &k, 4)
+	proposalHandler := newTestProposalHandler(k, valStore, nil)
+
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 4
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	// validator 2 omits its vote extension, and the proposer leaves the
+	// signature of validator 1 out, which does not count as a miss
+	for height := int64(1); height <= 6; height++ {
+		hash := blockHash(height)
+		require.NoError(t, k.LastBlockHash.Set(ctx, hash))
+		extensions := extendVotes(t, ctx, validators, height, hash)
+		extensions[2] = nil
+		commit := extendedCommit(t, ctx, validators, height, extensions)
+
+		injected := types.InjectedVoteExtensionTx{ExtendedCommitInfo: commit}
+		for _, i := range []int{0, 3} {
+			voteExt, err := types.DecodeVoteExtension(extensions[i])
+			require.NoError(t, err)
+			injected.ValidatorSignatures = append(injected.ValidatorSignatures, types.VoteExtensionSignature{
+				ValidatorAddress: validators[i].address,
+				Signature:        voteExt.Signature,
+			})
+		}
+		tx, err := types.EncodeInjectedVoteExtensionTx(&injected)
+		require.NoError(t, err)
+
+		finalizeCtx := ctx.WithBlockHeight(height + 1)
+		require.NoError(t, proposalHandler.PreBlocker(finalizeCtx, &abci.RequestFinalizeBlock{
+			Height:            height + 1,
+			Txs:               [][]byte{tx},
+			DecidedLastCommit: lastCommit(commit),
+		}))
+	}
+
+	for i, val := range validators {
+		require.Equal(t, i == 2, stakingKeeper.jailed[string(val.address)], "validator %d", i)
+	}
+	info, err := k.ExtensionSigningInfos.Get(ctx, validators[1].address)
+	require.NoError(t, err)
+	require.Zero(t, info.MissedBlocksCounter)
+	_, err = k.ExtensionSigningInfos.Get(ctx, validators[2].address)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+// recordingSigner records the height of every signing request.
+type recordingSigner struct {
+	Signer
+	heights []int64
+}
+
+func (s *recordingSigner) SignVoteExtension(chainID string, height int64, hash []byte) ([]byte, error) {
+	s.heights = append(s.heights, height)
+	return s.Signer.SignVoteExtension(chainID, height, hash)
+}
+
+func TestExtendVoteOneBlockPerHeight(t *testing.T) {
+	ctx, k := newTestKeeper(t)
+	signer := &recordingSigner{Signer: NewLocalSigner(newTestSecondaryKey(t, types.KeyType_KEY_TYPE_SECP256K1))}
+	handler := NewVoteExtensionHandler(&k, signer)
+
+	// a block is extended again, but no other block at the same height or
+	// any block at a lower height
+	for _, req := range []struct {
+		height  int64
+		hash    []byte
+		wantErr string
+	}{
+		{height: 5, hash: blockHash(5)},
+		{height: 5, hash: blockHash(6), wantErr: "already extended"},
+		{height: 5, hash: blockHash(5)},
+		{height: 6, hash: blockHash(6)},
+		{height: 5, hash: blockHash(5), wantErr: "below the last extended height"},
+	} {
+		_, err := handler.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: req.height, Hash: req.hash})
+		if req.wantErr != "" {
+			require.ErrorContains(t, err, req.wantErr)
+			continue
+		}
+		require.NoError(t, err)
+	}
+	require.Equal(t, []int64{5, 5, 6}, signer.heights)
+
+	_, err := NewVoteExtensionHandler(&k, nil).ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 7, Hash: blockHash(7)})
+	require.ErrorContains(t, err, "no secondary key configured")
+}
@@ -1,139 +1,542 @@
 package voteextension
 
 import (
-	"encoding/json"
+	"bytes"
 	"errors"
 	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/crypto"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 )
 
 type ProposalHandler struct {
 	Logger   log.Logger
 	Keeper   keeper.Keeper
 	valStore baseapp.ValidatorStore
+	// defaultHandler selects and checks the regular txs of a proposal, next to
+	// the injected signature tx.
+	defaultHandler *baseapp.DefaultProposalHandler
 }
 
-type ValidatorSignature struct {
-	ValidatorAddress []byte `json:"validator_address"`
-	Signature        []byte `json:"signature"`
-}
-
-type InjectedVoteExtTx struct {
-	ValidatorSignatures []ValidatorSignature `json:"validator_signatures"`
+// NewProposalHandler creates a new proposal handler. valStore provides the
+// consensus keys the vote extensions of the last commit are verified with.
+// The regular txs of a proposal are selected from mempool and verified with
+// txVerifier by baseapp's default proposal handler.
+func NewProposalHandler(logger log.Logger, keeper keeper.Keeper, valStore baseapp.ValidatorStore, mempool mempool.Mempool, txVerifier baseapp.ProposalTxVerifier) *ProposalHandler {
+	return &ProposalHandler{
+		Logger:         logger,
+		Keeper:         keeper,
+		valStore:       valStore,
+		defaultHandler: baseapp.NewDefaultProposalHandler(mempool, txVerifier),
+	}
 }
 
+// PrepareProposal injects the signature transaction in front of the txs the
+// default proposal handler selects. The space and gas it takes are reserved
+// first, so the proposal stays within req.MaxTxBytes and the block gas limit.
+// Once vote extensions are enabled ProcessProposal rejects a proposal without
+// it, so PrepareProposal fails instead of proposing one.
 func (h *ProposalHandler) PrepareProposal() sdk.PrepareProposalHandler {
+	prepareDefault := h.defaultHandler.PrepareProposalHandler()
 	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
 
 		ctx.Logger().Info("PrepareProposal called")
 
-		var validatorSignatures []ValidatorSignature
-
-		for i, vote := range req.LocalLastCommit.Votes {
-			if len(vote.VoteExtension) == 0 {
-				ctx.Logger().Info("Vote has no extension", "index", i)
-				continue
-			}
-			var voteExt SignatureVoteExtend
-			if err := json.Unmarshal(vote.VoteExtension, &voteExt); err != nil {
-				ctx.Logger().Error("unmarshall err")
-				continue
-			}
-			validatorSignatures = append(validatorSignatures, ValidatorSignature{
-				ValidatorAddress: vote.Validator.Address,
-				Signature:        voteExt.Signature,
-			})
-		}
-		if len(validatorSignatures) == 0 {
-			ctx.Logger().Info("No vote extensions found, not injecting tx")
-			return &abci.ResponsePrepareProposal{
-				Txs: req.Txs,
-			}, nil
+		if !voteExtensionsEnabled(ctx, req.Height) {
+			return prepareDefault(ctx, req)
+		}
+		injectedTx, err := h.injectedTx(ctx, req)
+		if err != nil {
+			return nil, err
 		}
-		injectedTx := InjectedVoteExtTx{
-			ValidatorSignatures: validatorSignatures,
+		injectedTxSize := cmttypes.ComputeProtoSizeForTxs([]cmttypes.Tx{injectedTx})
+		if injectedTxSize > req.MaxTxBytes {
+			return nil, fmt.Errorf("signature tx of %d bytes exceeds max tx bytes %d", injectedTxSize, req.MaxTxBytes)
 		}
-		tx, err := json.Marshal(injectedTx)
+		defaultCtx, err := reserveInjectedTxGas(ctx)
 		if err != nil {
-			return nil, errors.New("Error while marshalling json")
+			return nil, err
 		}
 
-		txs := make([][]byte, 0, len(req.Txs)+1)
-		txs = append(txs, tx)
-		txs = append(txs, req.Txs...)
+		defaultReq := *req
+		defaultReq.MaxTxBytes -= injectedTxSize
+		res, err := prepareDefault(defaultCtx, &defaultReq)
+		if err != nil {
+			return nil, err
+		}
+
+		txs := make([][]byte, 0, len(res.Txs)+1)
+		txs = append(txs, injectedTx)
+		txs = append(txs, res.Txs...)
 		return &abci.ResponsePrepareProposal{
 			Txs: txs,
 		}, nil
 	}
 }
 
-// ProcessProposal validates the proposal including the injected signature transaction
+// injectedTx returns the encoded signature transaction carrying the last
+// commit. It attests the previous block with the vote extensions of the last
+// commit if they reach more than 2/3 of the voting power, and only carries the
+// commit otherwise, for the pre-blocker to track the missed vote extensions.
+func (h *ProposalHandler) injectedTx(ctx sdk.Context, req *abci.RequestPrepareProposal) ([]byte, error) {
+	injectedTx := h.attestingTx(ctx, req)
+	tx, err := types.EncodeInjectedVoteExtensionTx(&injectedTx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode signature tx: %w", err)
+	}
+	return tx, nil
+}
+
+// attestingTx returns the signature transaction attesting the previous block
+// with the vote extensions of the last commit, or carrying only the commit if
+// they do not attest it. The signatures of validators with BLS12-381
+// secondary keys are aggregated into one.
+func (h *ProposalHandler) attestingTx(ctx sdk.Context, req *abci.RequestPrepareProposal) types.InjectedVoteExtensionTx {
+	commitOnly := types.InjectedVoteExtensionTx{ExtendedCommitInfo: req.LocalLastCommit}
+	var (
+		validatorSignatures []types.VoteExtensionSignature
+		aggregateIndexes    []int
+		aggregateSignatures [][]byte
+	)
+
+	// The vote extensions of the last commit were made for the previous
+	// block. Only those ProcessProposal accepts are included.
+	lastBlockHash, err := h.Keeper.LastBlockHash.Get(ctx)
+	if err != nil {
+		ctx.Logger().Info("No last block hash, injecting the last commit only", "error", err)
+		return commitOnly
+	}
+	signBytes := types.VoteExtensionSignBytes(ctx.ChainID(), req.Height-1, lastBlockHash)
+
+	for i, vote := range req.LocalLastCommit.Votes {
+		if len(vote.VoteExtension) == 0 {
+			ctx.Logger().Info("Vote has no extension", "index", i)
+			continue
+		}
+		voteExt, err := types.DecodeVoteExtension(vote.VoteExtension)
+		if err != nil {
+			ctx.Logger().Info("Skipping vote extension", "index", i, "error", err)
+			continue
+		}
+		if err := h.Keeper.VerifyValidatorSignature(ctx, vote.Validator.Address, signBytes, voteExt.Signature); err != nil {
+			ctx.Logger().Info("Skipping vote extension", "index", i, "error", err)
+			continue
+		}
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			ctx.Logger().Info("Skipping vote extension of a validator that did not vote for the block", "index", i)
+			continue
+		}
+		pubKey, err := h.Keeper.GetSecondaryPubKeyVoteExtension(ctx, vote.Validator.Address)
+		if err != nil {
+			ctx.Logger().Info("Skipping vote extension", "index", i, "error", err)
+			continue
+		}
+		if types.ValidatorKeyType(pubKey) == types.KeyType_KEY_TYPE_BLS12_381 {
+			aggregateIndexes = append(aggregateIndexes, i)
+			aggregateSignatures = append(aggregateSignatures, voteExt.Signature)
+			continue
+		}
+		validatorSignatures = append(validatorSignatures, types.VoteExtensionSignature{
+			ValidatorAddress: vote.Validator.Address,
+			Signature:        voteExt.Signature,
+		})
+	}
+	if len(validatorSignatures) == 0 && len(aggregateSignatures) == 0 {
+		ctx.Logger().Info("No vote extensions found, injecting the last commit only")
+		return commitOnly
+	}
+	injectedTx := types.InjectedVoteExtensionTx{
+		ValidatorSignatures: validatorSignatures,
+		ExtendedCommitInfo:  req.LocalLastCommit,
+	}
+	if len(aggregateSignatures) > 0 {
+		signature, err := types.AggregateBLS12381Signatures(aggregateSignatures)
+		if err != nil {
+			ctx.Logger().Error("Failed to aggregate vote extension signatures, injecting the last commit only", "error", err)
+			return commitOnly
+		}
+		injectedTx.AggregateSignature = &types.AggregateSignature{
+			Signers:   types.NewSignerBitmap(len(req.LocalLastCommit.Votes), aggregateIndexes),
+			Signature: signature,
+		}
+	}
+	if err := h.validateInjectedTx(ctx, req.Height, injectedTx); err != nil {
+		ctx.Logger().Info("Vote extensions do not attest the last block, injecting the last commit only", "error", err)
+		return commitOnly
+	}
+	return injectedTx
+}
+
+// voteExtensionsEnabled reports whether the last commit of the block at height
+// carries vote extensions. The block after the enable height is the first
+// whose last commit is extended.
+func voteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	params := ctx.ConsensusParams()
+	return params.Abci != nil && params.Abci.VoteExtensionsEnableHeight > 0 && height > params.Abci.VoteExtensionsEnableHeight
+}
+
+// ProcessProposal validates the injected signature transaction, and passes the
+// rest of the proposal on to the default proposal handler. Once vote
+// extensions are enabled every proposal must carry it, so that the pre-blocker
+// tracks the vote extensions of every last commit.
 func (h *ProposalHandler) ProcessProposal() sdk.ProcessProposalHandler {
+	processDefault := h.defaultHandler.ProcessProposalHandler()
 	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		enabled := voteExtensionsEnabled(ctx, req.Height)
 
-		if len(req.Txs) == 0 {
-			ctx.Logger().Info("Empty block proposal (no vote extensions from previous block), accepting")
+		// The first tx is the signature transaction injected by
+		// PrepareProposal. It cannot appear anywhere else, nor before vote
+		// extensions are enabled.
+		for i, tx := range req.Txs {
+			if types.IsInjectedTx(tx) && (i > 0 || !enabled) {
+				ctx.Logger().Error("Unexpected signature tx in proposal", "index", i)
+				return &abci.ResponseProcessProposal{
+					Status: abci.ResponseProcessProposal_REJECT,
+				}, nil
+			}
+		}
+		if !enabled {
+			return processDefault(ctx, req)
+		}
+		if len(req.Txs) == 0 || !types.IsInjectedTx(req.Txs[0]) {
+			ctx.Logger().Error("No signature tx in proposal")
+			return &abci.ResponseProcessProposal{
+				Status: abci.ResponseProcessProposal_REJECT,
+			}, nil
+		}
+		injectedTx, err := types.DecodeInjectedVoteExtensionTx(req.Txs[0])
+		if err != nil {
+			ctx.Logger().Error("Failed to decode signature tx", "error", err)
 			return &abci.ResponseProcessProposal{
-				Status: abci.ResponseProcessProposal_ACCEPT,
+				Status: abci.ResponseProcessProposal_REJECT,
 			}, nil
 		}
 
-		// First tx should be the signature transaction injected by PrepareProposal
-		firstTx := req.Txs[0]
-
-		var injectedTx InjectedVoteExtTx
-		if err := json.Unmarshal(firstTx, &injectedTx); err != nil {
-			ctx.Logger().Error("Failed to unmarshal first tx as signature tx", "error", err)
+		if err := h.validateInjectedTx(ctx, req.Height, injectedTx); err != nil {
+			ctx.Logger().Error("Invalid signature tx", "error", err)
 			return &abci.ResponseProcessProposal{
 				Status: abci.ResponseProcessProposal_REJECT,
 			}, nil
 		}
-
-		if len(injectedTx.ValidatorSignatures) == 0 {
-			ctx.Logger().Error("Signature tx has no signatures")
+		defaultCtx, err := reserveInjectedTxGas(ctx)
+		if err != nil {
+			ctx.Logger().Error("Invalid signature tx", "error", err)
 			return &abci.ResponseProcessProposal{
 				Status: abci.ResponseProcessProposal_REJECT,
 			}, nil
 		}
+		ctx.Logger().Info("vote extension valid")
 
-		blockHash := ctx.HeaderHash()
+		defaultReq := *req
+		defaultReq.Txs = req.Txs[1:]
+		return processDefault(defaultCtx, &defaultReq)
+	}
+}
 
-		for _, valSig := range injectedTx.ValidatorSignatures {
-			pk, err := crypto.SigToPub(blockHash, valSig.Signature)
-			publicKey := crypto.FromECDSAPub(pk)
-			if err != nil {
-				ctx.Logger().Error("Failed to recover public key",
-					"error", err,
-					"validator", valSig.ValidatorAddress,
-				)
-				return &abci.ResponseProcessProposal{
-					Status: abci.ResponseProcessProposal_REJECT,
-				}, nil
-			}
-			exists, err := h.Keeper.VoteExtensionMap.Has(ctx, valSig.ValidatorAddress)
-			if err != nil {
-				ctx.Logger().Error("vote extension map err")
-				return &abci.ResponseProcessProposal{
-					Status: abci.ResponseProcessProposal_REJECT,
-				}, nil
+// reserveInjectedTxGas lowers the block gas limit the default proposal
+// handler fills by the gas of the injected signature transaction. It fails if
+// the block gas limit leaves no room for it.
+func reserveInjectedTxGas(ctx sdk.Context) (sdk.Context, error) {
+	params := ctx.ConsensusParams()
+	if params.Block == nil || params.Block.MaxGas <= 0 {
+		return ctx, nil
+	}
+	if err := types.ValidateBlockMaxGas(params.Block.MaxGas); err != nil {
+		return ctx, err
+	}
+	block := *params.Block
+	block.MaxGas -= types.InjectedTxGasLimit
+	params.Block = &block
+	return ctx.WithConsensusParams(params), nil
+}
+
+// validateInjectedTx checks the signature transaction of the proposal at
+// height. Its extended commit info must match the last commit and carry
+// vote extensions signed by the validators' consensus keys. If it attests the
+// previous block, its signatures must be taken from those vote extensions,
+// verify against the signers' secondary keys and carry more than 2/3 of the
+// voting power. The aggregate signature must verify against the BLS12-381
+// keys of the signers it names, none of which may also sign individually.
+func (h *ProposalHandler) validateInjectedTx(ctx sdk.Context, height int64, injectedTx types.InjectedVoteExtensionTx) error {
+	extCommit := injectedTx.ExtendedCommitInfo
+	if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, height, ctx.ChainID(), extCommit); err != nil {
+		return err
+	}
+	if !attests(injectedTx) {
+		return nil
+	}
+
+	// The injected vote extensions were made for the previous block.
+	lastBlockHash, err := h.Keeper.LastBlockHash.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get last block hash: %w", err)
+	}
+	signBytes := types.VoteExtensionSignBytes(ctx.ChainID(), height-1, lastBlockHash)
+
+	var totalVotingPower, signedVotingPower int64
+	votes := make(map[string]abci.ExtendedVoteInfo, len(extCommit.Votes))
+	for _, vote := range extCommit.Votes {
+		totalVotingPower += vote.Validator.Power
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit {
+			votes[string(vote.Validator.Address)] = vote
+		}
+	}
+
+	signed := make(map[string]bool, len(injectedTx.ValidatorSignatures))
+	for _, valSig := range injectedTx.ValidatorSignatures {
+		if signed[string(valSig.ValidatorAddress)] {
+			return fmt.Errorf("duplicate signature of validator %X", valSig.ValidatorAddress)
+		}
+		signed[string(valSig.ValidatorAddress)] = true
+
+		vote, ok := votes[string(valSig.ValidatorAddress)]
+		if !ok {
+			return fmt.Errorf("validator %X did not vote for the last block", valSig.ValidatorAddress)
+		}
+		voteExt, err := types.DecodeVoteExtension(vote.VoteExtension)
+		if err != nil || !bytes.Equal(voteExt.Signature, valSig.Signature) {
+			return fmt.Errorf("signature of validator %X does not match its vote extension", valSig.ValidatorAddress)
+		}
+		if err := h.Keeper.VerifyValidatorSignature(ctx, valSig.ValidatorAddress, signBytes, valSig.Signature); err != nil {
+			return err
+		}
+		signedVotingPower += vote.Validator.Power
+	}
+
+	if aggregate := injectedTx.AggregateSignature; aggregate != nil {
+		signers, err := aggregateSigners(extCommit, aggregate)
+		if err != nil {
+			return err
+		}
+		consAddrs := make([]sdk.ConsAddress, len(signers))
+		for i, vote := range signers {
+			if signed[string(vote.Validator.Address)] {
+				return fmt.Errorf("duplicate signature of validator %X", vote.Validator.Address)
 			}
-			if !exists {
-				if err := h.Keeper.VoteExtensionMap.Set(ctx, valSig.ValidatorAddress, publicKey); err != nil {
-					ctx.Logger().Error("vote extension map err")
-					return &abci.ResponseProcessProposal{
-						Status: abci.ResponseProcessProposal_REJECT,
-					}, nil
+			signed[string(vote.Validator.Address)] = true
+			consAddrs[i] = vote.Validator.Address
+			signedVotingPower += vote.Validator.Power
+		}
+		if err := h.Keeper.VerifyValidatorAggregateSignature(ctx, consAddrs, signBytes, aggregate.Signature); err != nil {
+			return err
+		}
+	}
+
+	if requiredVotingPower := totalVotingPower*2/3 + 1; signedVotingPower < requiredVotingPower {
+		return fmt.Errorf("insufficient voting power: got %d, expected >= %d", signedVotingPower, requiredVotingPower)
+	}
+	return nil
+}
+
+// attests reports whether injectedTx carries signatures attesting the
+// previous block, rather than only the last commit.
+func attests(injectedTx types.InjectedVoteExtensionTx) bool {
+	return len(injectedTx.ValidatorSignatures) > 0 || injectedTx.AggregateSignature != nil
+}
+
+// aggregateSigners returns the votes of extCommit the signers bitmap of
+// aggregate names. Each must be a vote for the block.
+func aggregateSigners(extCommit abci.ExtendedCommitInfo, aggregate *types.AggregateSignature) ([]abci.ExtendedVoteInfo, error) {
+	indexes, err := types.SignerIndexes(aggregate.Signers, len(extCommit.Votes))
+	if err != nil {
+		return nil, err
+	}
+	votes := make([]abci.ExtendedVoteInfo, len(indexes))
+	for i, index := range indexes {
+		vote := extCommit.Votes[index]
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit {
+			return nil, fmt.Errorf("validator %X did not vote for the last block", vote.Validator.Address)
+		}
+		votes[i] = vote
+	}
+	return votes, nil
+}
+
+// PreBlocker tracks which validators omitted their vote extension in the last
+// commit carried by the signature transaction injected by PrepareProposal,
+// which ProcessProposal requires once vote extensions are enabled. If the
+// transaction attests the previous block, it also stores the attestation.
+func (h *ProposalHandler) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) error {
+	if len(req.Txs) == 0 || !types.IsInjectedTx(req.Txs[0]) {
+		return nil
+	}
+	injectedTx, err := types.DecodeInjectedVoteExtensionTx(req.Txs[0])
+	if err != nil {
+		ctx.Logger().Error("Failed to decode signature tx", "error", err)
+		return nil
+	}
+
+	lastBlockHash, err := h.Keeper.LastBlockHash.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	height := req.Height - 1
+	signBytes := types.VoteExtensionSignBytes(ctx.ChainID(), height, lastBlockHash)
+
+	if attests(injectedTx) {
+		if err := h.storeAttestation(ctx, req.DecidedLastCommit, injectedTx, height, lastBlockHash, signBytes); err != nil {
+			return err
+		}
+	}
+	return h.trackVoteExtensions(ctx, req.DecidedLastCommit, injectedTx.ExtendedCommitInfo, signBytes)
+}
+
+// storeAttestation stores the attestation of the block with hash at height
+// from the signatures of injectedTx. The signatures are verified again since
+// nodes catching up apply blocks without running ProcessProposal, and each one
+// is weighted with the signer's voting power in the last commit.
+func (h *ProposalHandler) storeAttestation(
+	ctx sdk.Context,
+	lastCommit abci.CommitInfo,
+	injectedTx types.InjectedVoteExtensionTx,
+	height int64,
+	hash []byte,
+	signBytes []byte,
+) error {
+	attestation := types.Attestation{
+		Height:    height,
+		BlockHash: hash,
+	}
+	votingPowers := make(map[string]int64, len(lastCommit.Votes))
+	for _, vote := range lastCommit.Votes {
+		votingPowers[string(vote.Validator.Address)] = vote.Validator.Power
+		attestation.TotalVotingPower += vote.Validator.Power
+	}
+
+	for _, valSig := range injectedTx.ValidatorSignatures {
+		votingPower, ok := votingPowers[string(valSig.ValidatorAddress)]
+		if !ok {
+			ctx.Logger().Error("Vote extension of a validator outside the last commit", "validator", valSig.ValidatorAddress)
+			continue
+		}
+		// count every validator once
+		delete(votingPowers, string(valSig.ValidatorAddress))
+
+		err := h.Keeper.VerifyValidatorSignature(ctx, valSig.ValidatorAddress, signBytes, valSig.Signature)
+		if errors.Is(err, types.ErrSecondaryKeyNotFound) || errors.Is(err, sdkerrors.ErrUnauthorized) {
+			ctx.Logger().Error("Invalid vote extension signature", "error", err, "validator", valSig.ValidatorAddress)
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		attestation.Signatures = append(attestation.Signatures, types.ValidatorSignature{
+			ConsensusAddress: sdk.ConsAddress(valSig.ValidatorAddress).String(),
+			VotingPower:      votingPower,
+			Signature:        valSig.Signature,
+		})
+		attestation.SignedVotingPower += votingPower
+	}
+	if aggregate := injectedTx.AggregateSignature; aggregate != nil {
+		if err := h.addAggregateSignature(ctx, &attestation, votingPowers, injectedTx.ExtendedCommitInfo, aggregate, signBytes); err != nil {
+			return err
+		}
+	}
+	if len(attestation.Signatures) == 0 && len(attestation.AggregateSigners) == 0 {
+		return nil
+	}
+	return h.Keeper.Attestations.Set(ctx, height, attestation)
+}
+
+// addAggregateSignature adds the aggregate signature of the injected tx to
+// attestation if it verifies. votingPowers holds the voting power in the last
+// commit of the validators not counted yet. An invalid aggregate is left out.
+func (h *ProposalHandler) addAggregateSignature(
+	ctx sdk.Context,
+	attestation *types.Attestation,
+	votingPowers map[string]int64,
+	extCommit abci.ExtendedCommitInfo,
+	aggregate *types.AggregateSignature,
+	signBytes []byte,
+) error {
+	signers, err := aggregateSigners(extCommit, aggregate)
+	if err != nil {
+		ctx.Logger().Error("Invalid aggregate vote extension signature", "error", err)
+		return nil
+	}
+
+	consAddrs := make([]sdk.ConsAddress, len(signers))
+	aggregateSigners := make([]types.AggregateSigner, len(signers))
+	var signedVotingPower int64
+	for i, vote := range signers {
+		votingPower, ok := votingPowers[string(vote.Validator.Address)]
+		if !ok {
+			ctx.Logger().Error("Aggregate vote extension of a validator outside the last commit or signing twice", "validator", vote.Validator.Address)
+			return nil
+		}
+		delete(votingPowers, string(vote.Validator.Address))
+
+		consAddrs[i] = vote.Validator.Address
+		aggregateSigners[i] = types.AggregateSigner{
+			ConsensusAddress: sdk.ConsAddress(vote.Validator.Address).String(),
+			VotingPower:      votingPower,
+		}
+		signedVotingPower += votingPower
+	}
+
+	err = h.Keeper.VerifyValidatorAggregateSignature(ctx, consAddrs, signBytes, aggregate.Signature)
+	if errors.Is(err, types.ErrSecondaryKeyNotFound) || errors.Is(err, types.ErrInvalidKeyType) || errors.Is(err, sdkerrors.ErrUnauthorized) {
+		ctx.Logger().Error("Invalid aggregate vote extension signature", "error", err)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	attestation.AggregateSignature = aggregate.Signature
+	attestation.AggregateSigners = aggregateSigners
+	attestation.SignedVotingPower += signedVotingPower
+	return nil
+}
+
+// trackVoteExtensions records for each validator of the last commit with a
+// registered secondary key whether its vote carried a valid vote extension,
+// so that validators that keep omitting them are jailed. The vote extensions
+// are taken from the extended commit info rather than the signatures of the
+// injected tx, so a proposer cannot leave a validator's signature out to make
+// it miss. ProcessProposal requires the injected tx once vote extensions are
+// enabled, so the vote extensions of every extended commit are tracked.
+func (h *ProposalHandler) trackVoteExtensions(ctx sdk.Context, lastCommit abci.CommitInfo, extCommit abci.ExtendedCommitInfo, signBytes []byte) error {
+	extensions := make(map[string][]byte, len(extCommit.Votes))
+	for _, vote := range extCommit.Votes {
+		if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit {
+			extensions[string(vote.Validator.Address)] = vote.VoteExtension
+		}
+	}
+
+	for _, vote := range lastCommit.Votes {
+		consAddr := sdk.ConsAddress(vote.Validator.Address)
+		registered, err := h.Keeper.VoteExtensionMap.Has(ctx, sdk.AccAddress(consAddr))
+		if err != nil {
+			return err
+		}
+		if !registered {
+			continue
+		}
+
+		signed := false
+		if ext, ok := extensions[string(consAddr)]; ok && vote.BlockIdFlag == cmtproto.BlockIDFlagCommit {
+			if voteExt, err := types.DecodeVoteExtension(ext); err == nil {
+				err := h.Keeper.VerifyValidatorSignature(ctx, consAddr, signBytes, voteExt.Signature)
+				if err != nil && !errors.Is(err, sdkerrors.ErrUnauthorized) {
+					return err
 				}
+				signed = err == nil
 			}
 		}
-		ctx.Logger().Info("vote extension valid")
-		return &abci.ResponseProcessProposal{
-			Status: abci.ResponseProcessProposal_ACCEPT,
-		}, nil
+		if err := h.Keeper.HandleValidatorVoteExtension(ctx, consAddr, vote.Validator.Power, signed); err != nil {
+			return err
+		}
 	}
+	return nil
 }
@@ -0,0 +1,74 @@
+package voteextension
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"example/x/secondarykeys/types"
+)
+
+// SecondaryKey is a validator's secondary private key, which signs its vote
+// extensions.
+type SecondaryKey interface {
+	// KeyType returns the type of the key.
+	KeyType() types.KeyType
+
+	// PubKey returns the public key as registered with
+	// MsgRegisterValidatorSecondaryKey.
+	PubKey() []byte
+
+	// Sign signs the 32 byte digest hash.
+	Sign(hash []byte) ([]byte, error)
+
+	// SignProofOfPossession signs the proof of possession digest hash, such
+	// as the bytes returned by ValidatorProofOfPossessionBytes.
+	SignProofOfPossession(hash []byte) ([]byte, error)
+}
+
+// secp256k1Key is a secp256k1 SecondaryKey.
+type secp256k1Key struct {
+	priv *ecdsa.PrivateKey
+}
+
+// NewSecp256k1SecondaryKey returns the SecondaryKey of priv.
+func NewSecp256k1SecondaryKey(priv *ecdsa.PrivateKey) SecondaryKey {
+	return secp256k1Key{priv: priv}
+}
+
+func (k secp256k1Key) KeyType() types.KeyType { return types.KeyType_KEY_TYPE_SECP256K1 }
+
+func (k secp256k1Key) PubKey() []byte { return crypto.FromECDSAPub(&k.priv.PublicKey) }
+
+func (k secp256k1Key) Sign(hash []byte) ([]byte, error) { return crypto.Sign(hash, k.priv) }
+
+func (k secp256k1Key) SignProofOfPossession(hash []byte) ([]byte, error) {
+	return crypto.Sign(hash, k.priv)
+}
+
+// bls12381Key is a BLS12-381 SecondaryKey. Its vote extension signatures are
+// aggregated by the proposer.
+type bls12381Key struct {
+	priv   []byte
+	pubKey []byte
+}
+
+// NewBLS12381SecondaryKey returns the SecondaryKey of the serialized BLS12-381
+// private key priv.
+func NewBLS12381SecondaryKey(priv []byte) (SecondaryKey, error) {
+	pubKey, err := types.BLS12381PubKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	return bls12381Key{priv: priv, pubKey: pubKey}, nil
+}
+
+func (k bls12381Key) KeyType() types.KeyType { return types.KeyType_KEY_TYPE_BLS12_381 }
+
+func (k bls12381Key) PubKey() []byte { return k.pubKey }
+
+func (k bls12381Key) Sign(hash []byte) ([]byte, error) { return types.SignBLS12381(k.priv, hash) }
+
+func (k bls12381Key) SignProofOfPossession(hash []byte) ([]byte, error) {
+	return types.SignBLS12381ProofOfPossession(k.priv, hash)
+}
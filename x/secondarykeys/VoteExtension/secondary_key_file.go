@@ -0,0 +1,123 @@
+package voteextension
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"example/x/secondarykeys/types"
+)
+
+// SecondaryKeyFileName is the name of the validator's secondary key file in
+// the node's config directory, next to priv_validator_key.json.
+const SecondaryKeyFileName = "secondary_key.json"
+
+// SecondaryKeyFile is the on-disk representation of a validator's secondary
+// key.
+type SecondaryKeyFile struct {
+	KeyType    string `json:"key_type"`
+	PublicKey  []byte `json:"public_key"`
+	PrivateKey []byte `json:"private_key"`
+}
+
+// LoadSecondaryKeyFile reads the secondary key stored at path.
+func LoadSecondaryKeyFile(path string) (SecondaryKey, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyFile SecondaryKeyFile
+	if err := json.Unmarshal(bz, &keyFile); err != nil {
+		return nil, fmt.Errorf("failed to decode secondary key file %s: %w", path, err)
+	}
+
+	var key SecondaryKey
+	switch keyFile.KeyType {
+	case types.KeyType_KEY_TYPE_SECP256K1.String():
+		priv, err := crypto.ToECDSA(keyFile.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secondary key in %s: %w", path, err)
+		}
+		key = NewSecp256k1SecondaryKey(priv)
+	case types.KeyType_KEY_TYPE_BLS12_381.String():
+		key, err = NewBLS12381SecondaryKey(keyFile.PrivateKey)
+		if err != nil {
+			return nil, fmt.Errorf("invalid secondary key in %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unsupported secondary key type %q in %s", keyFile.KeyType, path)
+	}
+	if !bytes.Equal(key.PubKey(), keyFile.PublicKey) {
+		return nil, fmt.Errorf("public key in %s does not match its private key", path)
+	}
+	return key, nil
+}
+
+// GenSecondaryKeyFile generates a new secondary key of the given type and
+// stores it at path. It refuses to overwrite an existing file.
+func GenSecondaryKeyFile(path string, keyType types.KeyType) (SecondaryKey, error) {
+	var (
+		key  SecondaryKey
+		priv []byte
+	)
+	switch keyType {
+	case types.KeyType_KEY_TYPE_SECP256K1:
+		ecdsaPriv, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		key, priv = NewSecp256k1SecondaryKey(ecdsaPriv), crypto.FromECDSA(ecdsaPriv)
+	case types.KeyType_KEY_TYPE_BLS12_381:
+		var err error
+		if priv, err = types.GenBLS12381PrivKey(); err != nil {
+			return nil, err
+		}
+		if key, err = NewBLS12381SecondaryKey(priv); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported secondary key type %s", keyType)
+	}
+
+	bz, err := json.MarshalIndent(SecondaryKeyFile{
+		KeyType:    keyType.String(),
+		PublicKey:  key.PubKey(),
+		PrivateKey: priv,
+	}, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Write(bz); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// LoadOrGenSecondaryKeyFile loads the secondary key stored at path, generating
+// a key of the given type first if the file does not exist. An existing key
+// is loaded whatever its type.
+func LoadOrGenSecondaryKeyFile(path string, keyType types.KeyType) (SecondaryKey, error) {
+	key, err := LoadSecondaryKeyFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return GenSecondaryKeyFile(path, keyType)
+	}
+	return key, err
+}
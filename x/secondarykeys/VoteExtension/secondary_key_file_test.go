@@ -0,0 +1,91 @@
+package voteextension
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"example/x/secondarykeys/types"
+
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecondaryKeyFile(t *testing.T) {
+	for _, keyType := range []types.KeyType{types.KeyType_KEY_TYPE_SECP256K1, types.KeyType_KEY_TYPE_BLS12_381} {
+		t.Run(keyType.String(), func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "config", SecondaryKeyFileName)
+
+			_, err := LoadSecondaryKeyFile(path)
+			require.ErrorIs(t, err, os.ErrNotExist)
+
+			generated, err := LoadOrGenSecondaryKeyFile(path, keyType)
+			require.NoError(t, err)
+			require.Equal(t, keyType, generated.KeyType())
+			require.NoError(t, types.ValidatePublicKey(keyType, generated.PubKey()))
+
+			info, err := os.Stat(path)
+			require.NoError(t, err)
+			require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+			// the key survives a restart, whatever type is asked for then
+			loaded, err := LoadOrGenSecondaryKeyFile(path, types.KeyType_KEY_TYPE_SECP256K1)
+			require.NoError(t, err)
+			require.Equal(t, generated, loaded)
+
+			// an existing key is never overwritten
+			_, err = GenSecondaryKeyFile(path, keyType)
+			require.ErrorIs(t, err, os.ErrExist)
+
+			// a file whose public key does not match its private key is
+			// rejected
+			var keyFile SecondaryKeyFile
+			bz, err := os.ReadFile(path)
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(bz, &keyFile))
+			keyFile.PublicKey = newTestSecondaryKey(t, keyType).PubKey()
+			bz, err = json.Marshal(keyFile)
+			require.NoError(t, err)
+			require.NoError(t, os.WriteFile(path, bz, 0o600))
+			_, err = LoadSecondaryKeyFile(path)
+			require.ErrorContains(t, err, "does not match")
+		})
+	}
+
+	_, err := GenSecondaryKeyFile(filepath.Join(t.TempDir(), SecondaryKeyFileName), types.KeyType_KEY_TYPE_UNSPECIFIED)
+	require.ErrorContains(t, err, "unsupported")
+}
+
+func TestExtendVoteUsesInjectedKey(t *testing.T) {
+	ctx := sdk.Context{}.WithLogger(log.NewNopLogger())
+	req := &abci.RequestExtendVote{Hash: crypto.Keccak256([]byte(FakeHashVal)), Height: 1}
+	signBytes := types.VoteExtensionSignBytes(ctx.ChainID(), req.Height, req.Hash)
+
+	// without a key the node does not extend its vote
+	_, err := NewVoteExtensionHandler(nil, nil).ExtendVoteHandler()(ctx, req)
+	require.Error(t, err)
+
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	res, err := NewVoteExtensionHandler(nil, NewLocalSigner(NewSecp256k1SecondaryKey(priv))).ExtendVoteHandler()(ctx, req)
+	require.NoError(t, err)
+
+	voteExt, err := types.DecodeVoteExtension(res.VoteExtension)
+	require.NoError(t, err)
+	pubKey, err := crypto.SigToPub(signBytes, voteExt.Signature)
+	require.NoError(t, err)
+	require.True(t, priv.PublicKey.Equal(pubKey))
+
+	blsKey := newTestSecondaryKey(t, types.KeyType_KEY_TYPE_BLS12_381)
+	res, err = NewVoteExtensionHandler(nil, NewLocalSigner(blsKey)).ExtendVoteHandler()(ctx, req)
+	require.NoError(t, err)
+
+	voteExt, err = types.DecodeVoteExtension(res.VoteExtension)
+	require.NoError(t, err)
+	require.Len(t, voteExt.Signature, types.BLS12381SignatureSize)
+	require.True(t, types.VerifySignature(types.KeyType_KEY_TYPE_BLS12_381, blsKey.PubKey(), signBytes, voteExt.Signature))
+}
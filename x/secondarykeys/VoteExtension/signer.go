@@ -0,0 +1,26 @@
+package voteextension
+
+import "example/x/secondarykeys/types"
+
+// Signer signs this node's vote extensions, either with a key held in process
+// or through a remote signer daemon that keeps the key out of the node.
+type Signer interface {
+	// SignVoteExtension signs the vote extension of the block with the given
+	// hash at height of chainID. A remote signer refuses to sign another
+	// block at a height it signed before.
+	SignVoteExtension(chainID string, height int64, hash []byte) ([]byte, error)
+}
+
+// localSigner signs vote extensions with a key held in process.
+type localSigner struct {
+	key SecondaryKey
+}
+
+// NewLocalSigner returns a Signer signing with key.
+func NewLocalSigner(key SecondaryKey) Signer {
+	return localSigner{key: key}
+}
+
+func (s localSigner) SignVoteExtension(chainID string, height int64, hash []byte) ([]byte, error) {
+	return s.key.Sign(types.VoteExtensionSignBytes(chainID, height, hash))
+}
@@ -0,0 +1,45 @@
+package voteextension
+
+import (
+	"example/x/secondarykeys/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// InjectedTx is the transaction the TxDecoder returns for the signature
+// transaction injected by PrepareProposal. It is unsigned and carries a single
+// MsgInjectAttestations. Only the TxDecoder creates it, so the ante handler
+// can tell it apart from a regular transaction with the same message.
+type InjectedTx struct {
+	authsigning.Tx
+}
+
+// NewTxDecoder wraps the TxDecoder of txConfig so that it also decodes the
+// injected signature transaction, which is not a regular transaction. The
+// rest of the block is decoded by txConfig.
+func NewTxDecoder(txConfig client.TxConfig, authority string) sdk.TxDecoder {
+	decoder := txConfig.TxDecoder()
+	return func(txBytes []byte) (sdk.Tx, error) {
+		if !types.IsInjectedTx(txBytes) {
+			return decoder(txBytes)
+		}
+		injectedTx, err := types.DecodeInjectedVoteExtensionTx(txBytes)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+
+		builder := txConfig.NewTxBuilder()
+		if err := builder.SetMsgs(&types.MsgInjectAttestations{
+			Authority:  authority,
+			InjectedTx: injectedTx,
+		}); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, err.Error())
+		}
+		builder.SetGasLimit(types.InjectedTxGasLimit)
+		return InjectedTx{Tx: builder.GetTx()}, nil
+	}
+}
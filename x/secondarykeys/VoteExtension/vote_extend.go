@@ -1,29 +1,56 @@
 package voteextension
 
 import (
-	"encoding/json"
+	"bytes"
+	"errors"
 	"example/x/secondarykeys/keeper"
-	secondarykeys "example/x/secondarykeys/module"
+	"example/x/secondarykeys/types"
+	"fmt"
+	"sync"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/crypto"
 )
 
 // VoteExtensionHandler handles vote extension creation and verification
 type VoteExtensionHandler struct {
 	keeper *keeper.Keeper
+	// signer signs this node's vote extensions. It is nil on nodes without
+	// a secondary key, which then do not extend their votes.
+	signer Signer
+
+	mtx sync.Mutex
+	// extendHeight and extendHash are the height and block of the last
+	// extended vote. ExtendVote is not told the consensus round and the sign
+	// bytes do not cover it, so only one block is extended per height.
+	extendHeight int64
+	extendHash   []byte
 }
 
 // NewVoteExtensionHandler creates a new vote extension handler
-func NewVoteExtensionHandler(keeper *keeper.Keeper) *VoteExtensionHandler {
+func NewVoteExtensionHandler(keeper *keeper.Keeper, signer Signer) *VoteExtensionHandler {
 	return &VoteExtensionHandler{
 		keeper: keeper,
+		signer: signer,
 	}
 }
 
-type SignatureVoteExtend struct {
-	Signature []byte
+// checkHeight records that the vote for the block with hash at height is
+// extended. It refuses another block at a height a vote was extended at
+// before, which would be a double sign.
+func (h *VoteExtensionHandler) checkHeight(height int64, hash []byte) error {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+
+	switch {
+	case height < h.extendHeight:
+		return fmt.Errorf("height %d is below the last extended height %d", height, h.extendHeight)
+	case height == h.extendHeight && !bytes.Equal(hash, h.extendHash):
+		return fmt.Errorf("already extended the vote for block %X at height %d", h.extendHash, height)
+	}
+	h.extendHeight = height
+	h.extendHash = hash
+	return nil
 }
 
 func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
@@ -31,13 +58,20 @@ func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
 		ctx.Logger().Info("EXTEND VOTE HANDLER CALLED",
 			"height", req.GetHeight(),
 		)
-		signature, err := crypto.Sign(req.GetHash(), &secondarykeys.SecondaryPrivateKey)
+		if h.signer == nil {
+			return nil, errors.New("no secondary key configured")
+		}
+		if err := h.checkHeight(req.GetHeight(), req.GetHash()); err != nil {
+			ctx.Logger().Error("Refusing to extend vote", "height", req.GetHeight(), "error", err)
+			return nil, err
+		}
+		signature, err := h.signer.SignVoteExtension(ctx.ChainID(), req.GetHeight(), req.GetHash())
 		if err != nil {
 			ctx.Logger().Error("Failed to sign", "error", err)
 			return nil, err
 		}
 
-		voteExt, err := json.Marshal(SignatureVoteExtend{
+		voteExt, err := types.EncodeVoteExtension(&types.VoteExtension{
 			Signature: signature,
 		})
 		if err != nil {
@@ -57,33 +91,43 @@ func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtens
 		ctx.Logger().Info("Verify Vote Extend CALLED",
 			"height", req.Height,
 		)
-		var voteExtension SignatureVoteExtend
-		err := json.Unmarshal(req.VoteExtension, &voteExtension)
-		if err != nil {
-			ctx.Logger().Info("Unmarshall ERR",
-				"height", req.Height,
-			)
-			return &abci.ResponseVerifyVoteExtension{
-				Status: abci.ResponseVerifyVoteExtension_REJECT,
-			}, nil
-		}
+		// Validators register their key with MsgRegisterValidatorSecondaryKey.
+		// Until then their extensions are ignored, and accepted so that their
+		// votes still count towards consensus.
 		exists, err := h.keeper.VoteExtensionMap.Has(ctx, req.ValidatorAddress)
 		if err != nil {
 			return &abci.ResponseVerifyVoteExtension{
 				Status: abci.ResponseVerifyVoteExtension_UNKNOWN,
 			}, nil
 		}
-		var pubBytes []byte
 		if !exists {
-			pk, err := crypto.SigToPub(req.Hash, voteExtension.Signature)
-			if err != nil {
-				return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
-			}
-			pubBytes := crypto.FromECDSAPub(pk)
-			h.keeper.VoteExtensionMap.Set(ctx, req.ValidatorAddress, pubBytes)
+			ctx.Logger().Info("Validator has no registered secondary key, ignoring vote extension",
+				"height", req.Height,
+			)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+		// ExtendVote sends an empty extension when it fails, for instance when
+		// the remote signer is down. Rejecting it would drop the precommit and
+		// could halt the chain, so it is accepted and counted as missed.
+		if len(req.VoteExtension) == 0 {
+			ctx.Logger().Info("Empty vote extension, counting it as missed",
+				"height", req.Height,
+			)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		voteExtension, err := types.DecodeVoteExtension(req.VoteExtension)
+		if err != nil {
+			ctx.Logger().Info("Failed to decode vote extension",
+				"height", req.Height,
+				"error", err,
+			)
+			return &abci.ResponseVerifyVoteExtension{
+				Status: abci.ResponseVerifyVoteExtension_REJECT,
+			}, nil
 		}
-		pubBytes, err = h.keeper.VoteExtensionMap.Get(ctx, req.ValidatorAddress)
-		if !crypto.VerifySignature(pubBytes, req.Hash, voteExtension.Signature[:64]) {
+		signBytes := types.VoteExtensionSignBytes(ctx.ChainID(), req.Height, req.Hash)
+		if err := h.keeper.VerifyValidatorSignature(ctx, req.ValidatorAddress, signBytes, voteExtension.Signature); err != nil {
 			ctx.Logger().Info("Signature NOT verified, calling from verifyvoteextension",
 				"height", req.Height,
 			)
@@ -3,7 +3,7 @@ package voteextension
 import (
 	"context"
 	"crypto/ecdsa"
-	"encoding/json"
+	"example/x/secondarykeys/types"
 	"testing"
 
 	"cosmossdk.io/log"
@@ -65,7 +65,7 @@ func TestVoteExtension(t *testing.T) {
 			ctx.Logger().Error("Failed to sign", "error", err)
 			return nil, err
 		}
-		voteExt, err := json.Marshal(SignatureVoteExtend{
+		voteExt, err := types.EncodeVoteExtension(&types.VoteExtension{
 			Signature: signature,
 		})
 		if err != nil {
@@ -79,8 +79,7 @@ func TestVoteExtension(t *testing.T) {
 	})
 	// Set up vote extension verifier
 	app.SetVerifyVoteExtensionHandler(func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
-		var voteExtension SignatureVoteExtend
-		err := json.Unmarshal(req.VoteExtension, &voteExtension)
+		voteExtension, err := types.DecodeVoteExtension(req.VoteExtension)
 		if err != nil {
 			ctx.Logger().Info("Unmarshall ERR",
 				"height", req.Height,
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PruneAttestations removes the attestations of the blocks that fell out of
+// the attestation retention window at the current block height.
+func (k Keeper) PruneAttestations(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.AttestationRetentionWindow == 0 {
+		return nil
+	}
+
+	// The attestation of a block is stored in the next block and kept for
+	// the window from then on.
+	minHeight := sdk.UnwrapSDKContext(ctx).BlockHeight() - params.AttestationRetentionWindow
+	if minHeight <= 0 {
+		return nil
+	}
+	return k.Attestations.Clear(ctx, new(collections.Range[int64]).EndExclusive(minHeight))
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"example/x/secondarykeys/types"
+)
+
+func TestPruneAttestations(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.AttestationRetentionWindow = 3
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	for height := int64(1); height <= 9; height++ {
+		require.NoError(t, f.keeper.Attestations.Set(f.ctx, height, types.Attestation{Height: height}))
+	}
+
+	// the attestation of block 6 was stored at height 7 and is kept until
+	// height 9
+	require.NoError(t, f.keeper.PruneAttestations(sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)))
+	heights, err := attestationHeights(f)
+	require.NoError(t, err)
+	require.Equal(t, []int64{7, 8, 9}, heights)
+
+	// a zero window keeps every attestation
+	params.AttestationRetentionWindow = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	require.NoError(t, f.keeper.PruneAttestations(sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)))
+	heights, err = attestationHeights(f)
+	require.NoError(t, err)
+	require.Equal(t, []int64{7, 8, 9}, heights)
+}
+
+func attestationHeights(f *fixture) ([]int64, error) {
+	iter, err := f.keeper.Attestations.Iterate(f.ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Keys()
+}
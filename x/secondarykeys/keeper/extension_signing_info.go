@@ -0,0 +1,152 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"example/x/secondarykeys/types"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// HandleValidatorVoteExtension records whether the validator with consAddr
+// and the given voting power included a valid vote extension in the last
+// commit. Like HandleValidatorSignature of x/slashing, it jails the validator,
+// and slashes it if the params say so, once it missed more vote extensions
+// than the signed blocks window allows. Jailed validators are not tracked.
+func (k Keeper) HandleValidatorVoteExtension(ctx context.Context, consAddr sdk.ConsAddress, power int64, signed bool) error {
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		params = types.DefaultParams()
+	} else if err != nil {
+		return err
+	}
+	if !params.ExtensionTrackingEnabled() {
+		return nil
+	}
+
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	if validator == nil || validator.IsJailed() {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	info, err := k.ExtensionSigningInfos.Get(ctx, consAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		info = types.ExtensionSigningInfo{
+			ConsensusAddress: consAddr.String(),
+			StartHeight:      height,
+		}
+	} else if err != nil {
+		return err
+	}
+
+	index := info.IndexOffset % params.SignedBlocksWindow
+	info.IndexOffset++
+
+	key := collections.Join(consAddr, index)
+	previous, err := k.ExtensionMissedBlocks.Has(ctx, key)
+	if err != nil {
+		return err
+	}
+	missed := !signed
+	switch {
+	case !previous && missed:
+		if err := k.ExtensionMissedBlocks.Set(ctx, key); err != nil {
+			return err
+		}
+		info.MissedBlocksCounter++
+	case previous && !missed:
+		if err := k.ExtensionMissedBlocks.Remove(ctx, key); err != nil {
+			return err
+		}
+		info.MissedBlocksCounter--
+	}
+
+	if missed {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMissingVoteExtension,
+				sdk.NewAttribute(types.AttributeKeyConsensusAddress, consAddr.String()),
+				sdk.NewAttribute(types.AttributeKeyMissedBlocks, fmt.Sprintf("%d", info.MissedBlocksCounter)),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+			),
+		)
+	}
+
+	minHeight := info.StartHeight + params.SignedBlocksWindow
+	maxMissed := params.SignedBlocksWindow - params.MinSignedBlocks()
+	if height > minHeight && info.MissedBlocksCounter > maxMissed {
+		// The tracking restarts with a full window once the validator is
+		// unjailed.
+		if err := k.jailForMissingVoteExtensions(sdkCtx, consAddr, power, params); err != nil {
+			return err
+		}
+		return k.deleteExtensionSigningInfo(ctx, consAddr)
+	}
+
+	return k.ExtensionSigningInfos.Set(ctx, consAddr, info)
+}
+
+// jailForMissingVoteExtensions slashes the validator with consAddr by the
+// slash_fraction_missing_extension param and jails it for the
+// downtime_jail_duration param.
+func (k Keeper) jailForMissingVoteExtensions(ctx sdk.Context, consAddr sdk.ConsAddress, power int64, params types.Params) error {
+	if params.SlashFractionMissingExtension.IsPositive() {
+		// The stake that signed the last commit is slashed, as in x/slashing.
+		distributionHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
+		if err := k.slashingKeeper.SlashWithInfractionReason(
+			ctx,
+			consAddr,
+			params.SlashFractionMissingExtension,
+			power,
+			distributionHeight,
+			stakingtypes.Infraction_INFRACTION_DOWNTIME,
+		); err != nil {
+			return err
+		}
+	}
+
+	if err := k.slashingKeeper.Jail(ctx, consAddr); err != nil {
+		return fmt.Errorf("failed to jail validator: %w", err)
+	}
+	jailedUntil := ctx.BlockHeader().Time.Add(params.DowntimeJailDuration)
+	if err := k.slashingKeeper.JailUntil(ctx, consAddr, jailedUntil); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeJailMissingVoteExtensions,
+			sdk.NewAttribute(types.AttributeKeyConsensusAddress, consAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(types.AttributeKeySlashFraction, params.SlashFractionMissingExtension.String()),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, jailedUntil.String()),
+		),
+	)
+	ctx.Logger().Info(
+		"jailing validator for missing vote extensions",
+		"validator", consAddr.String(),
+		"slashed", params.SlashFractionMissingExtension.String(),
+		"jailed_until", jailedUntil,
+	)
+	return nil
+}
+
+// deleteExtensionSigningInfo removes the signing info and missed bitmap of
+// the validator with consAddr.
+func (k Keeper) deleteExtensionSigningInfo(ctx context.Context, consAddr sdk.ConsAddress) error {
+	if err := k.ExtensionMissedBlocks.Clear(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, int64](consAddr)); err != nil {
+		return err
+	}
+	return k.ExtensionSigningInfos.Remove(ctx, consAddr)
+}
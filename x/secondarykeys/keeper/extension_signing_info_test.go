@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestHandleValidatorVoteExtension(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 10
+	params.DowntimeJailDuration = time.Hour
+	params.SlashFractionMissingExtension = math.LegacyNewDecWithPrec(1, 2)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, consAddr := f.stakingKeeper.addValidator(t)
+	blockTime := time.Unix(1_700_000_000, 0).UTC()
+	handle := func(height int64, signed bool) {
+		t.Helper()
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(height).WithBlockTime(blockTime)
+		require.NoError(t, f.keeper.HandleValidatorVoteExtension(ctx, consAddr, 10, signed))
+	}
+	jailed := func() bool {
+		validator, err := f.stakingKeeper.ValidatorByConsAddr(f.ctx, consAddr)
+		require.NoError(t, err)
+		return validator.IsJailed()
+	}
+
+	// 6 missed vote extensions exceed the 5 the window allows, but the
+	// validator is only jailed once a full window has passed.
+	for height := int64(1); height <= 11; height++ {
+		handle(height, height > 6 && height != 11)
+	}
+	require.False(t, jailed())
+
+	response, err := qs.ExtensionSigningInfo(f.ctx, &types.QueryExtensionSigningInfoRequest{ConsensusAddress: consAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, types.ExtensionSigningInfo{
+		ConsensusAddress:    consAddr.String(),
+		StartHeight:         1,
+		IndexOffset:         11,
+		MissedBlocksCounter: 6,
+	}, response.SigningInfo)
+
+	// signing at height 12 replaces the miss at height 2, and the misses up to
+	// height 16 replace earlier ones
+	for height := int64(12); height <= 16; height++ {
+		handle(height, height == 12)
+	}
+	require.False(t, jailed())
+
+	// missing at height 17 replaces the vote extension signed at height 7
+	handle(17, false)
+	require.True(t, jailed())
+	require.Equal(t, params.SlashFractionMissingExtension, f.slashingKeeper.slashed[consAddr.String()])
+	require.Equal(t, blockTime.Add(time.Hour), f.slashingKeeper.jailedUntil[consAddr.String()])
+
+	// the tracking restarts once the validator is unjailed
+	_, err = f.keeper.ExtensionSigningInfos.Get(f.ctx, consAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	hasMissed, err := f.keeper.ExtensionMissedBlocks.Has(f.ctx, collections.Join(consAddr, int64(2)))
+	require.NoError(t, err)
+	require.False(t, hasMissed)
+
+	handle(18, false)
+	_, err = f.keeper.ExtensionSigningInfos.Get(f.ctx, consAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestHandleValidatorVoteExtensionWithoutSlashing(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 2
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, consAddr := f.stakingKeeper.addValidator(t)
+	for height := int64(1); height <= 4; height++ {
+		ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(height)
+		require.NoError(t, f.keeper.HandleValidatorVoteExtension(ctx, consAddr, 10, false))
+	}
+
+	validator, err := f.stakingKeeper.ValidatorByConsAddr(f.ctx, consAddr)
+	require.NoError(t, err)
+	require.True(t, validator.IsJailed())
+	require.NotContains(t, f.slashingKeeper.slashed, consAddr.String())
+}
+
+func TestHandleValidatorVoteExtensionDisabled(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.SignedBlocksWindow = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	_, consAddr := f.stakingKeeper.addValidator(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1)
+	require.NoError(t, f.keeper.HandleValidatorVoteExtension(ctx, consAddr, 10, false))
+
+	_, err := f.keeper.ExtensionSigningInfos.Get(ctx, consAddr)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// unknown validators are not tracked either
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.DefaultParams()))
+	unknown := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	require.NoError(t, f.keeper.HandleValidatorVoteExtension(ctx, unknown, 10, false))
+
+	_, err = f.keeper.ExtensionSigningInfos.Get(ctx, unknown)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
+
+func TestExtensionSigningInfoQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	_, err := qs.ExtensionSigningInfo(f.ctx, &types.QueryExtensionSigningInfoRequest{
+		ConsensusAddress: sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+	})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.ExtensionSigningInfo(f.ctx, &types.QueryExtensionSigningInfoRequest{ConsensusAddress: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = qs.ExtensionSigningInfo(f.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -3,12 +3,136 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"example/x/secondarykeys/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	return k.Params.Set(ctx, genState.Params)
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, key := range genState.AccountKeys {
+		addr, err := k.addressCodec.StringToBytes(key.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.AnteHandlerMap.Set(ctx, addr, key.SecondaryKeySet()); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range genState.ValidatorKeys {
+		consAddr, err := sdk.ConsAddressFromBech32(key.ConsensusAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.VoteExtensionMap.Set(ctx, sdk.AccAddress(consAddr), key.PublicKey); err != nil {
+			return err
+		}
+	}
+
+	for _, record := range genState.KeyHistory {
+		addr, err := k.addressCodec.StringToBytes(record.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.KeyHistory.Set(ctx, collections.Join(sdk.AccAddress(addr), record.Sequence), record.Entry); err != nil {
+			return err
+		}
+	}
+
+	for _, pubKey := range genState.Tombstones {
+		if err := k.Tombstones.Set(ctx, pubKey); err != nil {
+			return err
+		}
+	}
+
+	for _, addrStr := range genState.RevokedAccounts {
+		addr, err := k.addressCodec.StringToBytes(addrStr)
+		if err != nil {
+			return err
+		}
+		if err := k.RevokedAccounts.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	for _, addrStr := range genState.LockedAccounts {
+		addr, err := k.addressCodec.StringToBytes(addrStr)
+		if err != nil {
+			return err
+		}
+		if err := k.LockedAccounts.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	for _, addrStr := range genState.RequiredAccounts {
+		addr, err := k.addressCodec.StringToBytes(addrStr)
+		if err != nil {
+			return err
+		}
+		if err := k.RequiredAccounts.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+
+	for _, info := range genState.ExtensionSigningInfos {
+		consAddr, err := sdk.ConsAddressFromBech32(info.ConsensusAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.ExtensionSigningInfos.Set(ctx, consAddr, info); err != nil {
+			return err
+		}
+	}
+
+	for _, missed := range genState.ExtensionMissedBlocks {
+		consAddr, err := sdk.ConsAddressFromBech32(missed.ConsensusAddress)
+		if err != nil {
+			return err
+		}
+		for _, index := range missed.MissedIndexes {
+			if err := k.ExtensionMissedBlocks.Set(ctx, collections.Join(consAddr, index)); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, recovery := range genState.PendingRecoveries {
+		addr, err := k.addressCodec.StringToBytes(recovery.Account)
+		if err != nil {
+			return err
+		}
+		if err := k.SetPendingRecovery(ctx, addr, recovery); err != nil {
+			return err
+		}
+	}
+
+	for _, sessionKey := range genState.SessionKeys {
+		addr, err := k.addressCodec.StringToBytes(sessionKey.Account)
+		if err != nil {
+			return err
+		}
+		if err := k.SessionKeys.Set(ctx, collections.Join(sdk.AccAddress(addr), sessionKey.PublicKey), sessionKey); err != nil {
+			return err
+		}
+	}
+	for _, allowance := range genState.SessionKeyAllowances {
+		addr, err := k.addressCodec.StringToBytes(allowance.Account)
+		if err != nil {
+			return err
+		}
+		if err := k.SessionKeyAllowances.Set(ctx, collections.Join(sdk.AccAddress(addr), allowance.PublicKey), allowance); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -21,5 +145,112 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	if err := k.AnteHandlerMap.Walk(ctx, nil, func(addr sdk.AccAddress, keySet types.SecondaryKeySet) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		genesis.AccountKeys = append(genesis.AccountKeys, types.NewAccountKey(addrStr, keySet))
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.VoteExtensionMap.Walk(ctx, nil, func(addr sdk.AccAddress, pubKey []byte) (bool, error) {
+		genesis.ValidatorKeys = append(genesis.ValidatorKeys, types.ValidatorKey{
+			ConsensusAddress: sdk.ConsAddress(addr).String(),
+			PublicKey:        pubKey,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.KeyHistory.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], entry types.SecondaryKeyHistoryEntry) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		genesis.KeyHistory = append(genesis.KeyHistory, types.KeyHistoryRecord{
+			Address:  addrStr,
+			Sequence: key.K2(),
+			Entry:    entry,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.Tombstones.Walk(ctx, nil, func(pubKey []byte) (bool, error) {
+		genesis.Tombstones = append(genesis.Tombstones, pubKey)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if genesis.RevokedAccounts, err = k.exportAccountSet(ctx, k.RevokedAccounts); err != nil {
+		return nil, err
+	}
+	if genesis.LockedAccounts, err = k.exportAccountSet(ctx, k.LockedAccounts); err != nil {
+		return nil, err
+	}
+	if genesis.RequiredAccounts, err = k.exportAccountSet(ctx, k.RequiredAccounts); err != nil {
+		return nil, err
+	}
+
+	if err := k.ExtensionSigningInfos.Walk(ctx, nil, func(_ sdk.ConsAddress, info types.ExtensionSigningInfo) (bool, error) {
+		genesis.ExtensionSigningInfos = append(genesis.ExtensionSigningInfos, info)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// The missed indexes of a validator are adjacent in the key set.
+	if err := k.ExtensionMissedBlocks.Walk(ctx, nil, func(key collections.Pair[sdk.ConsAddress, int64]) (bool, error) {
+		consAddr := key.K1().String()
+		if n := len(genesis.ExtensionMissedBlocks); n == 0 || genesis.ExtensionMissedBlocks[n-1].ConsensusAddress != consAddr {
+			genesis.ExtensionMissedBlocks = append(genesis.ExtensionMissedBlocks, types.ExtensionMissedBlocks{ConsensusAddress: consAddr})
+		}
+		last := &genesis.ExtensionMissedBlocks[len(genesis.ExtensionMissedBlocks)-1]
+		last.MissedIndexes = append(last.MissedIndexes, key.K2())
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.PendingRecoveries.Walk(ctx, nil, func(_ sdk.AccAddress, recovery types.PendingRecovery) (bool, error) {
+		genesis.PendingRecoveries = append(genesis.PendingRecoveries, recovery)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.SessionKeys.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, []byte], sessionKey types.SessionKey) (bool, error) {
+		genesis.SessionKeys = append(genesis.SessionKeys, sessionKey)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.SessionKeyAllowances.Walk(ctx, nil, func(_ collections.Pair[sdk.AccAddress, []byte], allowance types.SessionKeyAllowance) (bool, error) {
+		genesis.SessionKeyAllowances = append(genesis.SessionKeyAllowances, allowance)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
+
+// exportAccountSet returns the addresses held by set.
+func (k Keeper) exportAccountSet(ctx context.Context, set collections.KeySet[sdk.AccAddress]) ([]string, error) {
+	var addrs []string
+	err := set.Walk(ctx, nil, func(addr sdk.AccAddress) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		addrs = append(addrs, addrStr)
+		return false, nil
+	})
+	return addrs, err
+}
@@ -1,10 +1,20 @@
 package keeper_test
 
 import (
+	"bytes"
+	"sort"
 	"testing"
+	"time"
 
+	"example/testutil/sample"
 	"example/x/secondarykeys/types"
 
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
@@ -22,3 +32,99 @@ func TestGenesis(t *testing.T) {
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 }
+
+func TestGenesisRegistries(t *testing.T) {
+	newPubKey := func() []byte {
+		priv, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		return crypto.FromECDSAPub(&priv.PublicKey)
+	}
+	account := sample.AccAddress()
+	keySetAccount := sample.AccAddress()
+	revoked := sample.AccAddress()
+	validator := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	params := types.DefaultParams()
+	params.RequiredMsgTypeUrls = []string{"/cosmos.bank.v1beta1.MsgSend"}
+
+	genesisState := types.GenesisState{
+		Params: params,
+		AccountKeys: []types.AccountKey{
+			{Address: account, PublicKey: newPubKey(), KeyType: types.KeyType_KEY_TYPE_SECP256K1},
+		},
+		ValidatorKeys: []types.ValidatorKey{
+			{ConsensusAddress: sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String(), PublicKey: newPubKey()},
+		},
+		KeyHistory: []types.KeyHistoryRecord{
+			{Address: account, Sequence: 0, Entry: types.SecondaryKeyHistoryEntry{
+				KeyType:        types.KeyType_KEY_TYPE_SECP256K1,
+				PublicKey:      newPubKey(),
+				ReplacedHeight: 7,
+			}},
+		},
+		Tombstones:       [][]byte{newPubKey()},
+		RevokedAccounts:  []string{revoked},
+		LockedAccounts:   []string{revoked},
+		RequiredAccounts: []string{account},
+		ExtensionSigningInfos: []types.ExtensionSigningInfo{
+			{ConsensusAddress: validator, StartHeight: 3, IndexOffset: 12, MissedBlocksCounter: 2},
+		},
+		ExtensionMissedBlocks: []types.ExtensionMissedBlocks{
+			{ConsensusAddress: validator, MissedIndexes: []int64{4, 9}},
+		},
+	}
+	keySet := types.SecondaryKeySet{
+		Members: []types.SecondaryKeySetMember{
+			{KeyType: types.KeyType_KEY_TYPE_SECP256K1, PublicKey: newPubKey(), Weight: 1},
+			{KeyType: types.KeyType_KEY_TYPE_SECP256K1, PublicKey: newPubKey(), Weight: 2},
+		},
+		Threshold: 2,
+	}
+	genesisState.AccountKeys = append(genesisState.AccountKeys, types.AccountKey{Address: keySetAccount, KeySet: &keySet})
+	// accounts are exported in the order of their address bytes
+	sort.Slice(genesisState.AccountKeys, func(i, j int) bool {
+		return bytes.Compare(sdk.MustAccAddressFromBech32(genesisState.AccountKeys[i].Address), sdk.MustAccAddressFromBech32(genesisState.AccountKeys[j].Address)) < 0
+	})
+	recoveryPubKey, err := codectypes.NewAnyWithValue(secp256k1.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	executeTime := time.Unix(1_700_000_000, 0).UTC()
+	genesisState.PendingRecoveries = []types.PendingRecovery{
+		{Account: keySetAccount, NewPublicKey: recoveryPubKey, ExecuteTime: executeTime},
+	}
+	sessionPubKey := newPubKey()
+	expiryTime := executeTime.Add(time.Hour)
+	genesisState.SessionKeys = []types.SessionKey{
+		{
+			Account:     account,
+			KeyType:     types.KeyType_KEY_TYPE_SECP256K1,
+			PublicKey:   sessionPubKey,
+			MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"},
+			SpendLimit:  sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			ExpiryTime:  &expiryTime,
+		},
+	}
+	genesisState.SessionKeyAllowances = []types.SessionKeyAllowance{
+		{Account: account, PublicKey: sessionPubKey, Remaining: sdk.NewCoins(sdk.NewInt64Coin("stake", 40))},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	f := initFixture(t)
+	require.NoError(t, f.keeper.InitGenesis(f.ctx, genesisState))
+
+	// the public key index is rebuilt from the imported keys
+	for _, key := range genesisState.AccountKeys {
+		for _, member := range key.SecondaryKeySet().Members {
+			owners, err := f.keeper.GetSecondaryKeyOwners(f.ctx, member.PublicKey)
+			require.NoError(t, err)
+			require.Equal(t, []sdk.AccAddress{sdk.MustAccAddressFromBech32(key.Address)}, owners)
+		}
+	}
+
+	// and so is the recovery queue
+	queued, err := f.keeper.RecoveryQueue.Has(f.ctx, collections.Join(executeTime, sdk.MustAccAddressFromBech32(keySetAccount)))
+	require.NoError(t, err)
+	require.True(t, queued)
+
+	got, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, genesisState, *got)
+}
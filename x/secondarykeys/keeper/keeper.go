@@ -2,14 +2,19 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"example/x/secondarykeys/types"
 	"fmt"
+	"slices"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 type Keeper struct {
@@ -19,11 +24,73 @@ type Keeper struct {
 	// Address capable of executing a MsgUpdateParams message.
 	// Typically, this should be the x/gov module account.
 	authority []byte
+	// authKeeper replaces the primary public key of recovered accounts.
+	authKeeper types.AuthKeeper
+	// stakingKeeper resolves validator operators to consensus addresses.
+	stakingKeeper types.StakingKeeper
+	// slashingKeeper jails and slashes validators that miss too many vote
+	// extensions.
+	slashingKeeper types.SlashingKeeper
 
 	Schema           collections.Schema
 	Params           collections.Item[types.Params]
-	AnteHandlerMap   collections.Map[sdk.AccAddress, []byte]
+	AnteHandlerMap   *collections.IndexedMap[sdk.AccAddress, types.SecondaryKeySet, AnteHandlerIndexes]
 	VoteExtensionMap collections.Map[sdk.AccAddress, []byte]
+	// KeyHistory keeps the secondary keys an account rotated away from,
+	// keyed by account and rotation sequence.
+	KeyHistory collections.Map[collections.Pair[sdk.AccAddress, uint64], types.SecondaryKeyHistoryEntry]
+	// Tombstones holds revoked secondary public keys, which can never be
+	// registered again.
+	Tombstones collections.KeySet[[]byte]
+	// RevokedAccounts holds accounts whose secondary key has been revoked and
+	// not replaced yet.
+	RevokedAccounts collections.KeySet[sdk.AccAddress]
+	// LockedAccounts holds revoked accounts that refuse every transaction
+	// until a new secondary key is registered.
+	LockedAccounts collections.KeySet[sdk.AccAddress]
+	// RequiredAccounts holds accounts that require a secondary signature on
+	// every transaction.
+	RequiredAccounts collections.KeySet[sdk.AccAddress]
+	// LastBlockHash is the hash of the last block, which the vote extensions
+	// included in the next block are signed over.
+	LastBlockHash collections.Item[[]byte]
+	// Attestations holds the validator signatures over each block, keyed by
+	// the height of the block.
+	Attestations collections.Map[int64, types.Attestation]
+	// ExtensionSigningInfos tracks the vote extensions of validators with a
+	// registered secondary key over the signed blocks window.
+	ExtensionSigningInfos collections.Map[sdk.ConsAddress, types.ExtensionSigningInfo]
+	// ExtensionMissedBlocks holds the indexes of the signed blocks window a
+	// validator missed its vote extension at.
+	ExtensionMissedBlocks collections.KeySet[collections.Pair[sdk.ConsAddress, int64]]
+	// PendingRecoveries holds the account recoveries waiting for their
+	// timelock, keyed by the recovered account.
+	PendingRecoveries collections.Map[sdk.AccAddress, types.PendingRecovery]
+	// RecoveryQueue holds the pending recoveries by execute time and account.
+	RecoveryQueue collections.KeySet[collections.Pair[time.Time, sdk.AccAddress]]
+	// SessionKeys holds the session keys, keyed by account and session public
+	// key.
+	SessionKeys collections.Map[collections.Pair[sdk.AccAddress, []byte], types.SessionKey]
+	// SessionKeyAllowances holds what the transactions signed by each session
+	// key can still spend, keyed like SessionKeys.
+	SessionKeyAllowances collections.Map[collections.Pair[sdk.AccAddress, []byte], types.SessionKeyAllowance]
+}
+
+// AnteHandlerIndexes indexes the AnteHandlerMap by secondary public key.
+type AnteHandlerIndexes struct {
+	// PubKey maps a secondary public key to the accounts whose key set it is
+	// a member of.
+	PubKey *KeySetIndex
+}
+
+func (i AnteHandlerIndexes) IndexesList() []collections.Index[sdk.AccAddress, types.SecondaryKeySet] {
+	return []collections.Index[sdk.AccAddress, types.SecondaryKeySet]{i.PubKey}
+}
+
+func NewAnteHandlerIndexes(sb *collections.SchemaBuilder) AnteHandlerIndexes {
+	return AnteHandlerIndexes{
+		PubKey: NewKeySetIndex(sb, collections.NewPrefix(6), "ante_handler_map_by_pub_key"),
+	}
 }
 
 func NewKeeper(
@@ -31,7 +98,9 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
+	authKeeper types.AuthKeeper,
+	stakingKeeper types.StakingKeeper,
+	slashingKeeper types.SlashingKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -45,13 +114,18 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
+		authKeeper:     authKeeper,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
+
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		AnteHandlerMap: collections.NewMap(
+		AnteHandlerMap: collections.NewIndexedMap(
 			sb,
 			collections.NewPrefix(0), // or 1, 2, etc if you have multiple maps
 			"ante_handler_map",
 			sdk.AccAddressKey,
-			collections.BytesValue,
+			codec.CollValue[types.SecondaryKeySet](cdc),
+			NewAnteHandlerIndexes(sb),
 		),
 		VoteExtensionMap: collections.NewMap(
 			sb,
@@ -60,6 +134,59 @@ func NewKeeper(
 			sdk.AccAddressKey,
 			collections.BytesValue,
 		),
+		KeyHistory: collections.NewMap(
+			sb,
+			collections.NewPrefix(2),
+			"key_history",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key),
+			codec.CollValue[types.SecondaryKeyHistoryEntry](cdc),
+		),
+		Tombstones:       collections.NewKeySet(sb, collections.NewPrefix(3), "tombstones", collections.BytesKey),
+		RevokedAccounts:  collections.NewKeySet(sb, collections.NewPrefix(4), "revoked_accounts", sdk.AccAddressKey),
+		LockedAccounts:   collections.NewKeySet(sb, collections.NewPrefix(5), "locked_accounts", sdk.AccAddressKey),
+		RequiredAccounts: collections.NewKeySet(sb, collections.NewPrefix(7), "required_accounts", sdk.AccAddressKey),
+		LastBlockHash:    collections.NewItem(sb, types.LastBlockHashKey, "last_block_hash", collections.BytesValue),
+		Attestations:     collections.NewMap(sb, types.AttestationsKey, "attestations", collections.Int64Key, codec.CollValue[types.Attestation](cdc)),
+		ExtensionSigningInfos: collections.NewMap(
+			sb,
+			types.ExtensionSigningInfosKey,
+			"extension_signing_infos",
+			sdk.ConsAddressKey,
+			codec.CollValue[types.ExtensionSigningInfo](cdc),
+		),
+		ExtensionMissedBlocks: collections.NewKeySet(
+			sb,
+			types.ExtensionMissedBlocksKey,
+			"extension_missed_blocks",
+			collections.PairKeyCodec(sdk.ConsAddressKey, collections.Int64Key),
+		),
+		PendingRecoveries: collections.NewMap(
+			sb,
+			types.PendingRecoveriesKey,
+			"pending_recoveries",
+			sdk.AccAddressKey,
+			codec.CollValue[types.PendingRecovery](cdc),
+		),
+		RecoveryQueue: collections.NewKeySet(
+			sb,
+			types.RecoveryQueueKey,
+			"recovery_queue",
+			collections.PairKeyCodec(sdk.TimeKey, sdk.AccAddressKey),
+		),
+		SessionKeys: collections.NewMap(
+			sb,
+			types.SessionKeysKey,
+			"session_keys",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.BytesKey),
+			codec.CollValue[types.SessionKey](cdc),
+		),
+		SessionKeyAllowances: collections.NewMap(
+			sb,
+			types.SessionKeyAllowancesKey,
+			"session_key_allowances",
+			collections.PairKeyCodec(sdk.AccAddressKey, collections.BytesKey),
+			codec.CollValue[types.SessionKeyAllowance](cdc),
+		),
 	}
 
 	schema, err := sb.Build()
@@ -76,16 +203,12 @@ func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
-func (k Keeper) SetSecondaryPubKeyAnteHandler(ctx context.Context, addr sdk.AccAddress, pubKey []byte) error {
-	return k.AnteHandlerMap.Set(ctx, addr, pubKey)
+func (k Keeper) SetSecondaryKeySet(ctx context.Context, addr sdk.AccAddress, keySet types.SecondaryKeySet) error {
+	return k.AnteHandlerMap.Set(ctx, addr, keySet)
 }
 
-func (k Keeper) GetSecondaryPubKeyAnteHandler(ctx context.Context, addr sdk.AccAddress) ([]byte, error) {
-	bz, err := k.AnteHandlerMap.Get(ctx, addr)
-	if err != nil {
-		return nil, err
-	}
-	return bz, err
+func (k Keeper) GetSecondaryKeySet(ctx context.Context, addr sdk.AccAddress) (types.SecondaryKeySet, error) {
+	return k.AnteHandlerMap.Get(ctx, addr)
 }
 
 func (k Keeper) SetSecondaryPubKeyVoteExtension(ctx context.Context, addr sdk.AccAddress, pubKey []byte) error {
@@ -99,3 +222,184 @@ func (k Keeper) GetSecondaryPubKeyVoteExtension(ctx context.Context, addr sdk.Ac
 	}
 	return bz, err
 }
+
+// VerifyValidatorSignature checks that sig is a signature over signBytes by
+// the secondary key registered for the validator with the given consensus
+// address. It only reads state, so it is safe to call from the ABCI vote
+// extension and proposal handlers.
+func (k Keeper) VerifyValidatorSignature(ctx context.Context, consAddr sdk.ConsAddress, signBytes, sig []byte) error {
+	pubKey, err := k.VoteExtensionMap.Get(ctx, sdk.AccAddress(consAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrSecondaryKeyNotFound, "validator %s", consAddr)
+	}
+	if err != nil {
+		return err
+	}
+	if !types.VerifySignature(types.ValidatorKeyType(pubKey), pubKey, signBytes, sig) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "invalid secondary signature of validator %s", consAddr)
+	}
+	return nil
+}
+
+// VerifyValidatorAggregateSignature checks that sig aggregates signatures over
+// signBytes by the BLS12-381 secondary keys registered for the validators with
+// the given consensus addresses.
+func (k Keeper) VerifyValidatorAggregateSignature(ctx context.Context, consAddrs []sdk.ConsAddress, signBytes, sig []byte) error {
+	pubKeys := make([][]byte, len(consAddrs))
+	for i, consAddr := range consAddrs {
+		pubKey, err := k.VoteExtensionMap.Get(ctx, sdk.AccAddress(consAddr))
+		if errors.Is(err, collections.ErrNotFound) {
+			return errorsmod.Wrapf(types.ErrSecondaryKeyNotFound, "validator %s", consAddr)
+		}
+		if err != nil {
+			return err
+		}
+		if types.ValidatorKeyType(pubKey) != types.KeyType_KEY_TYPE_BLS12_381 {
+			return errorsmod.Wrapf(types.ErrInvalidKeyType, "secondary key of validator %s is not %s", consAddr, types.KeyType_KEY_TYPE_BLS12_381)
+		}
+		pubKeys[i] = pubKey
+	}
+	if !types.VerifyBLS12381Aggregate(pubKeys, signBytes, sig) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid aggregate secondary signature")
+	}
+	return nil
+}
+
+// GetRotationSequence returns the number of keys addr rotated away from or
+// removed from its key set, which is also the sequence the next rotation or
+// key set change is signed over.
+func (k Keeper) GetRotationSequence(ctx context.Context, addr sdk.AccAddress) (uint64, error) {
+	iter, err := k.KeyHistory.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr))
+	if err != nil {
+		return 0, err
+	}
+	defer iter.Close()
+
+	var sequence uint64
+	for ; iter.Valid(); iter.Next() {
+		sequence++
+	}
+	return sequence, nil
+}
+
+// GetSecondaryKeyOwners returns the accounts pubKey is registered for.
+func (k Keeper) GetSecondaryKeyOwners(ctx context.Context, pubKey []byte) ([]sdk.AccAddress, error) {
+	return k.AnteHandlerMap.Indexes.PubKey.Owners(ctx, pubKey)
+}
+
+// SecondarySignatureRequired reports whether a transaction with msgs needs a
+// secondary signature from addr. It does when addr has a registered secondary
+// key and either one of msgs weakens its protection (see WeakenedAccounts),
+// addr opted in through MsgSetSecondarySignatureRequired or one of msgs,
+// including msgs nested in authz.MsgExec, has a type listed in the
+// required_msg_type_urls param.
+func (k Keeper) SecondarySignatureRequired(ctx context.Context, addr sdk.AccAddress, msgs []sdk.Msg) (bool, error) {
+	registered, err := k.AnteHandlerMap.Has(ctx, addr)
+	if err != nil || !registered {
+		return false, err
+	}
+
+	weakened, err := k.WeakenedAccounts(msgs)
+	if err != nil {
+		return false, err
+	}
+	if slices.ContainsFunc(weakened, func(weakened sdk.AccAddress) bool { return weakened.Equals(addr) }) {
+		return true, nil
+	}
+
+	required, err := k.RequiredAccounts.Has(ctx, addr)
+	if err != nil || required {
+		return required, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		params = types.DefaultParams()
+	} else if err != nil {
+		return false, err
+	}
+	return containsRequiredMsg(params, msgs)
+}
+
+// VerifySecondarySignature verifies the signature sig of pubKey over the 32
+// byte digest hash. WebAuthn assertions must be made for a relying party ID
+// allowed by the params.
+func (k Keeper) VerifySecondarySignature(ctx context.Context, pubKey types.SecondaryPubKey, hash, sig []byte) error {
+	var rpIDs []string
+	if pubKey.KeyType == types.KeyType_KEY_TYPE_WEBAUTHN {
+		params, err := k.Params.Get(ctx)
+		if errors.Is(err, collections.ErrNotFound) {
+			params = types.DefaultParams()
+		} else if err != nil {
+			return err
+		}
+		rpIDs = params.WebauthnRpIds
+	}
+	return pubKey.VerifySignature(hash, sig, rpIDs)
+}
+
+// WeakenedAccounts returns the accounts whose secondary key protection msgs,
+// or the msgs nested in them, weaken without the key set authorising it in
+// the msg itself: revoking the secondary key, no longer requiring secondary
+// signatures and registering a session key. The primary key alone must not be
+// able to send them, so they always need a secondary signature. The senders
+// are decoded with the address codec, which accepts any case of an address.
+func (k Keeper) WeakenedAccounts(msgs []sdk.Msg) ([]sdk.AccAddress, error) {
+	var accounts []sdk.AccAddress
+	for _, msg := range msgs {
+		var sender string
+		switch msg := msg.(type) {
+		case *types.MsgRevokeSecondaryKey:
+			sender = msg.Sender
+		case *types.MsgSetSecondarySignatureRequired:
+			if msg.Required {
+				continue
+			}
+			sender = msg.Sender
+		case *types.MsgRegisterSessionKey:
+			sender = msg.Sender
+		case interface{ GetMessages() ([]sdk.Msg, error) }:
+			inner, err := msg.GetMessages()
+			if err != nil {
+				return nil, err
+			}
+			nested, err := k.WeakenedAccounts(inner)
+			if err != nil {
+				return nil, err
+			}
+			accounts = append(accounts, nested...)
+			continue
+		default:
+			continue
+		}
+
+		addr, err := k.addressCodec.StringToBytes(sender)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "invalid sender address")
+		}
+		accounts = append(accounts, addr)
+	}
+	return accounts, nil
+}
+
+// containsRequiredMsg reports whether msgs, or the msgs nested in them,
+// contain a Msg that requires a secondary signature.
+func containsRequiredMsg(params types.Params, msgs []sdk.Msg) (bool, error) {
+	for _, msg := range msgs {
+		if params.RequiresSecondarySignature(sdk.MsgTypeURL(msg)) {
+			return true, nil
+		}
+		nested, ok := msg.(interface{ GetMessages() ([]sdk.Msg, error) })
+		if !ok {
+			continue
+		}
+		inner, err := nested.GetMessages()
+		if err != nil {
+			return false, err
+		}
+		if found, err := containsRequiredMsg(params, inner); err != nil || found {
+			return found, err
+		}
+	}
+	return false, nil
+}
@@ -3,25 +3,143 @@ package keeper_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
 
+	"example/common"
+	"example/testutil/sample"
 	"example/x/secondarykeys/keeper"
 	module "example/x/secondarykeys/module"
 	"example/x/secondarykeys/types"
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx            context.Context
+	keeper         keeper.Keeper
+	addressCodec   address.Codec
+	authKeeper     *mockAuthKeeper
+	stakingKeeper  *mockStakingKeeper
+	slashingKeeper *mockSlashingKeeper
+}
+
+// mockAuthKeeper holds the accounts added with addAccount.
+type mockAuthKeeper struct {
+	accounts map[string]sdk.AccountI
+}
+
+func (m *mockAuthKeeper) AddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+}
+
+func (m *mockAuthKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return m.accounts[addr.String()]
+}
+
+func (m *mockAuthKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	m.accounts[acc.GetAddress().String()] = acc
+}
+
+// addAccount adds an account with pubKey, which may be nil, and returns its
+// address.
+func (m *mockAuthKeeper) addAccount(t *testing.T, pubKey cryptotypes.PubKey) sdk.AccAddress {
+	t.Helper()
+
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	acc := authtypes.NewBaseAccountWithAddress(addr)
+	acc.AccountNumber = uint64(len(m.accounts))
+	require.NoError(t, acc.SetPubKey(pubKey))
+	m.accounts[addr.String()] = acc
+	return addr
+}
+
+// mockStakingKeeper serves the validators added with addValidator.
+type mockStakingKeeper struct {
+	validators map[string]stakingtypes.Validator
+}
+
+func (m *mockStakingKeeper) ValidatorAddressCodec() address.Codec {
+	return addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32ValidatorAddrPrefix())
+}
+
+func (m *mockStakingKeeper) GetValidator(_ context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error) {
+	validator, ok := m.validators[addr.String()]
+	if !ok {
+		return stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound
+	}
+	return validator, nil
+}
+
+func (m *mockStakingKeeper) ValidatorByConsAddr(_ context.Context, consAddr sdk.ConsAddress) (stakingtypes.ValidatorI, error) {
+	operator, ok := m.operatorByConsAddr(consAddr)
+	if !ok {
+		return nil, stakingtypes.ErrNoValidatorFound
+	}
+	return m.validators[operator], nil
+}
+
+// operatorByConsAddr returns the operator of the validator with consAddr.
+func (m *mockStakingKeeper) operatorByConsAddr(consAddr sdk.ConsAddress) (string, bool) {
+	for operator, validator := range m.validators {
+		if addr, err := validator.GetConsAddr(); err == nil && consAddr.Equals(sdk.ConsAddress(addr)) {
+			return operator, true
+		}
+	}
+	return "", false
+}
+
+// mockSlashingKeeper jails the validators of its staking keeper and records
+// the slash fractions and jail times.
+type mockSlashingKeeper struct {
+	stakingKeeper *mockStakingKeeper
+	slashed       map[string]math.LegacyDec
+	jailedUntil   map[string]time.Time
+}
+
+func (m *mockSlashingKeeper) Jail(_ context.Context, consAddr sdk.ConsAddress) error {
+	operator, ok := m.stakingKeeper.operatorByConsAddr(consAddr)
+	if !ok {
+		return stakingtypes.ErrNoValidatorFound
+	}
+	validator := m.stakingKeeper.validators[operator]
+	validator.Jailed = true
+	m.stakingKeeper.validators[operator] = validator
+	return nil
+}
+
+func (m *mockSlashingKeeper) JailUntil(_ context.Context, consAddr sdk.ConsAddress, jailTime time.Time) error {
+	m.jailedUntil[consAddr.String()] = jailTime
+	return nil
+}
+
+func (m *mockSlashingKeeper) SlashWithInfractionReason(_ context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, _, _ int64, _ stakingtypes.Infraction) error {
+	m.slashed[consAddr.String()] = fraction
+	return nil
+}
+
+// addValidator adds a validator with a random consensus key and returns its
+// operator and consensus addresses.
+func (m *mockStakingKeeper) addValidator(t *testing.T) (sdk.ValAddress, sdk.ConsAddress) {
+	t.Helper()
+
+	operator := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress()))
+	consPubKey := ed25519.GenPrivKey().PubKey()
+	validator, err := stakingtypes.NewValidator(operator.String(), consPubKey, stakingtypes.Description{})
+	require.NoError(t, err)
+	m.validators[operator.String()] = validator
+	return operator, sdk.ConsAddress(consPubKey.Address())
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,12 +153,22 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	authKeeper := &mockAuthKeeper{accounts: make(map[string]sdk.AccountI)}
+	stakingKeeper := &mockStakingKeeper{validators: make(map[string]stakingtypes.Validator)}
+	slashingKeeper := &mockSlashingKeeper{
+		stakingKeeper: stakingKeeper,
+		slashed:       make(map[string]math.LegacyDec),
+		jailedUntil:   make(map[string]time.Time),
+	}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		authKeeper,
+		stakingKeeper,
+		slashingKeeper,
 	)
 
 	// Initialize params
@@ -49,8 +177,26 @@ func initFixture(t *testing.T) *fixture {
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:            ctx,
+		keeper:         k,
+		addressCodec:   addressCodec,
+		authKeeper:     authKeeper,
+		stakingKeeper:  stakingKeeper,
+		slashingKeeper: slashingKeeper,
 	}
 }
+
+// registerKey registers priv as the secondary key of account.
+func registerKey(t *testing.T, f *fixture, account sdk.AccAddress, priv common.SecondaryPrivKey) {
+	t.Helper()
+
+	pubKey, pop, err := common.SignProofOfPossession(priv, account)
+	require.NoError(t, err)
+	_, err = keeper.NewMsgServerImpl(f.keeper).RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    account.String(),
+		KeyType:   priv.KeyType(),
+		PublicKey: pubKey,
+		Signature: pop,
+	})
+	require.NoError(t, err)
+}
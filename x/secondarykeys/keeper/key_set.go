@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"example/x/secondarykeys/types"
+)
+
+// KeySetIndex indexes the AnteHandlerMap by the public key of every member of
+// a key set. It stores the (public key, account) pairs an indexes.Multi over
+// single keys would.
+type KeySetIndex struct {
+	refKeys collections.KeySet[collections.Pair[[]byte, sdk.AccAddress]]
+}
+
+// NewKeySetIndex returns the KeySetIndex stored under prefix.
+func NewKeySetIndex(sb *collections.SchemaBuilder, prefix collections.Prefix, name string) *KeySetIndex {
+	return &KeySetIndex{
+		refKeys: collections.NewKeySet(
+			sb,
+			prefix,
+			name,
+			collections.PairKeyCodec(collections.BytesKey, sdk.AccAddressKey),
+			collections.WithKeySetSecondaryIndex(),
+		),
+	}
+}
+
+// Reference implements collections.Index.
+func (i *KeySetIndex) Reference(ctx context.Context, addr sdk.AccAddress, keySet types.SecondaryKeySet, lazyOldValue func() (types.SecondaryKeySet, error)) error {
+	if err := i.Unreference(ctx, addr, lazyOldValue); err != nil {
+		return err
+	}
+	for _, member := range keySet.Members {
+		if err := i.refKeys.Set(ctx, collections.Join(member.PublicKey, addr)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unreference implements collections.Index.
+func (i *KeySetIndex) Unreference(ctx context.Context, addr sdk.AccAddress, lazyOldValue func() (types.SecondaryKeySet, error)) error {
+	oldKeySet, err := lazyOldValue()
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	for _, member := range oldKeySet.Members {
+		if err := i.refKeys.Remove(ctx, collections.Join(member.PublicKey, addr)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Owners returns the accounts whose key set pubKey is a member of.
+func (i *KeySetIndex) Owners(ctx context.Context, pubKey []byte) ([]sdk.AccAddress, error) {
+	iter, err := i.refKeys.Iterate(ctx, collections.NewPrefixedPairRange[[]byte, sdk.AccAddress](pubKey))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var owners []sdk.AccAddress
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		owners = append(owners, key.K2())
+	}
+	return owners, nil
+}
+
+// VerifyKeySetSignatures verifies signatures over the 32 byte digest hash,
+// each by a distinct member of keySet, and checks that the weights of the
+// signing members reach its threshold.
+func (k Keeper) VerifyKeySetSignatures(ctx context.Context, keySet types.SecondaryKeySet, hash []byte, signatures []types.SecondaryKeySignature) error {
+	var weight uint64
+	signed := make(map[string]struct{}, len(signatures))
+	for _, sig := range signatures {
+		member, ok := keySet.Member(sig.PublicKey)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidSecondarySignature, "%X is not a member of the key set", sig.PublicKey)
+		}
+		if _, ok := signed[string(sig.PublicKey)]; ok {
+			return errorsmod.Wrapf(types.ErrInvalidSecondarySignature, "duplicate signature of %X", sig.PublicKey)
+		}
+		signed[string(sig.PublicKey)] = struct{}{}
+
+		if err := k.VerifySecondarySignature(ctx, member.PubKey(), hash, sig.Signature); err != nil {
+			return err
+		}
+		weight += uint64(member.Weight)
+	}
+	if weight < uint64(keySet.Threshold) {
+		return errorsmod.Wrapf(types.ErrInvalidSecondarySignature, "signature weight %d below the threshold %d", weight, keySet.Threshold)
+	}
+	return nil
+}
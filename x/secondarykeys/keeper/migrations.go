@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "example/x/secondarykeys/migrations/v2"
+	v3 "example/x/secondarykeys/migrations/v3"
+	"example/x/secondarykeys/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the account secondary keys from raw secp256k1 public
+// keys to typed SecondaryPubKeys.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates the account secondary keys from single
+// SecondaryPubKeys to one-key SecondaryKeySets.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate3to4 sets the attestation retention window param, which prunes the
+// attestations that were kept forever before, to its default.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.AttestationRetentionWindow = types.DefaultAttestationRetentionWindow
+	return m.keeper.Params.Set(ctx, params)
+}
@@ -0,0 +1,26 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestMigrate3to4(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.AttestationRetentionWindow = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(sdk.UnwrapSDKContext(f.ctx)))
+
+	migrated, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	params.AttestationRetentionWindow = types.DefaultAttestationRetentionWindow
+	require.Equal(t, params, migrated)
+}
@@ -8,35 +8,42 @@ import (
 	"example/x/secondarykeys/types"
 
 	errorsmod "cosmossdk.io/errors"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/crypto"
-	EthereumK1 "github.com/ethereum/go-ethereum/crypto"
 )
 
+// BroadcastData registers a secondary key from a legacy memo encoded payload.
+//
+// Deprecated: use RegisterSecondaryKey instead.
 func (k msgServer) BroadcastData(ctx context.Context, msg *types.MsgBroadcastData) (*types.MsgBroadcastDataResponse, error) {
-	if _, err := k.addressCodec.StringToBytes(msg.Sender); err != nil {
-		return nil, errorsmod.Wrap(err, "invalid authority address")
-	}
-	msg.Data = strings.Trim(msg.Data, "SECONDARY")
-	secondSig, err := common.DecodeSecondSigFromMemo([]byte(msg.Data))
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
 	if err != nil {
-		panic(err)
+		return nil, errorsmod.Wrap(err, "invalid sender address")
 	}
-	err = secondSig.Validate()
+
+	data := strings.TrimPrefix(msg.Data, types.AnteHandlerPrefix)
+	secondSig, err := common.DecodeSecondSigFromMemo([]byte(data))
 	if err != nil {
-		panic(err)
+		return nil, errorsmod.Wrap(types.ErrInvalidData, err.Error())
+	}
+	if err := secondSig.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidData, err.Error())
+	}
+
+	// legacy payloads only ever carried Ethereum style secp256k1 keys
+	keyType := types.KeyType_KEY_TYPE_SECP256K1
+	if err := types.ValidatePublicKey(keyType, secondSig.PublicKey); err != nil {
+		return nil, err
+	}
+
+	// legacy payloads sign the hash of the public key itself
+	hash := crypto.Keccak256(secondSig.PublicKey)
+	if !types.VerifySignature(keyType, secondSig.PublicKey, hash, secondSig.Signature) {
+		return nil, types.ErrInvalidProofOfPossession
 	}
 
-	hsh := crypto.Keccak256([]byte(secondSig.PublicKey))
-	if EthereumK1.VerifySignature(secondSig.PublicKey, hsh, secondSig.Signature) {
-		f, err := sdk.AccAddressFromBech32(msg.Sender)
-		if err != nil {
-			panic(err)
-		}
-		err = k.SetSecondaryPubKeyAnteHandler(ctx, f, secondSig.PublicKey)
-		if err != nil {
-			panic(err)
-		}
+	if err := k.registerSecondaryKey(ctx, sender, keyType, secondSig.PublicKey); err != nil {
+		return nil, err
 	}
+
 	return &types.MsgBroadcastDataResponse{}, nil
 }
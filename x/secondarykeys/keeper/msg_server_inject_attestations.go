@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"example/x/secondarykeys/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// InjectAttestations runs the transaction PrepareProposal injects. The
+// module's pre-blocker has already verified and stored the attestation it
+// carries, so there is nothing left to do.
+func (k msgServer) InjectAttestations(ctx context.Context, msg *types.MsgInjectAttestations) (*types.MsgInjectAttestationsResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+	if !bytes.Equal(authority, types.InjectedTxAuthority) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected the %s module account, got %s", types.ModuleName, msg.Authority)
+	}
+	return &types.MsgInjectAttestationsResponse{}, nil
+}
@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestMsgInjectAttestations(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(types.InjectedTxAuthority)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgInjectAttestations
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "invalid authority",
+			input:     &types.MsgInjectAttestations{Authority: "invalid"},
+			expErr:    true,
+			expErrMsg: "invalid authority address",
+		},
+		{
+			name:      "other account",
+			input:     &types.MsgInjectAttestations{Authority: sample.AccAddress()},
+			expErr:    true,
+			expErrMsg: "expected the secondarykeys module account",
+		},
+		{
+			name:   "module account",
+			input:  &types.MsgInjectAttestations{Authority: authorityStr},
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.InjectAttestations(f.ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
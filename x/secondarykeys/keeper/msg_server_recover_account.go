@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"example/x/secondarykeys/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) RecoverAccount(ctx context.Context, msg *types.MsgRecoverAccount) (*types.MsgRecoverAccountResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Submitter); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid submitter address")
+	}
+	account, err := k.addressCodec.StringToBytes(msg.Account)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid account address")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		params = types.DefaultParams()
+	} else if err != nil {
+		return nil, err
+	}
+	if !params.RecoveryEnabled() {
+		return nil, types.ErrRecoveryDisabled
+	}
+
+	pending, err := k.PendingRecoveries.Has(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if pending {
+		return nil, errorsmod.Wrapf(types.ErrRecoveryPending, "account %s", msg.Account)
+	}
+
+	keySet, err := k.GetSecondaryKeySet(ctx, account)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(types.ErrSecondaryKeyNotFound, "account %s", msg.Account)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if msg.NewPublicKey == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "missing new public key")
+	}
+	recovery := types.PendingRecovery{
+		Account:      msg.Account,
+		NewPublicKey: msg.NewPublicKey,
+		ExecuteTime:  sdk.UnwrapSDKContext(ctx).BlockTime().Add(params.RecoveryDelay),
+	}
+	newPubKey, err := k.recoveryPubKey(recovery)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+
+	acc := k.authKeeper.GetAccount(ctx, account)
+	if acc == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", msg.Account)
+	}
+	if current := acc.GetPubKey(); current != nil && current.Equals(newPubKey) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, "new public key is the current primary public key")
+	}
+
+	hash, err := types.RecoverAccountBytes(account, acc.GetAccountNumber(), acc.GetSequence(), acc.GetPubKey(), newPubKey)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.VerifyKeySetSignatures(ctx, keySet, hash, msg.Signatures); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetPendingRecovery(ctx, account, recovery); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRecoverAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Account),
+			sdk.NewAttribute(types.AttributeKeySubmitter, msg.Submitter),
+			sdk.NewAttribute(types.AttributeKeyNewPublicKey, hex.EncodeToString(newPubKey.Bytes())),
+			sdk.NewAttribute(types.AttributeKeyExecuteTime, recovery.ExecuteTime.String()),
+		),
+	)
+
+	return &types.MsgRecoverAccountResponse{ExecuteTime: recovery.ExecuteTime}, nil
+}
+
+func (k msgServer) CancelRecovery(ctx context.Context, msg *types.MsgCancelRecovery) (*types.MsgCancelRecoveryResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	removed, err := k.RemovePendingRecovery(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	if !removed {
+		return nil, errorsmod.Wrapf(types.ErrRecoveryNotFound, "account %s", msg.Sender)
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelRecovery,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+		),
+	)
+
+	return &types.MsgCancelRecoveryResponse{}, nil
+}
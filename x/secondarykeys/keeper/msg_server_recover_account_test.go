@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"example/common"
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+// recoverAccountMsg returns the MsgRecoverAccount recovering account to
+// newPubKey, signed by signers.
+func recoverAccountMsg(t *testing.T, f *fixture, account sdk.AccAddress, newPubKey cryptotypes.PubKey, signers ...common.SecondaryPrivKey) *types.MsgRecoverAccount {
+	t.Helper()
+
+	acc := f.authKeeper.GetAccount(f.ctx, account)
+	sigs := make([]types.SecondaryKeySignature, len(signers))
+	for i, priv := range signers {
+		var err error
+		sigs[i], err = common.SignRecoverAccount(priv, acc, newPubKey)
+		require.NoError(t, err)
+	}
+	pubKeyAny, err := codectypes.NewAnyWithValue(newPubKey)
+	require.NoError(t, err)
+	return &types.MsgRecoverAccount{
+		Submitter:    sample.AccAddress(),
+		Account:      account.String(),
+		NewPublicKey: pubKeyAny,
+		Signatures:   sigs,
+	}
+}
+
+func TestMsgRecoverAccount(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	f.ctx = ctx
+
+	lost := secp256k1.GenPrivKey().PubKey()
+	newPubKey := secp256k1.GenPrivKey().PubKey()
+	account := f.authKeeper.addAccount(t, lost)
+	secondary, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_ED25519)
+	require.NoError(t, err)
+	registerKey(t, f, account, secondary)
+	other, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_ED25519)
+	require.NoError(t, err)
+
+	noKeyAccount := f.authKeeper.addAccount(t, nil)
+	bogusPubKey, err := codectypes.NewAnyWithValue(&types.Params{})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRecoverAccount
+		expErr error
+		errMsg string
+	}{
+		{
+			name:   "invalid account",
+			msg:    &types.MsgRecoverAccount{Submitter: sample.AccAddress(), Account: "invalid"},
+			errMsg: "invalid account address",
+		},
+		{
+			name:   "no secondary key",
+			msg:    recoverAccountMsg(t, f, noKeyAccount, newPubKey, secondary),
+			expErr: types.ErrSecondaryKeyNotFound,
+		},
+		{
+			name:   "missing new public key",
+			msg:    &types.MsgRecoverAccount{Submitter: sample.AccAddress(), Account: account.String()},
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name: "new public key is not a public key",
+			msg: func() *types.MsgRecoverAccount {
+				msg := recoverAccountMsg(t, f, account, newPubKey, secondary)
+				msg.NewPublicKey = bogusPubKey
+				return msg
+			}(),
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name:   "current primary key",
+			msg:    recoverAccountMsg(t, f, account, lost, secondary),
+			expErr: sdkerrors.ErrInvalidPubKey,
+		},
+		{
+			name:   "not signed",
+			msg:    recoverAccountMsg(t, f, account, newPubKey),
+			expErr: types.ErrInvalidSecondarySignature,
+		},
+		{
+			name:   "signed by another key",
+			msg:    recoverAccountMsg(t, f, account, newPubKey, other),
+			expErr: types.ErrInvalidSecondarySignature,
+		},
+		{
+			name: "signed for another public key",
+			msg: func() *types.MsgRecoverAccount {
+				msg := recoverAccountMsg(t, f, account, secp256k1.GenPrivKey().PubKey(), secondary)
+				msg.NewPublicKey = recoverAccountMsg(t, f, account, newPubKey).NewPublicKey
+				return msg
+			}(),
+			expErr: types.ErrInvalidSecondarySignature,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RecoverAccount(f.ctx, tc.msg)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+
+	msg := recoverAccountMsg(t, f, account, newPubKey, secondary)
+	res, err := ms.RecoverAccount(f.ctx, msg)
+	require.NoError(t, err)
+	executeTime := ctx.BlockTime().Add(types.DefaultRecoveryDelay)
+	require.Equal(t, executeTime, res.ExecuteTime)
+
+	queryRes, err := keeper.NewQueryServerImpl(f.keeper).PendingRecovery(f.ctx, &types.QueryPendingRecoveryRequest{Address: account.String()})
+	require.NoError(t, err)
+	require.Equal(t, account.String(), queryRes.Recovery.Account)
+	require.Equal(t, executeTime, queryRes.Recovery.ExecuteTime)
+
+	_, err = ms.RecoverAccount(f.ctx, recoverAccountMsg(t, f, account, secp256k1.GenPrivKey().PubKey(), secondary))
+	require.ErrorIs(t, err, types.ErrRecoveryPending)
+
+	// the primary key keeps the account until the timelock expires
+	require.NoError(t, f.keeper.ExecuteRecoveries(ctx.WithBlockTime(executeTime.Add(-time.Second))))
+	require.True(t, lost.Equals(f.authKeeper.GetAccount(f.ctx, account).GetPubKey()))
+
+	require.NoError(t, f.keeper.ExecuteRecoveries(ctx.WithBlockTime(executeTime)))
+	require.True(t, newPubKey.Equals(f.authKeeper.GetAccount(f.ctx, account).GetPubKey()))
+	_, err = keeper.NewQueryServerImpl(f.keeper).PendingRecovery(f.ctx, &types.QueryPendingRecoveryRequest{Address: account.String()})
+	require.ErrorContains(t, err, "not found")
+
+	// the signatures commit to the replaced primary key
+	_, err = ms.RecoverAccount(f.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidPubKey)
+	msg.NewPublicKey = recoverAccountMsg(t, f, account, secp256k1.GenPrivKey().PubKey()).NewPublicKey
+	_, err = ms.RecoverAccount(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidSecondarySignature)
+
+	// governance can turn recovery off
+	params := types.DefaultParams()
+	params.RecoveryDelay = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	_, err = ms.RecoverAccount(f.ctx, recoverAccountMsg(t, f, account, secp256k1.GenPrivKey().PubKey(), secondary))
+	require.ErrorIs(t, err, types.ErrRecoveryDisabled)
+}
+
+func TestMsgCancelRecovery(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Unix(1_700_000_000, 0).UTC())
+	f.ctx = ctx
+
+	primary := secp256k1.GenPrivKey().PubKey()
+	account := f.authKeeper.addAccount(t, primary)
+	secondary, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_SECP256R1)
+	require.NoError(t, err)
+	registerKey(t, f, account, secondary)
+
+	_, err = ms.CancelRecovery(f.ctx, &types.MsgCancelRecovery{Sender: account.String()})
+	require.ErrorIs(t, err, types.ErrRecoveryNotFound)
+
+	_, err = ms.RecoverAccount(f.ctx, recoverAccountMsg(t, f, account, secp256k1.GenPrivKey().PubKey(), secondary))
+	require.NoError(t, err)
+	_, err = ms.CancelRecovery(f.ctx, &types.MsgCancelRecovery{Sender: account.String()})
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.ExecuteRecoveries(ctx.WithBlockTime(ctx.BlockTime().Add(types.DefaultRecoveryDelay))))
+	require.True(t, primary.Equals(f.authKeeper.GetAccount(f.ctx, account).GetPubKey()))
+	has, err := f.keeper.RecoveryQueue.Has(f.ctx, collections.Join(ctx.BlockTime().Add(types.DefaultRecoveryDelay), account))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	// revoking the secondary key voids the recovery it authorised
+	_, err = ms.RecoverAccount(f.ctx, recoverAccountMsg(t, f, account, secp256k1.GenPrivKey().PubKey(), secondary))
+	require.NoError(t, err)
+	_, err = ms.RevokeSecondaryKey(f.ctx, &types.MsgRevokeSecondaryKey{Sender: account.String()})
+	require.NoError(t, err)
+	has, err = f.keeper.PendingRecoveries.Has(f.ctx, account)
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"example/x/secondarykeys/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RegisterSecondaryKey(ctx context.Context, msg *types.MsgRegisterSecondaryKey) (*types.MsgRegisterSecondaryKeyResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := types.ValidateAccountPublicKey(msg.KeyType, msg.PublicKey); err != nil {
+		return nil, err
+	}
+
+	hash := types.ProofOfPossessionBytes(sender, msg.PublicKey)
+	if err := k.VerifySecondarySignature(ctx, types.NewSecondaryPubKey(msg.KeyType, msg.PublicKey), hash, msg.Signature); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidProofOfPossession, err.Error())
+	}
+
+	if err := k.registerSecondaryKey(ctx, sender, msg.KeyType, msg.PublicKey); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterSecondaryKeyResponse{}, nil
+}
+
+// registerSecondaryKey stores an already verified secondary key for addr and
+// emits the registration event. An existing key can only be replaced through
+// a rotation. Registering lifts a previous revocation and lockdown.
+func (k Keeper) registerSecondaryKey(ctx context.Context, addr sdk.AccAddress, keyType types.KeyType, pubKey []byte) error {
+	exists, err := k.AnteHandlerMap.Has(ctx, addr)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return errorsmod.Wrap(types.ErrSecondaryKeyExists, "use MsgRotateSecondaryKey to replace it")
+	}
+	if err := k.checkNotTombstoned(ctx, pubKey); err != nil {
+		return err
+	}
+	if err := k.checkNotShared(ctx, addr, pubKey); err != nil {
+		return err
+	}
+
+	if err := k.SetSecondaryKeySet(ctx, addr, types.NewSingleKeySet(types.NewSecondaryPubKey(keyType, pubKey))); err != nil {
+		return err
+	}
+	if err := k.RevokedAccounts.Remove(ctx, addr); err != nil {
+		return err
+	}
+	if err := k.LockedAccounts.Remove(ctx, addr); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterSecondaryKey,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyKeyType, keyType.String()),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(pubKey)),
+		),
+	)
+	return nil
+}
+
+// checkNotTombstoned returns an error if pubKey has been revoked before.
+func (k Keeper) checkNotTombstoned(ctx context.Context, pubKey []byte) error {
+	tombstoned, err := k.Tombstones.Has(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	if tombstoned {
+		return types.ErrSecondaryKeyTombstoned
+	}
+	return nil
+}
+
+// checkNotShared returns an error if pubKey is registered for an account
+// other than addr and the params do not allow shared keys.
+func (k Keeper) checkNotShared(ctx context.Context, addr sdk.AccAddress, pubKey []byte) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if params.AllowSharedSecondaryKeys {
+		return nil
+	}
+
+	owners, err := k.GetSecondaryKeyOwners(ctx, pubKey)
+	if err != nil {
+		return err
+	}
+	for _, owner := range owners {
+		if !owner.Equals(addr) {
+			return errorsmod.Wrapf(types.ErrSecondaryKeyInUse, "registered for %s", owner)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,260 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"example/common"
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestMsgRegisterSecondaryKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	senderStr, err := f.addressCodec.BytesToString(sender)
+	require.NoError(t, err)
+
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey, signature, err := common.SignProofOfPossession(common.NewSecp256k1PrivKey(priv), sender)
+	require.NoError(t, err)
+
+	// a proof of possession produced for another account must not be accepted
+	_, otherSignature, err := common.SignProofOfPossession(common.NewSecp256k1PrivKey(priv), sdk.MustAccAddressFromBech32(sample.AccAddress()))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgRegisterSecondaryKey
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid sender",
+			input: &types.MsgRegisterSecondaryKey{
+				Sender:    "invalid",
+				KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+				PublicKey: pubKey,
+				Signature: signature,
+			},
+			expErr:    true,
+			expErrMsg: "invalid sender address",
+		},
+		{
+			name: "unspecified key type",
+			input: &types.MsgRegisterSecondaryKey{
+				Sender:    senderStr,
+				PublicKey: pubKey,
+				Signature: signature,
+			},
+			expErr:    true,
+			expErrMsg: "invalid secondary key type",
+		},
+		{
+			name: "malformed public key",
+			input: &types.MsgRegisterSecondaryKey{
+				Sender:    senderStr,
+				KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+				PublicKey: pubKey[:33],
+				Signature: signature,
+			},
+			expErr:    true,
+			expErrMsg: "invalid secondary public key",
+		},
+		{
+			name: "proof of possession for another account",
+			input: &types.MsgRegisterSecondaryKey{
+				Sender:    senderStr,
+				KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+				PublicKey: pubKey,
+				Signature: otherSignature,
+			},
+			expErr:    true,
+			expErrMsg: "invalid proof of possession",
+		},
+		{
+			name: "all good",
+			input: &types.MsgRegisterSecondaryKey{
+				Sender:    senderStr,
+				KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+				PublicKey: pubKey,
+				Signature: signature,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RegisterSecondaryKey(f.ctx, tc.input)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			stored, err := f.keeper.GetSecondaryKeySet(f.ctx, sender)
+			require.NoError(t, err)
+			require.Equal(t, types.NewSingleKeySet(types.NewSecondaryPubKey(types.KeyType_KEY_TYPE_SECP256K1, pubKey)), stored)
+		})
+	}
+}
+
+func TestMsgBroadcastData(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	senderStr, err := f.addressCodec.BytesToString(sender)
+	require.NoError(t, err)
+
+	memo, err := common.CreateValidMemo()
+	require.NoError(t, err)
+
+	_, err = ms.BroadcastData(f.ctx, &types.MsgBroadcastData{Sender: senderStr, Data: "SECONDARY{"})
+	require.ErrorIs(t, err, types.ErrInvalidData)
+
+	_, err = ms.BroadcastData(f.ctx, &types.MsgBroadcastData{Sender: senderStr, Data: memo})
+	require.NoError(t, err)
+
+	has, err := f.keeper.AnteHandlerMap.Has(f.ctx, sender)
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestMsgRegisterSecondaryKeySharedKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	register := func() error {
+		sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		pubKey, signature, err := common.SignProofOfPossession(common.NewSecp256k1PrivKey(priv), sender)
+		require.NoError(t, err)
+		_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+			Sender:    sender.String(),
+			KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+			PublicKey: pubKey,
+			Signature: signature,
+		})
+		return err
+	}
+
+	require.NoError(t, register())
+	require.ErrorIs(t, register(), types.ErrSecondaryKeyInUse)
+
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{AllowSharedSecondaryKeys: true}))
+	require.NoError(t, register())
+
+	owners, err := f.keeper.GetSecondaryKeyOwners(f.ctx, crypto.FromECDSAPub(&priv.PublicKey))
+	require.NoError(t, err)
+	require.Len(t, owners, 2)
+}
+
+func TestMsgRegisterSecondaryKeyTypes(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	for _, keyType := range []types.KeyType{
+		types.KeyType_KEY_TYPE_SECP256K1,
+		types.KeyType_KEY_TYPE_ED25519,
+		types.KeyType_KEY_TYPE_SECP256R1,
+		types.KeyType_KEY_TYPE_SCHNORR,
+	} {
+		t.Run(keyType.String(), func(t *testing.T) {
+			sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+			priv, err := common.GenSecondaryPrivKey(keyType)
+			require.NoError(t, err)
+			pubKey, signature, err := common.SignProofOfPossession(priv, sender)
+			require.NoError(t, err)
+
+			// the proof of possession is verified with the declared algorithm
+			for _, otherType := range []types.KeyType{types.KeyType_KEY_TYPE_ED25519, types.KeyType_KEY_TYPE_SCHNORR} {
+				if otherType == keyType {
+					continue
+				}
+				_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+					Sender:    sender.String(),
+					KeyType:   otherType,
+					PublicKey: pubKey,
+					Signature: signature,
+				})
+				require.Error(t, err)
+			}
+
+			_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+				Sender:    sender.String(),
+				KeyType:   keyType,
+				PublicKey: pubKey,
+				Signature: signature,
+			})
+			require.NoError(t, err)
+
+			stored, err := f.keeper.GetSecondaryKeySet(f.ctx, sender)
+			require.NoError(t, err)
+			require.Equal(t, types.NewSingleKeySet(types.NewSecondaryPubKey(keyType, pubKey)), stored)
+		})
+	}
+
+	// BLS12-381 keys are for validators only
+	blsPriv, err := types.GenBLS12381PrivKey()
+	require.NoError(t, err)
+	blsPubKey, err := types.BLS12381PubKey(blsPriv)
+	require.NoError(t, err)
+	_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    sample.AccAddress(),
+		KeyType:   types.KeyType_KEY_TYPE_BLS12_381,
+		PublicKey: blsPubKey,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidKeyType)
+}
+
+func TestMsgRegisterSecondaryKeyWebAuthn(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	passkey, err := common.WebAuthnPrivKeyFromSeed(crypto.Keccak256([]byte("passkey")), "example.com", "https://example.com")
+	require.NoError(t, err)
+	pubKey, signature, err := common.SignProofOfPossession(passkey, sender)
+	require.NoError(t, err)
+	msg := &types.MsgRegisterSecondaryKey{
+		Sender:    sender.String(),
+		KeyType:   types.KeyType_KEY_TYPE_WEBAUTHN,
+		PublicKey: pubKey,
+		Signature: signature,
+	}
+
+	// passkeys cannot be registered until governance allows their site
+	_, err = ms.RegisterSecondaryKey(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrInvalidProofOfPossession)
+	require.ErrorContains(t, err, "relying party ID not allowed")
+
+	params := types.DefaultParams()
+	params.WebauthnRpIds = []string{"example.com"}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	// the proof of possession is an assertion, not a plain P-256 signature
+	_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    sender.String(),
+		KeyType:   types.KeyType_KEY_TYPE_SECP256R1,
+		PublicKey: pubKey,
+		Signature: signature,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidProofOfPossession)
+
+	_, err = ms.RegisterSecondaryKey(f.ctx, msg)
+	require.NoError(t, err)
+	stored, err := f.keeper.GetSecondaryKeySet(f.ctx, sender)
+	require.NoError(t, err)
+	require.Equal(t, types.NewSingleKeySet(types.NewSecondaryPubKey(types.KeyType_KEY_TYPE_WEBAUTHN, pubKey)), stored)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+
+	"example/x/secondarykeys/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func (k msgServer) RegisterValidatorSecondaryKey(ctx context.Context, msg *types.MsgRegisterValidatorSecondaryKey) (*types.MsgRegisterValidatorSecondaryKeyResponse, error) {
+	operator, err := k.stakingKeeper.ValidatorAddressCodec().StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid validator address")
+	}
+
+	// Vote extensions are signed with secp256k1 or BLS12-381 keys. The
+	// proof of possession of a BLS12-381 key makes it safe to aggregate.
+	if msg.KeyType != types.KeyType_KEY_TYPE_SECP256K1 && msg.KeyType != types.KeyType_KEY_TYPE_BLS12_381 {
+		return nil, errorsmod.Wrapf(types.ErrInvalidKeyType, "validator keys must be %s or %s", types.KeyType_KEY_TYPE_SECP256K1, types.KeyType_KEY_TYPE_BLS12_381)
+	}
+	if err := types.ValidatePublicKey(msg.KeyType, msg.PublicKey); err != nil {
+		return nil, err
+	}
+
+	hash := types.ValidatorProofOfPossessionBytes(operator, msg.PublicKey)
+	if !types.VerifyProofOfPossession(msg.KeyType, msg.PublicKey, hash, msg.Signature) {
+		return nil, types.ErrInvalidProofOfPossession
+	}
+
+	validator, err := k.stakingKeeper.GetValidator(ctx, operator)
+	if errors.Is(err, stakingtypes.ErrNoValidatorFound) || errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(types.ErrValidatorNotFound, "validator %s", msg.ValidatorAddress)
+	}
+	if err != nil {
+		return nil, err
+	}
+	consAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	// A validator replaces its key by registering a new one.
+	if err := k.SetSecondaryPubKeyVoteExtension(ctx, consAddr, msg.PublicKey); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterValidatorSecondaryKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyConsensusAddress, sdk.ConsAddress(consAddr).String()),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(msg.PublicKey)),
+		),
+	)
+
+	return &types.MsgRegisterValidatorSecondaryKeyResponse{}, nil
+}
@@ -0,0 +1,229 @@
+package keeper_test
+
+import (
+	"crypto/ecdsa"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"example/common"
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestMsgRegisterValidatorSecondaryKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	operator, consAddr := f.stakingKeeper.addValidator(t)
+	unknown := sdk.ValAddress(sdk.MustAccAddressFromBech32(sample.AccAddress()))
+
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey, signature, err := common.SignValidatorProofOfPossession(priv, operator)
+	require.NoError(t, err)
+	_, unknownSignature, err := common.SignValidatorProofOfPossession(priv, unknown)
+	require.NoError(t, err)
+
+	// an account proof of possession of the operator must not be accepted
+	_, accountSignature, err := common.SignProofOfPossession(common.NewSecp256k1PrivKey(priv), sdk.AccAddress(operator))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgRegisterValidatorSecondaryKey
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "invalid validator address",
+			input: &types.MsgRegisterValidatorSecondaryKey{
+				ValidatorAddress: "invalid",
+				KeyType:          types.KeyType_KEY_TYPE_SECP256K1,
+				PublicKey:        pubKey,
+				Signature:        signature,
+			},
+			expErr:    true,
+			expErrMsg: "invalid validator address",
+		},
+		{
+			name: "unspecified key type",
+			input: &types.MsgRegisterValidatorSecondaryKey{
+				ValidatorAddress: operator.String(),
+				PublicKey:        pubKey,
+				Signature:        signature,
+			},
+			expErr:    true,
+			expErrMsg: "invalid secondary key type",
+		},
+		{
+			name: "malformed public key",
+			input: &types.MsgRegisterValidatorSecondaryKey{
+				ValidatorAddress: operator.String(),
+				KeyType:          types.KeyType_KEY_TYPE_SECP256K1,
+				PublicKey:        pubKey[:33],
+				Signature:        signature,
+			},
+			expErr:    true,
+			expErrMsg: "invalid secondary public key",
+		},
+		{
+			name: "account proof of possession",
+			input: &types.MsgRegisterValidatorSecondaryKey{
+				ValidatorAddress: operator.String(),
+				KeyType:          types.KeyType_KEY_TYPE_SECP256K1,
+				PublicKey:        pubKey,
+				Signature:        accountSignature,
+			},
+			expErr:    true,
+			expErrMsg: "invalid proof of possession",
+		},
+		{
+			name: "unknown validator",
+			input: &types.MsgRegisterValidatorSecondaryKey{
+				ValidatorAddress: unknown.String(),
+				KeyType:          types.KeyType_KEY_TYPE_SECP256K1,
+				PublicKey:        pubKey,
+				Signature:        unknownSignature,
+			},
+			expErr:    true,
+			expErrMsg: "validator not found",
+		},
+		{
+			name: "all good",
+			input: &types.MsgRegisterValidatorSecondaryKey{
+				ValidatorAddress: operator.String(),
+				KeyType:          types.KeyType_KEY_TYPE_SECP256K1,
+				PublicKey:        pubKey,
+				Signature:        signature,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RegisterValidatorSecondaryKey(f.ctx, tc.input)
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			stored, err := f.keeper.GetSecondaryPubKeyVoteExtension(f.ctx, sdk.AccAddress(consAddr))
+			require.NoError(t, err)
+			require.Equal(t, pubKey, stored)
+		})
+	}
+}
+
+func TestMsgRegisterValidatorSecondaryKeyReplacesKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	operator, consAddr := f.stakingKeeper.addValidator(t)
+
+	var privs []*ecdsa.PrivateKey
+	for range 2 {
+		priv, err := crypto.GenerateKey()
+		require.NoError(t, err)
+		pubKey, signature, err := common.SignValidatorProofOfPossession(priv, operator)
+		require.NoError(t, err)
+		_, err = ms.RegisterValidatorSecondaryKey(f.ctx, &types.MsgRegisterValidatorSecondaryKey{
+			ValidatorAddress: operator.String(),
+			KeyType:          types.KeyType_KEY_TYPE_SECP256K1,
+			PublicKey:        pubKey,
+			Signature:        signature,
+		})
+		require.NoError(t, err)
+		privs = append(privs, priv)
+	}
+
+	// only the latest key verifies vote extensions
+	hash := types.VoteExtensionSignBytes("example", 1, crypto.Keccak256([]byte("block")))
+	for i, priv := range privs {
+		sig, err := crypto.Sign(hash, priv)
+		require.NoError(t, err)
+		err = f.keeper.VerifyValidatorSignature(f.ctx, consAddr, hash, sig)
+		if i == len(privs)-1 {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestMsgRegisterValidatorBLS12381SecondaryKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	hash := types.VoteExtensionSignBytes("example", 1, crypto.Keccak256([]byte("block")))
+	var (
+		consAddrs  []sdk.ConsAddress
+		signatures [][]byte
+	)
+	for range 2 {
+		operator, consAddr := f.stakingKeeper.addValidator(t)
+		priv, err := types.GenBLS12381PrivKey()
+		require.NoError(t, err)
+		pubKey, pop, err := common.SignValidatorBLS12381ProofOfPossession(priv, operator)
+		require.NoError(t, err)
+		signature, err := types.SignBLS12381(priv, types.ValidatorProofOfPossessionBytes(operator, pubKey))
+		require.NoError(t, err)
+
+		for _, tc := range []struct {
+			name    string
+			keyType types.KeyType
+			sig     []byte
+		}{
+			{name: "wrong key type", keyType: types.KeyType_KEY_TYPE_SECP256K1, sig: pop},
+			{name: "signature instead of a proof of possession", keyType: types.KeyType_KEY_TYPE_BLS12_381, sig: signature},
+		} {
+			_, err = ms.RegisterValidatorSecondaryKey(f.ctx, &types.MsgRegisterValidatorSecondaryKey{
+				ValidatorAddress: operator.String(),
+				KeyType:          tc.keyType,
+				PublicKey:        pubKey,
+				Signature:        tc.sig,
+			})
+			require.Error(t, err, tc.name)
+		}
+
+		_, err = ms.RegisterValidatorSecondaryKey(f.ctx, &types.MsgRegisterValidatorSecondaryKey{
+			ValidatorAddress: operator.String(),
+			KeyType:          types.KeyType_KEY_TYPE_BLS12_381,
+			PublicKey:        pubKey,
+			Signature:        pop,
+		})
+		require.NoError(t, err)
+
+		voteSig, err := types.SignBLS12381(priv, hash)
+		require.NoError(t, err)
+		require.NoError(t, f.keeper.VerifyValidatorSignature(f.ctx, consAddr, hash, voteSig))
+		consAddrs = append(consAddrs, consAddr)
+		signatures = append(signatures, voteSig)
+	}
+
+	aggregate, err := types.AggregateBLS12381Signatures(signatures)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.VerifyValidatorAggregateSignature(f.ctx, consAddrs, hash, aggregate))
+	require.ErrorIs(t, f.keeper.VerifyValidatorAggregateSignature(f.ctx, consAddrs[:1], hash, aggregate), sdkerrors.ErrUnauthorized)
+
+	// validators with secp256k1 keys cannot be aggregated
+	operator, consAddr := f.stakingKeeper.addValidator(t)
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey, signature, err := common.SignValidatorProofOfPossession(priv, operator)
+	require.NoError(t, err)
+	_, err = ms.RegisterValidatorSecondaryKey(f.ctx, &types.MsgRegisterValidatorSecondaryKey{
+		ValidatorAddress: operator.String(),
+		KeyType:          types.KeyType_KEY_TYPE_SECP256K1,
+		PublicKey:        pubKey,
+		Signature:        signature,
+	})
+	require.NoError(t, err)
+	err = f.keeper.VerifyValidatorAggregateSignature(f.ctx, append(consAddrs, consAddr), hash, aggregate)
+	require.ErrorIs(t, err, types.ErrInvalidKeyType)
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"example/x/secondarykeys/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RevokeSecondaryKey(ctx context.Context, msg *types.MsgRevokeSecondaryKey) (*types.MsgRevokeSecondaryKeyResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	keySet, err := k.GetSecondaryKeySet(ctx, sender)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(types.ErrSecondaryKeyNotFound, "account %s", msg.Sender)
+	} else if err != nil {
+		return nil, err
+	}
+
+	if err := k.AnteHandlerMap.Remove(ctx, sender); err != nil {
+		return nil, err
+	}
+	for _, member := range keySet.Members {
+		if err := k.Tombstones.Set(ctx, member.PublicKey); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.RevokedAccounts.Set(ctx, sender); err != nil {
+		return nil, err
+	}
+	if msg.Lockdown {
+		if err := k.LockedAccounts.Set(ctx, sender); err != nil {
+			return nil, err
+		}
+	}
+	// a recovery authorised by the revoked keys is void
+	if _, err := k.RemovePendingRecovery(ctx, sender); err != nil {
+		return nil, err
+	}
+	// so are its session keys
+	if err := k.revokeSessionKeys(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	// the event carries a public key attribute for every member of the set
+	attributes := []sdk.Attribute{sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender)}
+	for _, member := range keySet.Members {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(member.PublicKey)))
+	}
+	attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyLockdown, strconv.FormatBool(msg.Lockdown)))
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRevokeSecondaryKey, attributes...))
+
+	return &types.MsgRevokeSecondaryKeyResponse{}, nil
+}
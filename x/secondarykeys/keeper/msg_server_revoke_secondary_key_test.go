@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"example/common"
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestMsgRevokeSecondaryKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	senderStr, err := f.addressCodec.BytesToString(sender)
+	require.NoError(t, err)
+
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey, pop, err := common.SignProofOfPossession(common.NewSecp256k1PrivKey(priv), sender)
+	require.NoError(t, err)
+
+	_, err = ms.RevokeSecondaryKey(f.ctx, &types.MsgRevokeSecondaryKey{Sender: senderStr})
+	require.ErrorIs(t, err, types.ErrSecondaryKeyNotFound)
+
+	_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    senderStr,
+		KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+		PublicKey: pubKey,
+		Signature: pop,
+	})
+	require.NoError(t, err)
+
+	_, err = ms.RevokeSecondaryKey(f.ctx, &types.MsgRevokeSecondaryKey{Sender: senderStr, Lockdown: true})
+	require.NoError(t, err)
+
+	has, err := f.keeper.AnteHandlerMap.Has(f.ctx, sender)
+	require.NoError(t, err)
+	require.False(t, has)
+	tombstoned, err := f.keeper.Tombstones.Has(f.ctx, pubKey)
+	require.NoError(t, err)
+	require.True(t, tombstoned)
+	locked, err := f.keeper.LockedAccounts.Has(f.ctx, sender)
+	require.NoError(t, err)
+	require.True(t, locked)
+
+	// the tombstoned key cannot be registered again, not even for another account
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	otherStr, err := f.addressCodec.BytesToString(other)
+	require.NoError(t, err)
+	_, otherPop, err := common.SignProofOfPossession(common.NewSecp256k1PrivKey(priv), other)
+	require.NoError(t, err)
+	_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    otherStr,
+		KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+		PublicKey: pubKey,
+		Signature: otherPop,
+	})
+	require.ErrorIs(t, err, types.ErrSecondaryKeyTombstoned)
+
+	// a fresh key lifts the revocation and the lockdown
+	newPriv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newPubKey, newPop, err := common.SignProofOfPossession(common.NewSecp256k1PrivKey(newPriv), sender)
+	require.NoError(t, err)
+	_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    senderStr,
+		KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+		PublicKey: newPubKey,
+		Signature: newPop,
+	})
+	require.NoError(t, err)
+
+	revoked, err := f.keeper.RevokedAccounts.Has(f.ctx, sender)
+	require.NoError(t, err)
+	require.False(t, revoked)
+	locked, err = f.keeper.LockedAccounts.Has(f.ctx, sender)
+	require.NoError(t, err)
+	require.False(t, locked)
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"strconv"
+
+	"example/x/secondarykeys/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RotateSecondaryKey(ctx context.Context, msg *types.MsgRotateSecondaryKey) (*types.MsgRotateSecondaryKeyResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := types.ValidateAccountPublicKey(msg.KeyType, msg.NewPublicKey); err != nil {
+		return nil, err
+	}
+
+	keySet, err := k.getKeySetForChange(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	currentPubKey, ok := keySet.SingleKey()
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrInvalidRotation, "the key set has several keys, use MsgAddSecondaryKeySetMember and MsgRemoveSecondaryKeySetMember")
+	}
+	if bytes.Equal(currentPubKey.Key, msg.NewPublicKey) {
+		return nil, errorsmod.Wrap(types.ErrInvalidRotation, "new key equals the current key")
+	}
+	if err := k.checkNotTombstoned(ctx, msg.NewPublicKey); err != nil {
+		return nil, err
+	}
+	if err := k.checkNotShared(ctx, sender, msg.NewPublicKey); err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.GetRotationSequence(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := types.RotationBytes(sender, sequence, currentPubKey.Key, msg.NewPublicKey)
+	if err := k.VerifySecondarySignature(ctx, currentPubKey, hash, msg.CurrentKeySignature); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRotation, "invalid current key signature: %s", err)
+	}
+	if err := k.VerifySecondarySignature(ctx, types.NewSecondaryPubKey(msg.KeyType, msg.NewPublicKey), hash, msg.NewKeySignature); err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRotation, "invalid new key signature: %s", err)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.KeyHistory.Set(ctx, collections.Join(sdk.AccAddress(sender), sequence), types.SecondaryKeyHistoryEntry{
+		KeyType:        currentPubKey.KeyType,
+		PublicKey:      currentPubKey.Key,
+		ReplacedHeight: sdkCtx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+	// the new key takes over the weight and threshold of the current one
+	keySet.Members[0].KeyType = msg.KeyType
+	keySet.Members[0].PublicKey = msg.NewPublicKey
+	if err := k.SetSecondaryKeySet(ctx, sender, keySet); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRotateSecondaryKey,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyKeyType, msg.KeyType.String()),
+			sdk.NewAttribute(types.AttributeKeyPreviousPublicKey, hex.EncodeToString(currentPubKey.Key)),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(msg.NewPublicKey)),
+			sdk.NewAttribute(types.AttributeKeyRotationSequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return &types.MsgRotateSecondaryKeyResponse{}, nil
+}
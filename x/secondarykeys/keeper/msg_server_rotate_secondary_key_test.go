@@ -0,0 +1,196 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"example/common"
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestMsgRotateSecondaryKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	senderStr, err := f.addressCodec.BytesToString(sender)
+	require.NoError(t, err)
+
+	currentPriv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	currentPubKey, pop, err := common.SignProofOfPossession(common.NewSecp256k1PrivKey(currentPriv), sender)
+	require.NoError(t, err)
+
+	newPriv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	newPubKey := crypto.FromECDSAPub(&newPriv.PublicKey)
+
+	rotation := func(sequence uint64) *types.MsgRotateSecondaryKey {
+		hash := types.RotationBytes(sender, sequence, currentPubKey, newPubKey)
+		currentSig, err := crypto.Sign(hash, currentPriv)
+		require.NoError(t, err)
+		newSig, err := crypto.Sign(hash, newPriv)
+		require.NoError(t, err)
+		return &types.MsgRotateSecondaryKey{
+			Sender:              senderStr,
+			KeyType:             types.KeyType_KEY_TYPE_SECP256K1,
+			NewPublicKey:        newPubKey,
+			CurrentKeySignature: currentSig,
+			NewKeySignature:     newSig,
+		}
+	}
+
+	// nothing to rotate before a key is registered
+	_, err = ms.RotateSecondaryKey(f.ctx, rotation(0))
+	require.ErrorIs(t, err, types.ErrSecondaryKeyNotFound)
+
+	_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    senderStr,
+		KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+		PublicKey: currentPubKey,
+		Signature: pop,
+	})
+	require.NoError(t, err)
+
+	// registering again must not overwrite the key
+	_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    senderStr,
+		KeyType:   types.KeyType_KEY_TYPE_SECP256K1,
+		PublicKey: currentPubKey,
+		Signature: pop,
+	})
+	require.ErrorIs(t, err, types.ErrSecondaryKeyExists)
+
+	testCases := []struct {
+		name      string
+		input     func() *types.MsgRotateSecondaryKey
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name: "missing current key signature",
+			input: func() *types.MsgRotateSecondaryKey {
+				msg := rotation(0)
+				msg.CurrentKeySignature = nil
+				return msg
+			},
+			expErr:    true,
+			expErrMsg: "invalid current key signature",
+		},
+		{
+			name: "new key signature by the current key",
+			input: func() *types.MsgRotateSecondaryKey {
+				msg := rotation(0)
+				msg.NewKeySignature = msg.CurrentKeySignature
+				return msg
+			},
+			expErr:    true,
+			expErrMsg: "invalid new key signature",
+		},
+		{
+			name: "wrong rotation sequence",
+			input: func() *types.MsgRotateSecondaryKey {
+				return rotation(1)
+			},
+			expErr:    true,
+			expErrMsg: "invalid current key signature",
+		},
+		{
+			name: "all good",
+			input: func() *types.MsgRotateSecondaryKey {
+				return rotation(0)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RotateSecondaryKey(f.ctx, tc.input())
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	stored, err := f.keeper.GetSecondaryKeySet(f.ctx, sender)
+	require.NoError(t, err)
+	require.Equal(t, types.NewSingleKeySet(types.NewSecondaryPubKey(types.KeyType_KEY_TYPE_SECP256K1, newPubKey)), stored)
+
+	entry, err := f.keeper.KeyHistory.Get(f.ctx, collections.Join(sender, uint64(0)))
+	require.NoError(t, err)
+	require.Equal(t, currentPubKey, entry.PublicKey)
+
+	sequence, err := f.keeper.GetRotationSequence(f.ctx, sender)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), sequence)
+}
+
+func TestMsgRotateSecondaryKeyAcrossTypes(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	current, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_ED25519)
+	require.NoError(t, err)
+	pubKey, pop, err := common.SignProofOfPossession(current, sender)
+	require.NoError(t, err)
+	_, err = ms.RegisterSecondaryKey(f.ctx, &types.MsgRegisterSecondaryKey{
+		Sender:    sender.String(),
+		KeyType:   current.KeyType(),
+		PublicKey: pubKey,
+		Signature: pop,
+	})
+	require.NoError(t, err)
+
+	params := types.DefaultParams()
+	params.WebauthnRpIds = []string{"example.com"}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	genPrivKey := func(keyType types.KeyType) (common.SecondaryPrivKey, error) {
+		if keyType == types.KeyType_KEY_TYPE_WEBAUTHN {
+			return common.WebAuthnPrivKeyFromSeed(crypto.Keccak256([]byte("passkey")), "example.com", "https://example.com")
+		}
+		return common.GenSecondaryPrivKey(keyType)
+	}
+
+	// each rotation is signed by the current key with its own algorithm
+	for sequence, keyType := range []types.KeyType{
+		types.KeyType_KEY_TYPE_SCHNORR,
+		types.KeyType_KEY_TYPE_WEBAUTHN,
+		types.KeyType_KEY_TYPE_SECP256R1,
+	} {
+		next, err := genPrivKey(keyType)
+		require.NoError(t, err)
+		hash := types.RotationBytes(sender, uint64(sequence), current.PubKey(), next.PubKey())
+		currentSig, err := current.Sign(hash)
+		require.NoError(t, err)
+		newSig, err := next.Sign(hash)
+		require.NoError(t, err)
+		_, err = ms.RotateSecondaryKey(f.ctx, &types.MsgRotateSecondaryKey{
+			Sender:              sender.String(),
+			KeyType:             keyType,
+			NewPublicKey:        next.PubKey(),
+			CurrentKeySignature: currentSig,
+			NewKeySignature:     newSig,
+		})
+		require.NoError(t, err)
+
+		entry, err := f.keeper.KeyHistory.Get(f.ctx, collections.Join(sender, uint64(sequence)))
+		require.NoError(t, err)
+		require.Equal(t, current.KeyType(), entry.KeyType)
+		require.Equal(t, current.PubKey(), entry.PublicKey)
+		current = next
+	}
+
+	stored, err := f.keeper.GetSecondaryKeySet(f.ctx, sender)
+	require.NoError(t, err)
+	require.Equal(t, types.NewSingleKeySet(types.NewSecondaryPubKey(types.KeyType_KEY_TYPE_SECP256R1, current.PubKey())), stored)
+}
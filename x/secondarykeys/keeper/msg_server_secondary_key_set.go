@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"strconv"
+
+	"example/x/secondarykeys/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AddSecondaryKeySetMember(ctx context.Context, msg *types.MsgAddSecondaryKeySetMember) (*types.MsgAddSecondaryKeySetMemberResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if err := types.ValidateAccountPublicKey(msg.KeyType, msg.PublicKey); err != nil {
+		return nil, err
+	}
+
+	keySet, err := k.getKeySetForChange(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := keySet.Member(msg.PublicKey); ok {
+		return nil, errorsmod.Wrap(types.ErrInvalidKeySet, "key is already a member")
+	}
+	if err := k.checkNotTombstoned(ctx, msg.PublicKey); err != nil {
+		return nil, err
+	}
+	if err := k.checkNotShared(ctx, sender, msg.PublicKey); err != nil {
+		return nil, err
+	}
+
+	newKeySet := types.SecondaryKeySet{
+		Members:   append(append([]types.SecondaryKeySetMember{}, keySet.Members...), types.SecondaryKeySetMember{KeyType: msg.KeyType, PublicKey: msg.PublicKey, Weight: msg.Weight}),
+		Threshold: msg.Threshold,
+	}
+	if err := newKeySet.Validate(); err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.GetRotationSequence(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	hash := types.AddKeySetMemberBytes(sender, sequence, msg.KeyType, msg.PublicKey, msg.Weight, msg.Threshold)
+	if err := k.VerifyKeySetSignatures(ctx, keySet, hash, msg.Signatures); err != nil {
+		return nil, err
+	}
+	if err := k.VerifySecondarySignature(ctx, types.NewSecondaryPubKey(msg.KeyType, msg.PublicKey), hash, msg.NewKeySignature); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidProofOfPossession, err.Error())
+	}
+
+	if err := k.SetSecondaryKeySet(ctx, sender, newKeySet); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAddSecondaryKeySetMember,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyKeyType, msg.KeyType.String()),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(msg.PublicKey)),
+			sdk.NewAttribute(types.AttributeKeyWeight, strconv.FormatUint(uint64(msg.Weight), 10)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(msg.Threshold), 10)),
+		),
+	)
+
+	return &types.MsgAddSecondaryKeySetMemberResponse{}, nil
+}
+
+func (k msgServer) RemoveSecondaryKeySetMember(ctx context.Context, msg *types.MsgRemoveSecondaryKeySetMember) (*types.MsgRemoveSecondaryKeySetMemberResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	keySet, err := k.getKeySetForChange(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	removed, ok := keySet.Member(msg.PublicKey)
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrInvalidKeySet, "key is not a member")
+	}
+	if len(keySet.Members) == 1 {
+		return nil, errorsmod.Wrap(types.ErrInvalidKeySet, "cannot remove the last member, use MsgRevokeSecondaryKey")
+	}
+
+	newKeySet := types.SecondaryKeySet{Threshold: msg.Threshold}
+	for _, member := range keySet.Members {
+		if !bytes.Equal(member.PublicKey, msg.PublicKey) {
+			newKeySet.Members = append(newKeySet.Members, member)
+		}
+	}
+	if err := newKeySet.Validate(); err != nil {
+		return nil, err
+	}
+
+	sequence, err := k.GetRotationSequence(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	hash := types.RemoveKeySetMemberBytes(sender, sequence, msg.PublicKey, msg.Threshold)
+	if err := k.VerifyKeySetSignatures(ctx, keySet, hash, msg.Signatures); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.KeyHistory.Set(ctx, collections.Join(sdk.AccAddress(sender), sequence), types.SecondaryKeyHistoryEntry{
+		KeyType:        removed.KeyType,
+		PublicKey:      removed.PublicKey,
+		ReplacedHeight: sdkCtx.BlockHeight(),
+	}); err != nil {
+		return nil, err
+	}
+	if err := k.SetSecondaryKeySet(ctx, sender, newKeySet); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRemoveSecondaryKeySetMember,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(msg.PublicKey)),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(msg.Threshold), 10)),
+			sdk.NewAttribute(types.AttributeKeyRotationSequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return &types.MsgRemoveSecondaryKeySetMemberResponse{}, nil
+}
+
+// getKeySetForChange returns the key set of addr, which must exist to be
+// changed.
+func (k Keeper) getKeySetForChange(ctx context.Context, addr sdk.AccAddress) (types.SecondaryKeySet, error) {
+	keySet, err := k.GetSecondaryKeySet(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.SecondaryKeySet{}, errorsmod.Wrapf(types.ErrSecondaryKeyNotFound, "account %s", addr)
+	}
+	return keySet, err
+}
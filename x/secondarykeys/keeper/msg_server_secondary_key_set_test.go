@@ -0,0 +1,268 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"example/common"
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+// signKeySet returns the signatures of privs over hash.
+func signKeySet(t *testing.T, hash []byte, privs ...common.SecondaryPrivKey) []types.SecondaryKeySignature {
+	t.Helper()
+
+	sigs := make([]types.SecondaryKeySignature, len(privs))
+	for i, priv := range privs {
+		signature, err := priv.Sign(hash)
+		require.NoError(t, err)
+		sigs[i] = types.SecondaryKeySignature{PublicKey: priv.PubKey(), Signature: signature}
+	}
+	return sigs
+}
+
+// addMemberMsg returns the MsgAddSecondaryKeySetMember adding newPriv to the
+// key set of sender, signed by newPriv and signers.
+func addMemberMsg(t *testing.T, f *fixture, sender sdk.AccAddress, newPriv common.SecondaryPrivKey, weight, threshold uint32, signers ...common.SecondaryPrivKey) *types.MsgAddSecondaryKeySetMember {
+	t.Helper()
+
+	sequence, err := f.keeper.GetRotationSequence(f.ctx, sender)
+	require.NoError(t, err)
+	hash := types.AddKeySetMemberBytes(sender, sequence, newPriv.KeyType(), newPriv.PubKey(), weight, threshold)
+	newKeySig, err := newPriv.Sign(hash)
+	require.NoError(t, err)
+	return &types.MsgAddSecondaryKeySetMember{
+		Sender:          sender.String(),
+		KeyType:         newPriv.KeyType(),
+		PublicKey:       newPriv.PubKey(),
+		Weight:          weight,
+		Threshold:       threshold,
+		NewKeySignature: newKeySig,
+		Signatures:      signKeySet(t, hash, signers...),
+	}
+}
+
+// removeMemberMsg returns the MsgRemoveSecondaryKeySetMember removing pubKey
+// from the key set of sender, signed by signers.
+func removeMemberMsg(t *testing.T, f *fixture, sender sdk.AccAddress, pubKey []byte, threshold uint32, signers ...common.SecondaryPrivKey) *types.MsgRemoveSecondaryKeySetMember {
+	t.Helper()
+
+	sequence, err := f.keeper.GetRotationSequence(f.ctx, sender)
+	require.NoError(t, err)
+	hash := types.RemoveKeySetMemberBytes(sender, sequence, pubKey, threshold)
+	return &types.MsgRemoveSecondaryKeySetMember{
+		Sender:     sender.String(),
+		PublicKey:  pubKey,
+		Threshold:  threshold,
+		Signatures: signKeySet(t, hash, signers...),
+	}
+}
+
+func TestMsgAddSecondaryKeySetMember(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	privs := make([]common.SecondaryPrivKey, 4)
+	for i, keyType := range []types.KeyType{
+		types.KeyType_KEY_TYPE_ED25519,
+		types.KeyType_KEY_TYPE_SECP256K1,
+		types.KeyType_KEY_TYPE_SECP256R1,
+		types.KeyType_KEY_TYPE_SCHNORR,
+	} {
+		var err error
+		privs[i], err = common.GenSecondaryPrivKey(keyType)
+		require.NoError(t, err)
+	}
+	a, b, c, d := privs[0], privs[1], privs[2], privs[3]
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	registerKey(t, f, sender, a)
+
+	// 1 of 2, then 2 of 3 hardware devices, each change signed by the
+	// threshold of the set before it
+	_, err := ms.AddSecondaryKeySetMember(f.ctx, addMemberMsg(t, f, sender, b, 1, 1, a))
+	require.NoError(t, err)
+	_, err = ms.AddSecondaryKeySetMember(f.ctx, addMemberMsg(t, f, sender, c, 1, 2, b))
+	require.NoError(t, err)
+
+	keySet, err := f.keeper.GetSecondaryKeySet(f.ctx, sender)
+	require.NoError(t, err)
+	require.Equal(t, types.SecondaryKeySet{
+		Members: []types.SecondaryKeySetMember{
+			{KeyType: a.KeyType(), PublicKey: a.PubKey(), Weight: 1},
+			{KeyType: b.KeyType(), PublicKey: b.PubKey(), Weight: 1},
+			{KeyType: c.KeyType(), PublicKey: c.PubKey(), Weight: 1},
+		},
+		Threshold: 2,
+	}, keySet)
+	owners, err := f.keeper.GetSecondaryKeyOwners(f.ctx, c.PubKey())
+	require.NoError(t, err)
+	require.Equal(t, []sdk.AccAddress{sender}, owners)
+
+	otherChange := addMemberMsg(t, f, sender, d, 1, 2, a, b)
+	otherChange.Threshold = 1
+	invalidPoP := addMemberMsg(t, f, sender, d, 1, 2, a, b)
+	invalidPoP.NewKeySignature = invalidPoP.Signatures[0].Signature
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAddSecondaryKeySetMember
+		expErr error
+		errMsg string
+	}{
+		{
+			name:   "below threshold",
+			msg:    addMemberMsg(t, f, sender, d, 1, 2, a),
+			expErr: types.ErrInvalidSecondarySignature,
+			errMsg: "signature weight 1 below the threshold 2",
+		},
+		{
+			name:   "duplicate signer",
+			msg:    addMemberMsg(t, f, sender, d, 1, 2, a, a),
+			expErr: types.ErrInvalidSecondarySignature,
+			errMsg: "duplicate signature",
+		},
+		{
+			name:   "signer not a member",
+			msg:    addMemberMsg(t, f, sender, d, 1, 2, a, d),
+			expErr: types.ErrInvalidSecondarySignature,
+			errMsg: "not a member",
+		},
+		{
+			name:   "signature over another change",
+			msg:    otherChange,
+			expErr: types.ErrInvalidSecondarySignature,
+		},
+		{
+			name:   "invalid proof of possession",
+			msg:    invalidPoP,
+			expErr: types.ErrInvalidProofOfPossession,
+		},
+		{
+			name:   "threshold above total weight",
+			msg:    addMemberMsg(t, f, sender, d, 1, 5, a, b),
+			expErr: types.ErrInvalidKeySet,
+		},
+		{
+			name:   "no weight",
+			msg:    addMemberMsg(t, f, sender, d, 0, 2, a, b),
+			expErr: types.ErrInvalidKeySet,
+		},
+		{
+			name:   "existing member",
+			msg:    addMemberMsg(t, f, sender, c, 1, 2, a, b),
+			expErr: types.ErrInvalidKeySet,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.AddSecondaryKeySetMember(f.ctx, tc.msg)
+			require.ErrorIs(t, err, tc.expErr)
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+
+	// a key set of several keys cannot be rotated
+	_, err = ms.RotateSecondaryKey(f.ctx, &types.MsgRotateSecondaryKey{
+		Sender:       sender.String(),
+		KeyType:      d.KeyType(),
+		NewPublicKey: d.PubKey(),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRotation)
+
+	// accounts without a key set cannot add members
+	_, err = ms.AddSecondaryKeySetMember(f.ctx, addMemberMsg(t, f, sdk.MustAccAddressFromBech32(sample.AccAddress()), d, 1, 1, a))
+	require.ErrorIs(t, err, types.ErrSecondaryKeyNotFound)
+}
+
+func TestMsgRemoveSecondaryKeySetMember(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	privs := make([]common.SecondaryPrivKey, 3)
+	for i := range privs {
+		var err error
+		privs[i], err = common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_ED25519)
+		require.NoError(t, err)
+	}
+	a, b, c := privs[0], privs[1], privs[2]
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	registerKey(t, f, sender, a)
+	_, err := ms.AddSecondaryKeySetMember(f.ctx, addMemberMsg(t, f, sender, b, 1, 1, a))
+	require.NoError(t, err)
+	_, err = ms.AddSecondaryKeySetMember(f.ctx, addMemberMsg(t, f, sender, c, 1, 2, a))
+	require.NoError(t, err)
+
+	_, err = ms.RemoveSecondaryKeySetMember(f.ctx, removeMemberMsg(t, f, sender, a.PubKey(), 2, a))
+	require.ErrorIs(t, err, types.ErrInvalidSecondarySignature)
+
+	// the lost device is removed by the other two
+	remove := removeMemberMsg(t, f, sender, a.PubKey(), 2, b, c)
+	_, err = ms.RemoveSecondaryKeySetMember(f.ctx, remove)
+	require.NoError(t, err)
+
+	entry, err := f.keeper.KeyHistory.Get(f.ctx, collections.Join(sender, uint64(0)))
+	require.NoError(t, err)
+	require.Equal(t, a.PubKey(), entry.PublicKey)
+	owners, err := f.keeper.GetSecondaryKeyOwners(f.ctx, a.PubKey())
+	require.NoError(t, err)
+	require.Empty(t, owners)
+
+	// the removal cannot be replayed, nor can signatures over the previous
+	// rotation sequence be used once the key is back
+	_, err = ms.RemoveSecondaryKeySetMember(f.ctx, remove)
+	require.ErrorIs(t, err, types.ErrInvalidKeySet)
+	_, err = ms.AddSecondaryKeySetMember(f.ctx, addMemberMsg(t, f, sender, a, 1, 2, b, c))
+	require.NoError(t, err)
+	_, err = ms.RemoveSecondaryKeySetMember(f.ctx, remove)
+	require.ErrorIs(t, err, types.ErrInvalidSecondarySignature)
+	_, err = ms.RemoveSecondaryKeySetMember(f.ctx, removeMemberMsg(t, f, sender, a.PubKey(), 2, b, c))
+	require.NoError(t, err)
+
+	// the remaining weight must reach the new threshold
+	_, err = ms.RemoveSecondaryKeySetMember(f.ctx, removeMemberMsg(t, f, sender, b.PubKey(), 2, b, c))
+	require.ErrorIs(t, err, types.ErrInvalidKeySet)
+	_, err = ms.RemoveSecondaryKeySetMember(f.ctx, removeMemberMsg(t, f, sender, b.PubKey(), 1, b, c))
+	require.NoError(t, err)
+
+	keySet, err := f.keeper.GetSecondaryKeySet(f.ctx, sender)
+	require.NoError(t, err)
+	require.Equal(t, types.NewSingleKeySet(types.NewSecondaryPubKey(c.KeyType(), c.PubKey())), keySet)
+
+	// the last key is revoked rather than removed
+	_, err = ms.RemoveSecondaryKeySetMember(f.ctx, removeMemberMsg(t, f, sender, c.PubKey(), 1, c))
+	require.ErrorIs(t, err, types.ErrInvalidKeySet)
+	_, err = ms.RemoveSecondaryKeySetMember(f.ctx, removeMemberMsg(t, f, sender, a.PubKey(), 1, c))
+	require.ErrorIs(t, err, types.ErrInvalidKeySet)
+}
+
+func TestMsgRevokeSecondaryKeySet(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	a, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_ED25519)
+	require.NoError(t, err)
+	b, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_SECP256R1)
+	require.NoError(t, err)
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	registerKey(t, f, sender, a)
+	_, err = ms.AddSecondaryKeySetMember(f.ctx, addMemberMsg(t, f, sender, b, 1, 2, a))
+	require.NoError(t, err)
+
+	_, err = ms.RevokeSecondaryKey(f.ctx, &types.MsgRevokeSecondaryKey{Sender: sender.String()})
+	require.NoError(t, err)
+
+	// every key of the set is tombstoned
+	for _, priv := range []common.SecondaryPrivKey{a, b} {
+		tombstoned, err := f.keeper.Tombstones.Has(f.ctx, priv.PubKey())
+		require.NoError(t, err)
+		require.True(t, tombstoned)
+		owners, err := f.keeper.GetSecondaryKeyOwners(f.ctx, priv.PubKey())
+		require.NoError(t, err)
+		require.Empty(t, owners)
+	}
+}
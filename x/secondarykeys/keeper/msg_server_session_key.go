@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"strconv"
+	"strings"
+
+	"example/x/secondarykeys/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RegisterSessionKey(ctx context.Context, msg *types.MsgRegisterSessionKey) (*types.MsgRegisterSessionKeyResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	sessionKey := msg.SessionKey()
+	if err := sessionKey.Validate(); err != nil {
+		return nil, err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sessionKey.Expired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		return nil, errorsmod.Wrap(types.ErrInvalidSessionKey, "already expired")
+	}
+
+	keySet, err := k.getKeySetForChange(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := keySet.Member(msg.PublicKey); ok {
+		return nil, errorsmod.Wrap(types.ErrInvalidSessionKey, "key is a member of the key set")
+	}
+	if err := k.checkNotTombstoned(ctx, msg.PublicKey); err != nil {
+		return nil, err
+	}
+
+	// An expired session key can be registered again with a new expiry.
+	if err := k.pruneExpiredSessionKeys(ctx, sender); err != nil {
+		return nil, err
+	}
+	exists, err := k.IsSessionKey(ctx, sender, msg.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errorsmod.Wrap(types.ErrSessionKeyExists, "use MsgRevokeSessionKey to replace it")
+	}
+
+	sequence, err := k.GetRotationSequence(ctx, sender)
+	if err != nil {
+		return nil, err
+	}
+	hash := types.RegisterSessionKeyBytes(sender, sequence, sessionKey)
+	if err := k.VerifyKeySetSignatures(ctx, keySet, hash, msg.Signatures); err != nil {
+		return nil, err
+	}
+	if err := k.VerifySecondarySignature(ctx, sessionKey.PubKey(), hash, msg.SessionKeySignature); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidProofOfPossession, err.Error())
+	}
+
+	if err := k.SetSessionKey(ctx, sender, sessionKey); err != nil {
+		return nil, err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyKeyType, msg.KeyType.String()),
+		sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(msg.PublicKey)),
+		sdk.NewAttribute(types.AttributeKeyMsgTypeURLs, strings.Join(msg.MsgTypeUrls, ",")),
+		sdk.NewAttribute(types.AttributeKeySpendLimit, msg.SpendLimit.String()),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(msg.ExpiryHeight, 10)),
+	}
+	if msg.ExpiryTime != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyExpiryTime, msg.ExpiryTime.String()))
+	}
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeRegisterSessionKey, attributes...))
+
+	return &types.MsgRegisterSessionKeyResponse{}, nil
+}
+
+func (k msgServer) RevokeSessionKey(ctx context.Context, msg *types.MsgRevokeSessionKey) (*types.MsgRevokeSessionKeyResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	exists, err := k.IsSessionKey(ctx, sender, msg.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errorsmod.Wrapf(types.ErrSessionKeyNotFound, "%X", msg.PublicKey)
+	}
+
+	if err := k.RemoveSessionKey(ctx, sender, msg.PublicKey); err != nil {
+		return nil, err
+	}
+	if err := k.Tombstones.Set(ctx, msg.PublicKey); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRevokeSessionKey,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(msg.PublicKey)),
+		),
+	)
+
+	return &types.MsgRevokeSessionKeyResponse{}, nil
+}
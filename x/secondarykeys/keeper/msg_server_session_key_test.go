@@ -0,0 +1,222 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"example/common"
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+// registerSessionKeyMsg returns the MsgRegisterSessionKey registering
+// sessionPriv for sender, scoped to MsgSend with a spend limit of 100stake
+// until height 100, signed by sessionPriv and signers.
+func registerSessionKeyMsg(t *testing.T, ctx sdk.Context, f *fixture, sender sdk.AccAddress, sessionPriv common.SecondaryPrivKey, signers ...common.SecondaryPrivKey) *types.MsgRegisterSessionKey {
+	t.Helper()
+
+	msg := &types.MsgRegisterSessionKey{
+		Sender:       sender.String(),
+		KeyType:      sessionPriv.KeyType(),
+		PublicKey:    sessionPriv.PubKey(),
+		MsgTypeUrls:  []string{"/cosmos.bank.v1beta1.MsgSend"},
+		SpendLimit:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		ExpiryHeight: 100,
+	}
+	signSessionKeyMsg(t, ctx, f, msg, sessionPriv, signers...)
+	return msg
+}
+
+// signSessionKeyMsg sets the signatures of sessionPriv and signers on msg.
+func signSessionKeyMsg(t *testing.T, ctx sdk.Context, f *fixture, msg *types.MsgRegisterSessionKey, sessionPriv common.SecondaryPrivKey, signers ...common.SecondaryPrivKey) {
+	t.Helper()
+
+	sequence, err := f.keeper.GetRotationSequence(ctx, sdk.MustAccAddressFromBech32(msg.Sender))
+	require.NoError(t, err)
+	sessionSig, err := common.SignRegisterSessionKey(sessionPriv, msg, sequence)
+	require.NoError(t, err)
+	msg.SessionKeySignature = sessionSig.Signature
+	msg.Signatures = nil
+	for _, priv := range signers {
+		sig, err := common.SignRegisterSessionKey(priv, msg, sequence)
+		require.NoError(t, err)
+		msg.Signatures = append(msg.Signatures, sig)
+	}
+}
+
+func TestMsgRegisterSessionKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	blockTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10).WithBlockTime(blockTime)
+
+	privs := make([]common.SecondaryPrivKey, 4)
+	for i := range privs {
+		var err error
+		privs[i], err = common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_ED25519)
+		require.NoError(t, err)
+	}
+	a, b, session, other := privs[0], privs[1], privs[2], privs[3]
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	registerKey(t, f, sender, a)
+	_, err := ms.AddSecondaryKeySetMember(f.ctx, addMemberMsg(t, f, sender, b, 1, 2, a))
+	require.NoError(t, err)
+
+	_, err = ms.RegisterSessionKey(ctx, registerSessionKeyMsg(t, ctx, f, sender, session, a, b))
+	require.NoError(t, err)
+
+	sessionKeys, err := f.keeper.GetSessionKeys(ctx, sender)
+	require.NoError(t, err)
+	require.Equal(t, []types.SessionKey{{
+		Account:      sender.String(),
+		KeyType:      session.KeyType(),
+		PublicKey:    session.PubKey(),
+		MsgTypeUrls:  []string{"/cosmos.bank.v1beta1.MsgSend"},
+		SpendLimit:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		ExpiryHeight: 100,
+	}}, sessionKeys)
+	allowance, err := f.keeper.SessionKeyAllowances.Get(ctx, collections.Join(sender, session.PubKey()))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), allowance.Remaining)
+
+	otherScope := registerSessionKeyMsg(t, ctx, f, sender, other, a, b)
+	otherScope.MsgTypeUrls = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+	invalidPoP := registerSessionKeyMsg(t, ctx, f, sender, other, a, b)
+	invalidPoP.SessionKeySignature = invalidPoP.Signatures[0].Signature
+	moduleScope := registerSessionKeyMsg(t, ctx, f, sender, other, a, b)
+	moduleScope.MsgTypeUrls = []string{sdk.MsgTypeURL(&types.MsgRevokeSecondaryKey{})}
+	signSessionKeyMsg(t, ctx, f, moduleScope, other, a, b)
+	noExpiry := registerSessionKeyMsg(t, ctx, f, sender, other, a, b)
+	noExpiry.ExpiryHeight = 0
+	signSessionKeyMsg(t, ctx, f, noExpiry, other, a, b)
+	expired := registerSessionKeyMsg(t, ctx, f, sender, other, a, b)
+	expired.ExpiryTime = &blockTime
+	signSessionKeyMsg(t, ctx, f, expired, other, a, b)
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRegisterSessionKey
+		expErr error
+		errMsg string
+	}{
+		{
+			name:   "below threshold",
+			msg:    registerSessionKeyMsg(t, ctx, f, sender, other, a),
+			expErr: types.ErrInvalidSecondarySignature,
+			errMsg: "signature weight 1 below the threshold 2",
+		},
+		{
+			name:   "signature over another scope",
+			msg:    otherScope,
+			expErr: types.ErrInvalidSecondarySignature,
+		},
+		{
+			name:   "invalid proof of possession",
+			msg:    invalidPoP,
+			expErr: types.ErrInvalidProofOfPossession,
+		},
+		{
+			name:   "module msg in scope",
+			msg:    moduleScope,
+			expErr: types.ErrInvalidSessionKey,
+			errMsg: "cannot be signed by a session key",
+		},
+		{
+			name:   "no expiry",
+			msg:    noExpiry,
+			expErr: types.ErrInvalidSessionKey,
+			errMsg: "neither an expiry height nor an expiry time",
+		},
+		{
+			name:   "already expired",
+			msg:    expired,
+			expErr: types.ErrInvalidSessionKey,
+			errMsg: "already expired",
+		},
+		{
+			name:   "member of the key set",
+			msg:    registerSessionKeyMsg(t, ctx, f, sender, b, a, b),
+			expErr: types.ErrInvalidSessionKey,
+			errMsg: "member of the key set",
+		},
+		{
+			name:   "existing session key",
+			msg:    registerSessionKeyMsg(t, ctx, f, sender, session, a, b),
+			expErr: types.ErrSessionKeyExists,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.RegisterSessionKey(ctx, tc.msg)
+			require.ErrorIs(t, err, tc.expErr)
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+
+	// once expired, the session key is pruned and can be registered again
+	later := ctx.WithBlockHeight(100)
+	renewed := registerSessionKeyMsg(t, later, f, sender, session, a, b)
+	renewed.ExpiryHeight = 200
+	signSessionKeyMsg(t, later, f, renewed, session, a, b)
+	_, err = ms.RegisterSessionKey(later, renewed)
+	require.NoError(t, err)
+	sessionKeys, err = f.keeper.GetSessionKeys(later, sender)
+	require.NoError(t, err)
+	require.Len(t, sessionKeys, 1)
+	require.Equal(t, int64(200), sessionKeys[0].ExpiryHeight)
+
+	// accounts without a key set cannot register session keys
+	_, err = ms.RegisterSessionKey(ctx, registerSessionKeyMsg(t, ctx, f, sdk.MustAccAddressFromBech32(sample.AccAddress()), other, a))
+	require.ErrorIs(t, err, types.ErrSecondaryKeyNotFound)
+}
+
+func TestMsgRevokeSessionKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	privs := make([]common.SecondaryPrivKey, 3)
+	for i := range privs {
+		var err error
+		privs[i], err = common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_SECP256R1)
+		require.NoError(t, err)
+	}
+	a, session, other := privs[0], privs[1], privs[2]
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	registerKey(t, f, sender, a)
+	_, err := ms.RegisterSessionKey(ctx, registerSessionKeyMsg(t, ctx, f, sender, session, a))
+	require.NoError(t, err)
+	_, err = ms.RegisterSessionKey(ctx, registerSessionKeyMsg(t, ctx, f, sender, other, a))
+	require.NoError(t, err)
+
+	_, err = ms.RevokeSessionKey(ctx, &types.MsgRevokeSessionKey{Sender: sample.AccAddress(), PublicKey: session.PubKey()})
+	require.ErrorIs(t, err, types.ErrSessionKeyNotFound)
+
+	_, err = ms.RevokeSessionKey(ctx, &types.MsgRevokeSessionKey{Sender: sender.String(), PublicKey: session.PubKey()})
+	require.NoError(t, err)
+	registered, err := f.keeper.IsSessionKey(ctx, sender, session.PubKey())
+	require.NoError(t, err)
+	require.False(t, registered)
+	hasAllowance, err := f.keeper.SessionKeyAllowances.Has(ctx, collections.Join(sender, session.PubKey()))
+	require.NoError(t, err)
+	require.False(t, hasAllowance)
+
+	// a revoked session key can never be registered again
+	_, err = ms.RegisterSessionKey(ctx, registerSessionKeyMsg(t, ctx, f, sender, session, a))
+	require.ErrorIs(t, err, types.ErrSecondaryKeyTombstoned)
+
+	// revoking the key set revokes its session keys
+	_, err = ms.RevokeSecondaryKey(ctx, &types.MsgRevokeSecondaryKey{Sender: sender.String()})
+	require.NoError(t, err)
+	sessionKeys, err := f.keeper.GetSessionKeys(ctx, sender)
+	require.NoError(t, err)
+	require.Empty(t, sessionKeys)
+	tombstoned, err := f.keeper.Tombstones.Has(ctx, other.PubKey())
+	require.NoError(t, err)
+	require.True(t, tombstoned)
+}
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"example/x/secondarykeys/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetSecondarySignatureRequired(ctx context.Context, msg *types.MsgSetSecondarySignatureRequired) (*types.MsgSetSecondarySignatureRequiredResponse, error) {
+	sender, err := k.addressCodec.StringToBytes(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	if msg.Required {
+		// Requiring a signature without a key to produce it would be a no-op
+		// that silently turns on once a key is registered.
+		registered, err := k.AnteHandlerMap.Has(ctx, sender)
+		if err != nil {
+			return nil, err
+		}
+		if !registered {
+			return nil, errorsmod.Wrapf(types.ErrSecondaryKeyNotFound, "account %s", msg.Sender)
+		}
+		if err := k.RequiredAccounts.Set(ctx, sender); err != nil {
+			return nil, err
+		}
+	} else if err := k.RequiredAccounts.Remove(ctx, sender); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetSecondarySignatureRequired,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyRequired, strconv.FormatBool(msg.Required)),
+		),
+	)
+
+	return &types.MsgSetSecondarySignatureRequiredResponse{}, nil
+}
@@ -0,0 +1,120 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"example/common"
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestMsgSetSecondarySignatureRequired(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	senderStr, err := f.addressCodec.BytesToString(sender)
+	require.NoError(t, err)
+
+	// an account without a secondary key cannot require one
+	_, err = ms.SetSecondarySignatureRequired(f.ctx, &types.MsgSetSecondarySignatureRequired{Sender: senderStr, Required: true})
+	require.ErrorIs(t, err, types.ErrSecondaryKeyNotFound)
+
+	priv, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_SECP256K1)
+	require.NoError(t, err)
+	registerKey(t, f, sender, priv)
+	send := banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+
+	required, err := f.keeper.SecondarySignatureRequired(f.ctx, sender, []sdk.Msg{send})
+	require.NoError(t, err)
+	require.False(t, required)
+
+	_, err = ms.SetSecondarySignatureRequired(f.ctx, &types.MsgSetSecondarySignatureRequired{Sender: senderStr, Required: true})
+	require.NoError(t, err)
+
+	required, err = f.keeper.SecondarySignatureRequired(f.ctx, sender, []sdk.Msg{send})
+	require.NoError(t, err)
+	require.True(t, required)
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).SecondaryKey(f.ctx, &types.QuerySecondaryKeyRequest{Address: senderStr})
+	require.NoError(t, err)
+	require.True(t, res.SecondarySignatureRequired)
+
+	_, err = ms.SetSecondarySignatureRequired(f.ctx, &types.MsgSetSecondarySignatureRequired{Sender: senderStr})
+	require.NoError(t, err)
+
+	required, err = f.keeper.SecondarySignatureRequired(f.ctx, sender, []sdk.Msg{send})
+	require.NoError(t, err)
+	require.False(t, required)
+}
+
+func TestSecondarySignatureRequiredByMsgType(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.RequiredMsgTypeUrls = []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	unregistered := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	priv, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_SECP256K1)
+	require.NoError(t, err)
+	registerKey(t, f, sender, priv)
+
+	send := banktypes.NewMsgSend(sender, sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	multiSend := banktypes.NewMsgMultiSend(
+		banktypes.NewInput(sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))),
+		[]banktypes.Output{banktypes.NewOutput(sender, sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))},
+	)
+	exec := authz.NewMsgExec(sender, []sdk.Msg{send})
+
+	testCases := []struct {
+		name     string
+		addr     sdk.AccAddress
+		msgs     []sdk.Msg
+		required bool
+	}{
+		{
+			name:     "listed msg",
+			addr:     sender,
+			msgs:     []sdk.Msg{send},
+			required: true,
+		},
+		{
+			name:     "listed msg among others",
+			addr:     sender,
+			msgs:     []sdk.Msg{multiSend, send},
+			required: true,
+		},
+		{
+			name:     "listed msg nested in authz exec",
+			addr:     sender,
+			msgs:     []sdk.Msg{&exec},
+			required: true,
+		},
+		{
+			name: "unlisted msg",
+			addr: sender,
+			msgs: []sdk.Msg{multiSend},
+		},
+		{
+			name: "account without secondary key",
+			addr: unregistered,
+			msgs: []sdk.Msg{send},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			required, err := f.keeper.SecondarySignatureRequired(f.ctx, tc.addr, tc.msgs)
+			require.NoError(t, err)
+			require.Equal(t, tc.required, required)
+		})
+	}
+}
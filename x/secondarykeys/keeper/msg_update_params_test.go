@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -43,6 +44,94 @@ func TestMsgUpdateParams(t *testing.T) {
 			},
 			expErr: false,
 		},
+		{
+			name: "required msg type urls",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{RequiredMsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}},
+			},
+			expErr: false,
+		},
+		{
+			name: "invalid msg type url",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{RequiredMsgTypeUrls: []string{"cosmos.bank.v1beta1.MsgSend"}},
+			},
+			expErr:    true,
+			expErrMsg: "invalid msg type url",
+		},
+		{
+			name: "duplicate msg type url",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{RequiredMsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgSend"}},
+			},
+			expErr:    true,
+			expErrMsg: "duplicate msg type url",
+		},
+		{
+			name: "webauthn relying party IDs",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{WebauthnRpIds: []string{"example.com", "wallet.example.com", "localhost"}},
+			},
+			expErr: false,
+		},
+		{
+			name: "webauthn relying party ID with scheme",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{WebauthnRpIds: []string{"https://example.com"}},
+			},
+			expErr:    true,
+			expErrMsg: "invalid webauthn relying party ID",
+		},
+		{
+			name: "webauthn relying party ID with port",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{WebauthnRpIds: []string{"example.com:443"}},
+			},
+			expErr:    true,
+			expErrMsg: "invalid webauthn relying party ID",
+		},
+		{
+			name: "upper case webauthn relying party ID",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{WebauthnRpIds: []string{"Example.com"}},
+			},
+			expErr:    true,
+			expErrMsg: "invalid webauthn relying party ID",
+		},
+		{
+			name: "duplicate webauthn relying party ID",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{WebauthnRpIds: []string{"example.com", "example.com"}},
+			},
+			expErr:    true,
+			expErrMsg: "duplicate webauthn relying party ID",
+		},
+		{
+			name: "negative recovery delay",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{RecoveryDelay: -time.Hour},
+			},
+			expErr:    true,
+			expErrMsg: "recovery delay must not be negative",
+		},
+		{
+			name: "negative attestation retention window",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{AttestationRetentionWindow: -1},
+			},
+			expErr:    true,
+			expErrMsg: "attestation retention window must not be negative",
+		},
 		{
 			name: "all good",
 			input: &types.MsgUpdateParams{
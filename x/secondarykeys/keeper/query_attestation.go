@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example/x/secondarykeys/types"
+)
+
+func (q queryServer) Attestation(ctx context.Context, req *types.QueryAttestationRequest) (*types.QueryAttestationResponse, error) {
+	if req == nil || req.Height <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	attestation, err := q.k.Attestations.Get(ctx, req.Height)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "attestation not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryAttestationResponse{Attestation: attestation}, nil
+}
@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestAttestationQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	attestation := types.Attestation{
+		Height:    5,
+		BlockHash: []byte("hash"),
+		Signatures: []types.ValidatorSignature{{
+			ConsensusAddress: sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			VotingPower:      10,
+			Signature:        []byte("signature"),
+		}},
+		SignedVotingPower: 10,
+		TotalVotingPower:  12,
+	}
+	require.NoError(t, f.keeper.Attestations.Set(f.ctx, attestation.Height, attestation))
+
+	response, err := qs.Attestation(f.ctx, &types.QueryAttestationRequest{Height: 5})
+	require.NoError(t, err)
+	require.Equal(t, attestation, response.Attestation)
+
+	_, err = qs.Attestation(f.ctx, &types.QueryAttestationRequest{Height: 6})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.Attestation(f.ctx, &types.QueryAttestationRequest{})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example/x/secondarykeys/types"
+)
+
+func (q queryServer) ExtensionSigningInfo(ctx context.Context, req *types.QueryExtensionSigningInfoRequest) (*types.QueryExtensionSigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsensusAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid consensus address")
+	}
+
+	info, err := q.k.ExtensionSigningInfos.Get(ctx, consAddr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "extension signing info not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryExtensionSigningInfoResponse{SigningInfo: info}, nil
+}
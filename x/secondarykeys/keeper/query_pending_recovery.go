@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example/x/secondarykeys/types"
+)
+
+func (q queryServer) PendingRecovery(ctx context.Context, req *types.QueryPendingRecoveryRequest) (*types.QueryPendingRecoveryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	account, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	recovery, err := q.k.PendingRecoveries.Get(ctx, account)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "pending recovery not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryPendingRecoveryResponse{Recovery: recovery}, nil
+}
@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example/x/secondarykeys/types"
+)
+
+func (q queryServer) SecondaryKey(ctx context.Context, req *types.QuerySecondaryKeyRequest) (*types.QuerySecondaryKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	var res types.QuerySecondaryKeyResponse
+	keySet, err := q.k.AnteHandlerMap.Get(ctx, addr)
+	switch {
+	case err == nil:
+		accountKey := types.NewAccountKey(req.Address, keySet)
+		res.SecondaryKey = &accountKey
+	case !errors.Is(err, collections.ErrNotFound):
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if res.Revoked, err = q.k.RevokedAccounts.Has(ctx, addr); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if res.Locked, err = q.k.LockedAccounts.Has(ctx, addr); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if res.SecondarySignatureRequired, err = q.k.RequiredAccounts.Has(ctx, addr); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if res.SecondaryKey == nil && !res.Revoked {
+		return nil, status.Error(codes.NotFound, "secondary key not found")
+	}
+	return &res, nil
+}
+
+func (q queryServer) AllSecondaryKeys(ctx context.Context, req *types.QueryAllSecondaryKeysRequest) (*types.QueryAllSecondaryKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	keys, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.AnteHandlerMap,
+		req.Pagination,
+		func(addr sdk.AccAddress, keySet types.SecondaryKeySet) (types.AccountKey, error) {
+			addrStr, err := q.k.addressCodec.BytesToString(addr)
+			if err != nil {
+				return types.AccountKey{}, err
+			}
+			return types.NewAccountKey(addrStr, keySet), nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllSecondaryKeysResponse{SecondaryKeys: keys, Pagination: pageRes}, nil
+}
+
+func (q queryServer) SecondaryKeyOwner(ctx context.Context, req *types.QuerySecondaryKeyOwnerRequest) (*types.QuerySecondaryKeyOwnerResponse, error) {
+	if req == nil || len(req.PublicKey) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owners, err := q.k.GetSecondaryKeyOwners(ctx, req.PublicKey)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if len(owners) == 0 {
+		return nil, status.Error(codes.NotFound, "secondary key not registered")
+	}
+
+	res := &types.QuerySecondaryKeyOwnerResponse{Owners: make([]string, len(owners))}
+	for i, owner := range owners {
+		if res.Owners[i], err = q.k.addressCodec.BytesToString(owner); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	return res, nil
+}
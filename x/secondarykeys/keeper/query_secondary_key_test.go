@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestSecondaryKeyQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	// the key type of each key is reported
+	keyTypes := []types.KeyType{
+		types.KeyType_KEY_TYPE_SECP256K1,
+		types.KeyType_KEY_TYPE_ED25519,
+		types.KeyType_KEY_TYPE_SECP256R1,
+		types.KeyType_KEY_TYPE_SCHNORR,
+	}
+	keys := make([]types.AccountKey, 5)
+	for i := range keys {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		addrStr, err := f.addressCodec.BytesToString(addr)
+		require.NoError(t, err)
+		keyType := keyTypes[i%len(keyTypes)]
+		keys[i] = types.AccountKey{Address: addrStr, PublicKey: []byte{byte(i)}, KeyType: keyType}
+		require.NoError(t, f.keeper.SetSecondaryKeySet(f.ctx, addr, types.NewSingleKeySet(types.NewSecondaryPubKey(keyType, keys[i].PublicKey))))
+	}
+
+	response, err := qs.SecondaryKey(f.ctx, &types.QuerySecondaryKeyRequest{Address: keys[0].Address})
+	require.NoError(t, err)
+	require.Equal(t, &keys[0], response.SecondaryKey)
+	require.False(t, response.Revoked)
+
+	_, err = qs.SecondaryKey(f.ctx, &types.QuerySecondaryKeyRequest{Address: sample.AccAddress()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.SecondaryKey(f.ctx, &types.QuerySecondaryKeyRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	// revoked accounts are reported even though they hold no key
+	revoked := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	require.NoError(t, f.keeper.RevokedAccounts.Set(f.ctx, revoked))
+	require.NoError(t, f.keeper.LockedAccounts.Set(f.ctx, revoked))
+	response, err = qs.SecondaryKey(f.ctx, &types.QuerySecondaryKeyRequest{Address: revoked.String()})
+	require.NoError(t, err)
+	require.Nil(t, response.SecondaryKey)
+	require.True(t, response.Revoked)
+	require.True(t, response.Locked)
+
+	var all []types.AccountKey
+	var nextKey []byte
+	for {
+		res, err := qs.AllSecondaryKeys(f.ctx, &types.QueryAllSecondaryKeysRequest{
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(res.SecondaryKeys), 2)
+		all = append(all, res.SecondaryKeys...)
+		if nextKey = res.Pagination.NextKey; nextKey == nil {
+			break
+		}
+	}
+	require.ElementsMatch(t, keys, all)
+}
+
+func TestSecondaryKeyOwnerQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	pubKey := []byte("shared")
+	owners := make([]string, 2)
+	for i := range owners {
+		addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+		require.NoError(t, f.keeper.SetSecondaryKeySet(f.ctx, addr, types.NewSingleKeySet(types.NewSecondaryPubKey(types.KeyType_KEY_TYPE_SECP256K1, pubKey))))
+		owners[i] = addr.String()
+	}
+	require.NoError(t, f.keeper.SetSecondaryKeySet(f.ctx, sdk.MustAccAddressFromBech32(sample.AccAddress()), types.NewSingleKeySet(types.NewSecondaryPubKey(types.KeyType_KEY_TYPE_SECP256K1, []byte("other")))))
+
+	response, err := qs.SecondaryKeyOwner(f.ctx, &types.QuerySecondaryKeyOwnerRequest{PublicKey: pubKey})
+	require.NoError(t, err)
+	require.ElementsMatch(t, owners, response.Owners)
+
+	// the index follows the map when a key is removed
+	require.NoError(t, f.keeper.AnteHandlerMap.Remove(f.ctx, sdk.MustAccAddressFromBech32(owners[0])))
+	response, err = qs.SecondaryKeyOwner(f.ctx, &types.QuerySecondaryKeyOwnerRequest{PublicKey: pubKey})
+	require.NoError(t, err)
+	require.Equal(t, owners[1:], response.Owners)
+
+	_, err = qs.SecondaryKeyOwner(f.ctx, &types.QuerySecondaryKeyOwnerRequest{PublicKey: []byte("unknown")})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example/x/secondarykeys/types"
+)
+
+func (q queryServer) SessionKeys(ctx context.Context, req *types.QuerySessionKeysRequest) (*types.QuerySessionKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	account, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	sessionKeys, err := q.k.GetSessionKeys(ctx, account)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	res := &types.QuerySessionKeysResponse{SessionKeys: sessionKeys}
+	for _, sessionKey := range sessionKeys {
+		allowance, err := q.k.SessionKeyAllowances.Get(ctx, collections.Join(sdk.AccAddress(account), sessionKey.PublicKey))
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		res.Allowances = append(res.Allowances, allowance)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example/x/secondarykeys/types"
+)
+
+func (q queryServer) ValidatorSecondaryKey(ctx context.Context, req *types.QueryValidatorSecondaryKeyRequest) (*types.QueryValidatorSecondaryKeyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := sdk.ConsAddressFromBech32(req.ConsensusAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid consensus address")
+	}
+
+	pubKey, err := q.k.VoteExtensionMap.Get(ctx, sdk.AccAddress(consAddr))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "validator secondary key not found")
+	} else if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryValidatorSecondaryKeyResponse{
+		SecondaryKey: types.ValidatorKey{ConsensusAddress: req.ConsensusAddress, PublicKey: pubKey},
+	}, nil
+}
+
+func (q queryServer) AllValidatorSecondaryKeys(ctx context.Context, req *types.QueryAllValidatorSecondaryKeysRequest) (*types.QueryAllValidatorSecondaryKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	keys, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.VoteExtensionMap,
+		req.Pagination,
+		func(addr sdk.AccAddress, pubKey []byte) (types.ValidatorKey, error) {
+			return types.ValidatorKey{ConsensusAddress: sdk.ConsAddress(addr).String(), PublicKey: pubKey}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllValidatorSecondaryKeysResponse{SecondaryKeys: keys, Pagination: pageRes}, nil
+}
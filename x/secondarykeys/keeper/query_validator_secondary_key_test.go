@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+func TestValidatorSecondaryKeyQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	keys := make([]types.ValidatorKey, 3)
+	for i := range keys {
+		consAddr := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+		keys[i] = types.ValidatorKey{ConsensusAddress: consAddr.String(), PublicKey: []byte{byte(i)}}
+		require.NoError(t, f.keeper.SetSecondaryPubKeyVoteExtension(f.ctx, sdk.AccAddress(consAddr), keys[i].PublicKey))
+	}
+
+	response, err := qs.ValidatorSecondaryKey(f.ctx, &types.QueryValidatorSecondaryKeyRequest{ConsensusAddress: keys[1].ConsensusAddress})
+	require.NoError(t, err)
+	require.Equal(t, keys[1], response.SecondaryKey)
+
+	unknown := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address())
+	_, err = qs.ValidatorSecondaryKey(f.ctx, &types.QueryValidatorSecondaryKeyRequest{ConsensusAddress: unknown.String()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.ValidatorSecondaryKey(f.ctx, &types.QueryValidatorSecondaryKeyRequest{ConsensusAddress: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err := qs.AllValidatorSecondaryKeys(f.ctx, &types.QueryAllValidatorSecondaryKeysRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(keys)), res.Pagination.Total)
+	require.ElementsMatch(t, keys, res.SecondaryKeys)
+}
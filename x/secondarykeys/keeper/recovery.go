@@ -0,0 +1,108 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"example/x/secondarykeys/types"
+)
+
+// SetPendingRecovery stores recovery and queues it for its execute time.
+func (k Keeper) SetPendingRecovery(ctx context.Context, account sdk.AccAddress, recovery types.PendingRecovery) error {
+	if err := k.PendingRecoveries.Set(ctx, account, recovery); err != nil {
+		return err
+	}
+	return k.RecoveryQueue.Set(ctx, collections.Join(recovery.ExecuteTime, account))
+}
+
+// RemovePendingRecovery removes the pending recovery of account, if any, and
+// reports whether there was one.
+func (k Keeper) RemovePendingRecovery(ctx context.Context, account sdk.AccAddress) (bool, error) {
+	recovery, err := k.PendingRecoveries.Get(ctx, account)
+	if errors.Is(err, collections.ErrNotFound) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	if err := k.PendingRecoveries.Remove(ctx, account); err != nil {
+		return false, err
+	}
+	return true, k.RecoveryQueue.Remove(ctx, collections.Join(recovery.ExecuteTime, account))
+}
+
+// ExecuteRecoveries replaces the primary public key of the accounts whose
+// recovery timelock expired by the block time, and removes their recoveries.
+func (k Keeper) ExecuteRecoveries(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	iter, err := k.RecoveryQueue.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, sdk.AccAddress](sdkCtx.BlockTime()))
+	if err != nil {
+		return err
+	}
+	accounts, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range accounts {
+		account := key.K2()
+		recovery, err := k.PendingRecoveries.Get(ctx, account)
+		if err != nil {
+			return err
+		}
+		if _, err := k.RemovePendingRecovery(ctx, account); err != nil {
+			return err
+		}
+
+		// A recovery that cannot be applied is dropped rather than halting
+		// the chain; the account keeps its primary key.
+		pubKey, err := k.recoveryPubKey(recovery)
+		if err == nil {
+			err = k.setPrimaryPubKey(ctx, account, pubKey)
+		}
+		if err != nil {
+			sdkCtx.Logger().Error("failed to execute account recovery", "account", recovery.Account, "error", err)
+			continue
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeExecuteRecovery,
+				sdk.NewAttribute(types.AttributeKeyAccount, recovery.Account),
+				sdk.NewAttribute(types.AttributeKeyNewPublicKey, hex.EncodeToString(pubKey.Bytes())),
+			),
+		)
+		sdkCtx.Logger().Info("recovered account", "account", recovery.Account)
+	}
+	return nil
+}
+
+// recoveryPubKey returns the new primary public key of recovery.
+func (k Keeper) recoveryPubKey(recovery types.PendingRecovery) (cryptotypes.PubKey, error) {
+	var pubKey cryptotypes.PubKey
+	if err := k.cdc.UnpackAny(recovery.NewPublicKey, &pubKey); err != nil {
+		return nil, err
+	}
+	return pubKey, nil
+}
+
+// setPrimaryPubKey replaces the public key of the x/auth account of account.
+func (k Keeper) setPrimaryPubKey(ctx context.Context, account sdk.AccAddress, pubKey cryptotypes.PubKey) error {
+	acc := k.authKeeper.GetAccount(ctx, account)
+	if acc == nil {
+		return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", account)
+	}
+	if err := acc.SetPubKey(pubKey); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidPubKey, err.Error())
+	}
+	k.authKeeper.SetAccount(ctx, acc)
+	return nil
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"example/x/secondarykeys/types"
+)
+
+// SetSessionKey stores sessionKey for addr with its whole spend limit as the
+// remaining allowance.
+func (k Keeper) SetSessionKey(ctx context.Context, addr sdk.AccAddress, sessionKey types.SessionKey) error {
+	key := collections.Join(addr, sessionKey.PublicKey)
+	if err := k.SessionKeys.Set(ctx, key, sessionKey); err != nil {
+		return err
+	}
+	return k.SessionKeyAllowances.Set(ctx, key, types.SessionKeyAllowance{
+		Account:   sessionKey.Account,
+		PublicKey: sessionKey.PublicKey,
+		Remaining: sessionKey.SpendLimit,
+	})
+}
+
+// RemoveSessionKey removes the session key pubKey of addr and its allowance.
+func (k Keeper) RemoveSessionKey(ctx context.Context, addr sdk.AccAddress, pubKey []byte) error {
+	key := collections.Join(addr, pubKey)
+	if err := k.SessionKeys.Remove(ctx, key); err != nil {
+		return err
+	}
+	return k.SessionKeyAllowances.Remove(ctx, key)
+}
+
+// IsSessionKey reports whether pubKey is a session key of addr.
+func (k Keeper) IsSessionKey(ctx context.Context, addr sdk.AccAddress, pubKey []byte) (bool, error) {
+	return k.SessionKeys.Has(ctx, collections.Join(addr, pubKey))
+}
+
+// GetSessionKeys returns the session keys of addr, expired ones included.
+func (k Keeper) GetSessionKeys(ctx context.Context, addr sdk.AccAddress) ([]types.SessionKey, error) {
+	iter, err := k.SessionKeys.Iterate(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, []byte](addr))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// UseSessionKey verifies sig, the signature of a session key of addr over the
+// secondary sign bytes hash of tx, and charges the coins tx spends from addr
+// to the remaining allowance of the session key. The session key must not
+// have expired and every msg of tx must be in its scope.
+func (k Keeper) UseSessionKey(ctx context.Context, addr sdk.AccAddress, tx sdk.Tx, hash []byte, sig types.SecondaryKeySignature) error {
+	key := collections.Join(addr, sig.PublicKey)
+	sessionKey, err := k.SessionKeys.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(types.ErrSessionKeyNotFound, "%X", sig.PublicKey)
+	} else if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sessionKey.Expired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		return errorsmod.Wrapf(types.ErrSessionKeyExpired, "%X", sig.PublicKey)
+	}
+	if !sessionKey.InScope(tx.GetMsgs()) {
+		return errorsmod.Wrapf(types.ErrSessionKeyScope, "session key %X can only sign %s", sig.PublicKey, strings.Join(sessionKey.MsgTypeUrls, ", "))
+	}
+	if err := k.VerifySecondarySignature(ctx, sessionKey.PubKey(), hash, sig.Signature); err != nil {
+		return err
+	}
+
+	allowance, err := k.SessionKeyAllowances.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+	spent := types.TxSpend(tx, addr, k.addressCodec)
+	remaining, exceeded := allowance.Remaining.SafeSub(spent...)
+	if exceeded {
+		return errorsmod.Wrapf(types.ErrSessionKeySpendLimit, "spending %s with %s remaining", spent, allowance.Remaining)
+	}
+	allowance.Remaining = remaining
+	if err := k.SessionKeyAllowances.Set(ctx, key, allowance); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseSessionKey,
+			sdk.NewAttribute(types.AttributeKeyAccount, sessionKey.Account),
+			sdk.NewAttribute(types.AttributeKeyPublicKey, hex.EncodeToString(sessionKey.PublicKey)),
+			sdk.NewAttribute(types.AttributeKeySpent, spent.String()),
+			sdk.NewAttribute(types.AttributeKeyRemaining, remaining.String()),
+		),
+	)
+	return nil
+}
+
+// pruneExpiredSessionKeys removes the session keys of addr that expired.
+func (k Keeper) pruneExpiredSessionKeys(ctx context.Context, addr sdk.AccAddress) error {
+	sessionKeys, err := k.GetSessionKeys(ctx, addr)
+	if err != nil {
+		return err
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, sessionKey := range sessionKeys {
+		if !sessionKey.Expired(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+			continue
+		}
+		if err := k.RemoveSessionKey(ctx, addr, sessionKey.PublicKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// revokeSessionKeys removes every session key of addr and tombstones it.
+func (k Keeper) revokeSessionKeys(ctx context.Context, addr sdk.AccAddress) error {
+	sessionKeys, err := k.GetSessionKeys(ctx, addr)
+	if err != nil {
+		return err
+	}
+	for _, sessionKey := range sessionKeys {
+		if err := k.RemoveSessionKey(ctx, addr, sessionKey.PublicKey); err != nil {
+			return err
+		}
+		if err := k.Tombstones.Set(ctx, sessionKey.PublicKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"example/common"
+	"example/testutil/sample"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+// msgsTx is a tx of msgs alone.
+type msgsTx []sdk.Msg
+
+func (tx msgsTx) GetMsgs() []sdk.Msg { return tx }
+
+func (tx msgsTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func TestUseSessionKey(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	a, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_ED25519)
+	require.NoError(t, err)
+	session, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_ED25519)
+	require.NoError(t, err)
+	sender := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	registerKey(t, f, sender, a)
+	_, err = ms.RegisterSessionKey(ctx, registerSessionKeyMsg(t, ctx, f, sender, session, a))
+	require.NoError(t, err)
+
+	recipient := sample.AccAddress()
+	use := func(from string, amount int64) error {
+		tx := msgsTx{&banktypes.MsgSend{
+			FromAddress: from,
+			ToAddress:   recipient,
+			Amount:      sdk.NewCoins(sdk.NewInt64Coin("stake", amount)),
+		}}
+		hash := sha256.Sum256([]byte(from))
+		sig, err := session.Sign(hash[:])
+		require.NoError(t, err)
+		return f.keeper.UseSessionKey(ctx, sender, tx, hash[:], types.SecondaryKeySignature{
+			PublicKey: session.PubKey(),
+			Signature: sig,
+		})
+	}
+
+	require.NoError(t, use(sender.String(), 60))
+	allowance, err := f.keeper.SessionKeyAllowances.Get(ctx, collections.Join(sender, session.PubKey()))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 40)), allowance.Remaining)
+
+	// an uppercase sender is the same account and counts against the limit
+	require.ErrorIs(t, use(strings.ToUpper(sender.String()), 60), types.ErrSessionKeySpendLimit)
+	require.NoError(t, use(strings.ToUpper(sender.String()), 40))
+	allowance, err = f.keeper.SessionKeyAllowances.Get(ctx, collections.Join(sender, session.PubKey()))
+	require.NoError(t, err)
+	require.True(t, allowance.Remaining.IsZero())
+}
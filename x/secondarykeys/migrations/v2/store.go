@@ -0,0 +1,56 @@
+package v2
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"example/x/secondarykeys/types"
+)
+
+var (
+	// AccountKeysPrefix is the prefix of the account secondary keys.
+	AccountKeysPrefix = collections.NewPrefix(0)
+	// PubKeyIndexPrefix is the prefix of the index of the account secondary
+	// keys by public key.
+	PubKeyIndexPrefix = collections.NewPrefix(6)
+)
+
+// MigrateStore migrates the account secondary keys from consensus version 1,
+// which stored the raw 65 byte secp256k1 public key of each account, to a
+// SecondaryPubKey carrying its KeyType. It also indexes the keys by public
+// key, which version 1 did not.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	accountKeys := collections.NewMap(sb, AccountKeysPrefix, "ante_handler_map", sdk.AccAddressKey, collections.BytesValue)
+	pubKeyIndex := collections.NewKeySet(sb, PubKeyIndexPrefix, "ante_handler_map_by_pub_key", collections.PairKeyCodec(collections.BytesKey, sdk.AccAddressKey))
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	iter, err := accountKeys.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range keys {
+		bz, err := cdc.Marshal(&types.SecondaryPubKey{KeyType: types.KeyType_KEY_TYPE_SECP256K1, Key: kv.Value})
+		if err != nil {
+			return err
+		}
+		if err := accountKeys.Set(ctx, kv.Key, bz); err != nil {
+			return err
+		}
+		if err := pubKeyIndex.Set(ctx, collections.Join(kv.Value, kv.Key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
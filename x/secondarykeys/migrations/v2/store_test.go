@@ -0,0 +1,50 @@
+package v2_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"example/testutil/sample"
+	v2 "example/x/secondarykeys/migrations/v2"
+	module "example/x/secondarykeys/module"
+	"example/x/secondarykeys/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey := crypto.FromECDSAPub(&priv.PublicKey)
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	// version 1 stored the raw public key
+	sb := collections.NewSchemaBuilder(storeService)
+	oldKeys := collections.NewMap(sb, v2.AccountKeysPrefix, "ante_handler_map", sdk.AccAddressKey, collections.BytesValue)
+	require.NoError(t, oldKeys.Set(ctx, addr, pubKey))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	sb = collections.NewSchemaBuilder(storeService)
+	accountKeys := collections.NewMap(sb, v2.AccountKeysPrefix, "ante_handler_map", sdk.AccAddressKey, codec.CollValue[types.SecondaryPubKey](cdc))
+	pubKeyIndex := collections.NewKeySet(sb, v2.PubKeyIndexPrefix, "ante_handler_map_by_pub_key", collections.PairKeyCodec(collections.BytesKey, sdk.AccAddressKey))
+
+	got, err := accountKeys.Get(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, types.SecondaryPubKey{KeyType: types.KeyType_KEY_TYPE_SECP256K1, Key: pubKey}, got)
+	indexed, err := pubKeyIndex.Has(ctx, collections.Join(pubKey, addr))
+	require.NoError(t, err)
+	require.True(t, indexed)
+}
@@ -0,0 +1,52 @@
+package v3
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"example/x/secondarykeys/types"
+)
+
+// AccountKeysPrefix is the prefix of the account secondary keys.
+var AccountKeysPrefix = collections.NewPrefix(0)
+
+// MigrateStore migrates the account secondary keys from consensus version 2,
+// which stored a single SecondaryPubKey per account, to a SecondaryKeySet of
+// that one key with weight and threshold 1. The index by public key holds the
+// same entries for both and is left as is.
+func MigrateStore(ctx context.Context, storeService corestore.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	accountKeys := collections.NewMap(sb, AccountKeysPrefix, "ante_handler_map", sdk.AccAddressKey, collections.BytesValue)
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	iter, err := accountKeys.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range keys {
+		var pubKey types.SecondaryPubKey
+		if err := cdc.Unmarshal(kv.Value, &pubKey); err != nil {
+			return err
+		}
+		keySet := types.NewSingleKeySet(pubKey)
+		bz, err := cdc.Marshal(&keySet)
+		if err != nil {
+			return err
+		}
+		if err := accountKeys.Set(ctx, kv.Key, bz); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+package v3_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	"github.com/stretchr/testify/require"
+
+	"example/testutil/sample"
+	v3 "example/x/secondarykeys/migrations/v3"
+	module "example/x/secondarykeys/module"
+	"example/x/secondarykeys/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	cdc := moduletestutil.MakeTestEncodingConfig(module.AppModule{}).Codec
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	ctx := testutil.DefaultContext(storeKey, storetypes.NewTransientStoreKey("transient_test"))
+
+	pubKey := types.SecondaryPubKey{KeyType: types.KeyType_KEY_TYPE_ED25519, Key: make([]byte, 32)}
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	// version 2 stored a single key
+	sb := collections.NewSchemaBuilder(storeService)
+	oldKeys := collections.NewMap(sb, v3.AccountKeysPrefix, "ante_handler_map", sdk.AccAddressKey, codec.CollValue[types.SecondaryPubKey](cdc))
+	require.NoError(t, oldKeys.Set(ctx, addr, pubKey))
+
+	require.NoError(t, v3.MigrateStore(ctx, storeService, cdc))
+
+	sb = collections.NewSchemaBuilder(storeService)
+	accountKeys := collections.NewMap(sb, v3.AccountKeysPrefix, "ante_handler_map", sdk.AccAddressKey, codec.CollValue[types.SecondaryKeySet](cdc))
+	got, err := accountKeys.Get(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, types.NewSingleKeySet(pubKey), got)
+}
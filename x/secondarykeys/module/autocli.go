@@ -17,6 +17,59 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "SecondaryKey",
+					Use:            "secondary-key [address]",
+					Short:          "Shows the secondary key registered for an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "AllSecondaryKeys",
+					Use:       "list-secondary-keys",
+					Short:     "List the secondary keys of all accounts",
+				},
+				{
+					RpcMethod:      "SecondaryKeyOwner",
+					Use:            "secondary-key-owner [public-key]",
+					Short:          "Shows the accounts a secondary public key is registered for",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "public_key"}},
+				},
+				{
+					RpcMethod:      "ValidatorSecondaryKey",
+					Use:            "validator-secondary-key [consensus-address]",
+					Short:          "Shows the secondary key of a validator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "consensus_address"}},
+				},
+				{
+					RpcMethod: "AllValidatorSecondaryKeys",
+					Use:       "list-validator-secondary-keys",
+					Short:     "List the secondary keys of all validators",
+				},
+				{
+					RpcMethod:      "Attestation",
+					Use:            "attestation [height]",
+					Short:          "Shows the validator signatures over the block at a height",
+					Long:           "Shows the validator signatures over the block at a height. Attestations are only kept for the attestation_retention_window param; older heights are not found.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "height"}},
+				},
+				{
+					RpcMethod:      "ExtensionSigningInfo",
+					Use:            "extension-signing-info [consensus-address]",
+					Short:          "Shows the vote extensions a validator missed in the signed blocks window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "consensus_address"}},
+				},
+				{
+					RpcMethod:      "PendingRecovery",
+					Use:            "pending-recovery [address]",
+					Short:          "Shows the pending recovery of an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "SessionKeys",
+					Use:            "session-keys [address]",
+					Short:          "Shows the session keys of an account with their remaining allowances",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,10 +81,89 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod:      "RegisterSecondaryKey",
+					Use:            "register-secondary-key [key-type] [public-key] [signature]",
+					Short:          "Register a secondary public key for the sender",
+					Long:           "Register a secondary public key for the sender. The signature is a proof of possession over the sender address and public key.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_type"}, {ProtoField: "public_key"}, {ProtoField: "signature"}},
+				},
+				{
+					RpcMethod:      "RotateSecondaryKey",
+					Use:            "rotate-secondary-key [key-type] [new-public-key] [current-key-signature] [new-key-signature]",
+					Short:          "Replace the sender's secondary public key",
+					Long:           "Replace the sender's secondary public key. Both the current and the new secondary key sign over the sender address, rotation sequence and both public keys.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_type"}, {ProtoField: "new_public_key"}, {ProtoField: "current_key_signature"}, {ProtoField: "new_key_signature"}},
+				},
+				{
+					RpcMethod:      "AddSecondaryKeySetMember",
+					Use:            "add-secondary-key-set-member [key-type] [public-key] [weight] [threshold] [new-key-signature]",
+					Short:          "Add a key to the sender's secondary key set",
+					Long:           "Add a key to the sender's secondary key set and set the threshold of the resulting set. The new key and the current threshold of the set sign over the sender address, rotation sequence, new key, weight and threshold; pass the signatures of the set as JSON with --signatures.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_type"}, {ProtoField: "public_key"}, {ProtoField: "weight"}, {ProtoField: "threshold"}, {ProtoField: "new_key_signature"}},
+				},
+				{
+					RpcMethod:      "RemoveSecondaryKeySetMember",
+					Use:            "remove-secondary-key-set-member [public-key] [threshold]",
+					Short:          "Remove a key from the sender's secondary key set",
+					Long:           "Remove a key from the sender's secondary key set and set the threshold of the remaining keys. The current threshold of the set signs over the sender address, rotation sequence, removed key and threshold; pass the signatures as JSON with --signatures.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "public_key"}, {ProtoField: "threshold"}},
+				},
+				{
+					RpcMethod: "RevokeSecondaryKey",
+					Use:       "revoke-secondary-key",
+					Short:     "Revoke the sender's secondary public key",
+					Long:      "Revoke the sender's secondary public key. The key is tombstoned and can never be registered again. With --lockdown the account rejects every transaction until a new secondary key is registered.",
+				},
+				{
+					RpcMethod:      "SetSecondarySignatureRequired",
+					Use:            "set-secondary-signature-required [required]",
+					Short:          "Require a secondary signature on every transaction of the sender",
+					Long:           "Require a valid secondary signature on every transaction of the sender while it has a registered secondary key, or lift that requirement with false.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "required"}},
+				},
+				{
+					RpcMethod:      "RecoverAccount",
+					Use:            "recover-account [account] [new-public-key]",
+					Short:          "Recover an account whose primary key is lost to a new primary key",
+					Long:           "Recover an account whose primary key is lost to a new primary key, given as JSON like the output of 'keys show --output json'. The account's secondary key set signs over the account number, sequence and current and new primary public keys; pass the signatures as JSON with --signatures. Any account can submit the recovery, which takes effect once the recovery_delay param has passed unless the current primary key cancels it.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "account"}, {ProtoField: "new_public_key"}},
+				},
+				{
+					RpcMethod: "CancelRecovery",
+					Use:       "cancel-recovery",
+					Short:     "Cancel the pending recovery of the sender",
+				},
+				{
+					RpcMethod:      "RegisterSessionKey",
+					Use:            "register-session-key [key-type] [public-key] [session-key-signature]",
+					Short:          "Register a session key of the sender, scoped to some Msg types, with a spend limit and an expiry",
+					Long:           "Register a session key of the sender that signs in place of its secondary key set for transactions whose msgs all have a type URL listed with --msg-type-urls, until --expiry-height or --expiry-time and while the fees and coins they spend stay within --spend-limit. The session key and the current threshold of the sender's key set sign over the sender address, rotation sequence, session key, scope, spend limit and expiry; pass the signatures of the set as JSON with --signatures.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "key_type"}, {ProtoField: "public_key"}, {ProtoField: "session_key_signature"}},
+				},
+				{
+					RpcMethod:      "RevokeSessionKey",
+					Use:            "revoke-session-key [public-key]",
+					Short:          "Revoke a session key of the sender",
+					Long:           "Revoke a session key of the sender before it expires. The key is tombstoned and can never be registered again.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "public_key"}},
+				},
+				{
+					RpcMethod:      "RegisterValidatorSecondaryKey",
+					Use:            "register-validator-secondary-key [validator-address] [key-type] [public-key] [signature]",
+					Short:          "Register the secondary public key a validator signs its vote extensions with",
+					Long:           "Register the secondary public key a validator signs its vote extensions with. It must be sent by the validator operator, and the signature is a proof of possession over the operator address and public key.",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "validator_address"}, {ProtoField: "key_type"}, {ProtoField: "public_key"}, {ProtoField: "signature"}},
+				},
+				{
+					RpcMethod: "InjectAttestations",
+					Skip:      true, // skipped because only injected by the proposer
+				},
 				{
 					RpcMethod:      "BroadcastData",
 					Use:            "broadcast-data [data]",
 					Short:          "Send a broadcast-data tx",
+					Deprecated:     "use register-secondary-key instead",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "data"}},
 				},
 				// this line is used by ignite scaffolding # autocli/tx
@@ -30,19 +30,19 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 	const (
-		opWeightMsgBroadcastData          = "op_weight_msg_secondarykeys"
-		defaultWeightMsgBroadcastData int = 100
+		opWeightMsgRegisterSecondaryKey          = "op_weight_msg_register_secondary_key"
+		defaultWeightMsgRegisterSecondaryKey int = 100
 	)
 
-	var weightMsgBroadcastData int
-	simState.AppParams.GetOrGenerate(opWeightMsgBroadcastData, &weightMsgBroadcastData, nil,
+	var weightMsgRegisterSecondaryKey int
+	simState.AppParams.GetOrGenerate(opWeightMsgRegisterSecondaryKey, &weightMsgRegisterSecondaryKey, nil,
 		func(_ *rand.Rand) {
-			weightMsgBroadcastData = defaultWeightMsgBroadcastData
+			weightMsgRegisterSecondaryKey = defaultWeightMsgRegisterSecondaryKey
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgBroadcastData,
-		secondarykeyssimulation.SimulateMsgBroadcastData(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig, ""),
+		weightMsgRegisterSecondaryKey,
+		secondarykeyssimulation.SimulateMsgRegisterSecondaryKey(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 
 	return operations
@@ -0,0 +1,143 @@
+package remotesigner
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	cmtnet "github.com/cometbft/cometbft/libs/net"
+	"github.com/cometbft/cometbft/libs/protoio"
+
+	"example/x/secondarykeys/types"
+)
+
+// RemoteSignerError is a request the signer refused.
+type RemoteSignerError struct {
+	Code        int32
+	Description string
+}
+
+func (e *RemoteSignerError) Error() string {
+	return fmt.Sprintf("remote signer error %d: %s", e.Code, e.Description)
+}
+
+// SignerClient requests signatures from a signer daemon. It implements the
+// voteextension.Signer interface, so the node's vote extensions are signed
+// without the secondary key being loaded into the node.
+type SignerClient struct {
+	addr    string
+	timeout time.Duration
+
+	// mtx serializes requests over conn, which is dialed when it is first
+	// needed and again after a failed request.
+	mtx  sync.Mutex
+	conn net.Conn
+}
+
+// NewSignerClient returns a client of the signer listening on addr, a tcp://
+// or unix:// address. Every request, including a reconnection, must complete
+// within timeout.
+func NewSignerClient(addr string, timeout time.Duration) (*SignerClient, error) {
+	if protocol, _ := cmtnet.ProtocolAndAddress(addr); protocol != "tcp" && protocol != "unix" {
+		return nil, fmt.Errorf("unsupported signer address %s, expected tcp:// or unix://", addr)
+	}
+	return &SignerClient{addr: addr, timeout: timeout}, nil
+}
+
+// PubKey returns the type and public key of the signer's secondary key.
+func (c *SignerClient) PubKey(chainID string) (types.KeyType, []byte, error) {
+	res, err := c.request(&types.SignerMessage{
+		Sum: &types.SignerMessage_PubKeyRequest{PubKeyRequest: &types.SignerPubKeyRequest{ChainId: chainID}},
+	})
+	if err != nil {
+		return types.KeyType_KEY_TYPE_UNSPECIFIED, nil, err
+	}
+	pubKeyRes := res.GetPubKeyResponse()
+	if pubKeyRes == nil {
+		return types.KeyType_KEY_TYPE_UNSPECIFIED, nil, fmt.Errorf("unexpected signer response %T", res.Sum)
+	}
+	if pubKeyRes.Error != nil {
+		return types.KeyType_KEY_TYPE_UNSPECIFIED, nil, &RemoteSignerError{Code: pubKeyRes.Error.Code, Description: pubKeyRes.Error.Description}
+	}
+	return pubKeyRes.KeyType, pubKeyRes.PublicKey, nil
+}
+
+// SignVoteExtension implements voteextension.Signer.
+func (c *SignerClient) SignVoteExtension(chainID string, height int64, hash []byte) ([]byte, error) {
+	res, err := c.request(&types.SignerMessage{
+		Sum: &types.SignerMessage_SignVoteExtensionRequest{SignVoteExtensionRequest: &types.SignVoteExtensionRequest{
+			ChainId:   chainID,
+			Height:    height,
+			BlockHash: hash,
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	signRes := res.GetSignVoteExtensionResponse()
+	if signRes == nil {
+		return nil, fmt.Errorf("unexpected signer response %T", res.Sum)
+	}
+	if signRes.Error != nil {
+		return nil, &RemoteSignerError{Code: signRes.Error.Code, Description: signRes.Error.Description}
+	}
+	return signRes.Signature, nil
+}
+
+// Close closes the connection to the signer.
+func (c *SignerClient) Close() error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	return c.closeConn()
+}
+
+// request sends req to the signer and returns its response. A request that
+// fails on an established connection, which the signer may have dropped, is
+// retried once on a new one. Retrying is safe since the signer hands out the
+// same signature for the same request.
+func (c *SignerClient) request(req *types.SignerMessage) (*types.SignerMessage, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	retry := c.conn != nil
+	res, err := c.requestOnce(req)
+	if err != nil && retry {
+		res, err = c.requestOnce(req)
+	}
+	return res, err
+}
+
+func (c *SignerClient) requestOnce(req *types.SignerMessage) (*types.SignerMessage, error) {
+	if c.conn == nil {
+		conn, err := dial(c.addr, c.timeout)
+		if err != nil {
+			return nil, fmt.Errorf("failed to connect to signer at %s: %w", c.addr, err)
+		}
+		c.conn = conn
+	}
+
+	var res types.SignerMessage
+	err := c.conn.SetDeadline(time.Now().Add(c.timeout))
+	if err == nil {
+		_, err = protoio.NewDelimitedWriter(c.conn).WriteMsg(req)
+	}
+	if err == nil {
+		_, err = protoio.NewDelimitedReader(c.conn, maxMsgSize).ReadMsg(&res)
+	}
+	if err != nil {
+		return nil, errors.Join(err, c.closeConn())
+	}
+	return &res, nil
+}
+
+func (c *SignerClient) closeConn() error {
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	return err
+}
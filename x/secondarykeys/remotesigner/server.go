@@ -0,0 +1,159 @@
+package remotesigner
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/cometbft/cometbft/libs/protoio"
+
+	voteextension "example/x/secondarykeys/VoteExtension"
+	"example/x/secondarykeys/types"
+)
+
+// Codes of the SignerError of a refused request.
+const (
+	ErrCodeWrongChainID int32 = iota + 1
+	ErrCodeDoubleSign
+	ErrCodeSigningFailed
+)
+
+// SignerServer answers the requests of nodes for the signatures of a
+// secondary key. It refuses to sign conflicting vote extensions, see
+// SignState.
+type SignerServer struct {
+	logger  log.Logger
+	chainID string
+	key     voteextension.SecondaryKey
+
+	// mtx serializes signing across connections, so that the sign state is
+	// checked and saved atomically.
+	mtx   sync.Mutex
+	state *SignState
+}
+
+// NewSignerServer returns a server signing the vote extensions of chainID with
+// key. state is the last signed vote extension.
+func NewSignerServer(logger log.Logger, chainID string, key voteextension.SecondaryKey, state *SignState) *SignerServer {
+	return &SignerServer{
+		logger:  logger,
+		chainID: chainID,
+		key:     key,
+		state:   state,
+	}
+}
+
+// Serve accepts connections on ln and answers their requests until ln is
+// closed.
+func (s *SignerServer) Serve(ln net.Listener) error {
+	for {
+		conn, err := ln.Accept()
+		if errors.Is(err, net.ErrClosed) {
+			return nil
+		} else if err != nil {
+			return err
+		}
+		go s.serveConn(conn)
+	}
+}
+
+// serveConn answers the requests of conn until it is closed or sends an
+// unknown request.
+func (s *SignerServer) serveConn(rawConn net.Conn) {
+	conn, err := secureConn(rawConn, DefaultTimeout)
+	if err != nil {
+		s.logger.Error("Signer connection handshake failed", "remote", rawConn.RemoteAddr(), "error", err)
+		return
+	}
+	defer conn.Close()
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		return
+	}
+
+	reader := protoio.NewDelimitedReader(conn, maxMsgSize)
+	writer := protoio.NewDelimitedWriter(conn)
+	for {
+		var req types.SignerMessage
+		if _, err := reader.ReadMsg(&req); err != nil {
+			return
+		}
+		res := s.handleRequest(&req)
+		if res == nil {
+			s.logger.Error("Unexpected signer request, closing connection", "request", fmt.Sprintf("%T", req.Sum))
+			return
+		}
+		if _, err := writer.WriteMsg(res); err != nil {
+			s.logger.Error("Failed to write signer response", "error", err)
+			return
+		}
+	}
+}
+
+// handleRequest returns the response to req, or nil if req is not a request.
+func (s *SignerServer) handleRequest(req *types.SignerMessage) *types.SignerMessage {
+	switch r := req.Sum.(type) {
+	case *types.SignerMessage_PubKeyRequest:
+		res := &types.SignerPubKeyResponse{}
+		if r.PubKeyRequest.ChainId != s.chainID {
+			res.Error = s.wrongChainID(r.PubKeyRequest.ChainId)
+		} else {
+			res.KeyType = s.key.KeyType()
+			res.PublicKey = s.key.PubKey()
+		}
+		return &types.SignerMessage{Sum: &types.SignerMessage_PubKeyResponse{PubKeyResponse: res}}
+
+	case *types.SignerMessage_SignVoteExtensionRequest:
+		res := &types.SignVoteExtensionResponse{}
+		signature, signerErr := s.signVoteExtension(r.SignVoteExtensionRequest)
+		if signerErr != nil {
+			res.Error = signerErr
+		} else {
+			res.Signature = signature
+		}
+		return &types.SignerMessage{Sum: &types.SignerMessage_SignVoteExtensionResponse{SignVoteExtensionResponse: res}}
+
+	default:
+		return nil
+	}
+}
+
+func (s *SignerServer) signVoteExtension(req *types.SignVoteExtensionRequest) ([]byte, *types.SignerError) {
+	if req.ChainId != s.chainID {
+		return nil, s.wrongChainID(req.ChainId)
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	signature, err := s.state.CheckHeight(req.Height, req.BlockHash)
+	if err != nil {
+		s.logger.Error("Refusing to sign vote extension", "height", req.Height, "error", err)
+		return nil, &types.SignerError{Code: ErrCodeDoubleSign, Description: err.Error()}
+	}
+	if signature != nil {
+		return signature, nil
+	}
+
+	signature, err = s.key.Sign(types.VoteExtensionSignBytes(s.chainID, req.Height, req.BlockHash))
+	if err != nil {
+		return nil, &types.SignerError{Code: ErrCodeSigningFailed, Description: err.Error()}
+	}
+	// The state is saved before the signature leaves the signer.
+	if err := s.state.Save(req.Height, req.BlockHash, signature); err != nil {
+		s.logger.Error("Failed to save sign state", "error", err)
+		return nil, &types.SignerError{Code: ErrCodeSigningFailed, Description: "failed to save sign state"}
+	}
+	s.logger.Info("Signed vote extension", "height", req.Height)
+	return signature, nil
+}
+
+func (s *SignerServer) wrongChainID(chainID string) *types.SignerError {
+	return &types.SignerError{
+		Code:        ErrCodeWrongChainID,
+		Description: fmt.Sprintf("signer serves chain %q, not %q", s.chainID, chainID),
+	}
+}
@@ -0,0 +1,97 @@
+package remotesigner
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cometbft/cometbft/libs/tempfile"
+)
+
+var (
+	// ErrHeightRegression is returned for a request below the last signed
+	// height.
+	ErrHeightRegression = errors.New("height regression")
+
+	// ErrConflictingBlock is returned for a request for another block at the
+	// last signed height. Signing it would be a double sign.
+	ErrConflictingBlock = errors.New("conflicting block at the same height")
+)
+
+// SignState is the last vote extension a signer signed, like the
+// priv_validator_state.json of CometBFT's file signer. It is persisted before
+// a signature is handed out, so that a restarted signer does not sign a
+// conflicting block either.
+//
+// Vote extensions are signed over the chain ID, height and block hash only,
+// and ExtendVote is not told the consensus round. The state is therefore
+// keyed on the height alone: once a block is signed at a height, no other
+// block is signed at it, even if a later round proposes one. The node then
+// sends its precommit for that block without an extension.
+type SignState struct {
+	Height    int64  `json:"height,string"`
+	BlockHash []byte `json:"block_hash,omitempty"`
+	Signature []byte `json:"signature,omitempty"`
+
+	filePath string
+}
+
+// LoadOrGenSignState loads the sign state stored at path, creating an empty
+// one if the file does not exist.
+func LoadOrGenSignState(path string) (*SignState, error) {
+	state := &SignState{filePath: path}
+	bz, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			return nil, err
+		}
+		return state, state.save()
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(bz, state); err != nil {
+		return nil, fmt.Errorf("failed to decode sign state %s: %w", path, err)
+	}
+	return state, nil
+}
+
+// CheckHeight checks that the vote extension of the block with hash may be
+// signed at height. If the same block was signed at height before, it returns
+// that signature, which may be handed out again.
+func (s *SignState) CheckHeight(height int64, hash []byte) ([]byte, error) {
+	if height <= 0 {
+		return nil, fmt.Errorf("invalid height %d", height)
+	}
+
+	switch {
+	case height < s.Height:
+		return nil, fmt.Errorf("%w: %d < %d", ErrHeightRegression, height, s.Height)
+	case height > s.Height:
+		return nil, nil
+	case !bytes.Equal(hash, s.BlockHash):
+		return nil, fmt.Errorf("%w: height %d", ErrConflictingBlock, height)
+	default:
+		return s.Signature, nil
+	}
+}
+
+// Save records that the vote extension of the block with hash was signed at
+// height, and persists the state.
+func (s *SignState) Save(height int64, hash, signature []byte) error {
+	s.Height = height
+	s.BlockHash = hash
+	s.Signature = signature
+	return s.save()
+}
+
+func (s *SignState) save() error {
+	bz, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return tempfile.WriteFileAtomic(s.filePath, bz, 0o600)
+}
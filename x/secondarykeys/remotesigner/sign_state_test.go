@@ -0,0 +1,59 @@
+package remotesigner
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "secondary_signer_state.json")
+	state, err := LoadOrGenSignState(path)
+	require.NoError(t, err)
+	require.Zero(t, state.Height)
+
+	hash, otherHash, sig := []byte("block"), []byte("other block"), []byte("signature")
+
+	signature, err := state.CheckHeight(5, hash)
+	require.NoError(t, err)
+	require.Nil(t, signature)
+	require.NoError(t, state.Save(5, hash, sig))
+
+	tests := []struct {
+		name    string
+		height  int64
+		hash    []byte
+		sig     []byte
+		wantErr error
+	}{
+		{name: "same block is signed again", height: 5, hash: hash, sig: sig},
+		{name: "conflicting block", height: 5, hash: otherHash, wantErr: ErrConflictingBlock},
+		{name: "next height", height: 6, hash: otherHash},
+		{name: "lower height", height: 4, hash: hash, wantErr: ErrHeightRegression},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			signature, err := state.CheckHeight(tc.height, tc.hash)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.sig, signature)
+		})
+	}
+
+	require.NoError(t, state.Save(6, otherHash, sig))
+	_, err = state.CheckHeight(5, otherHash)
+	require.ErrorIs(t, err, ErrHeightRegression)
+	_, err = state.CheckHeight(0, hash)
+	require.ErrorContains(t, err, "invalid height")
+
+	// the state survives a restart
+	loaded, err := LoadOrGenSignState(path)
+	require.NoError(t, err)
+	require.Equal(t, state, loaded)
+	_, err = loaded.CheckHeight(6, hash)
+	require.ErrorIs(t, err, ErrConflictingBlock)
+}
@@ -0,0 +1,110 @@
+package remotesigner
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	voteextension "example/x/secondarykeys/VoteExtension"
+	"example/x/secondarykeys/types"
+)
+
+const testChainID = "test-chain"
+
+// startTestSigner serves a signer of key on addr and returns the address it
+// listens on.
+func startTestSigner(t *testing.T, addr string, key voteextension.SecondaryKey, statePath string) string {
+	t.Helper()
+
+	state, err := LoadOrGenSignState(statePath)
+	require.NoError(t, err)
+	ln, err := Listen(addr)
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+	go NewSignerServer(log.NewNopLogger(), testChainID, key, state).Serve(ln)
+
+	if tcpAddr, ok := ln.Addr().(*net.TCPAddr); ok {
+		return "tcp://" + tcpAddr.String()
+	}
+	return addr
+}
+
+func TestSignerClient(t *testing.T) {
+	tests := []struct {
+		name string
+		addr func(dir string) string
+	}{
+		{name: "unix", addr: func(dir string) string { return "unix://" + filepath.Join(dir, "signer.sock") }},
+		{name: "tcp", addr: func(string) string { return "tcp://127.0.0.1:0" }},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := t.TempDir()
+			statePath := filepath.Join(dir, "state.json")
+			priv, err := crypto.GenerateKey()
+			require.NoError(t, err)
+			key := voteextension.NewSecp256k1SecondaryKey(priv)
+
+			addr := startTestSigner(t, tc.addr(dir), key, statePath)
+			client, err := NewSignerClient(addr, DefaultTimeout)
+			require.NoError(t, err)
+			defer client.Close()
+
+			keyType, pubKey, err := client.PubKey(testChainID)
+			require.NoError(t, err)
+			require.Equal(t, types.KeyType_KEY_TYPE_SECP256K1, keyType)
+			require.Equal(t, key.PubKey(), pubKey)
+
+			hash, otherHash := []byte("block"), []byte("other block")
+			sig, err := client.SignVoteExtension(testChainID, 10, hash)
+			require.NoError(t, err)
+			require.True(t, types.VerifySignature(keyType, pubKey, types.VoteExtensionSignBytes(testChainID, 10, hash), sig))
+
+			// the same request is answered with the same signature
+			again, err := client.SignVoteExtension(testChainID, 10, hash)
+			require.NoError(t, err)
+			require.Equal(t, sig, again)
+
+			requireSignerError(t, client, testChainID, 10, otherHash, ErrCodeDoubleSign)
+			requireSignerError(t, client, "other-chain", 11, hash, ErrCodeWrongChainID)
+
+			_, err = client.SignVoteExtension(testChainID, 11, otherHash)
+			require.NoError(t, err)
+			requireSignerError(t, client, testChainID, 10, hash, ErrCodeDoubleSign)
+			requireSignerError(t, client, testChainID, 11, hash, ErrCodeDoubleSign)
+
+			// a signer restarted on the same state still refuses to double
+			// sign
+			restarted := startTestSigner(t, tc.addr(t.TempDir()), key, statePath)
+			client, err = NewSignerClient(restarted, DefaultTimeout)
+			require.NoError(t, err)
+			defer client.Close()
+			requireSignerError(t, client, testChainID, 11, hash, ErrCodeDoubleSign)
+			_, err = client.SignVoteExtension(testChainID, 12, hash)
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSignerClientUnreachable(t *testing.T) {
+	_, err := NewSignerClient("http://127.0.0.1:1234", DefaultTimeout)
+	require.ErrorContains(t, err, "unsupported signer address")
+
+	client, err := NewSignerClient("unix://"+filepath.Join(t.TempDir(), "missing.sock"), DefaultTimeout)
+	require.NoError(t, err)
+	_, err = client.SignVoteExtension(testChainID, 1, []byte("block"))
+	require.ErrorContains(t, err, "failed to connect to signer")
+}
+
+func requireSignerError(t *testing.T, client *SignerClient, chainID string, height int64, hash []byte, code int32) {
+	t.Helper()
+
+	_, err := client.SignVoteExtension(chainID, height, hash)
+	var signerErr *RemoteSignerError
+	require.ErrorAs(t, err, &signerErr)
+	require.Equal(t, code, signerErr.Code)
+}
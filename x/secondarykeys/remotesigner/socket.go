@@ -0,0 +1,58 @@
+package remotesigner
+
+import (
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	cmtnet "github.com/cometbft/cometbft/libs/net"
+	p2pconn "github.com/cometbft/cometbft/p2p/conn"
+)
+
+const (
+	// DefaultTimeout is the default timeout of a request to the signer,
+	// including the connection handshake.
+	DefaultTimeout = 3 * time.Second
+
+	// maxMsgSize is the maximum size of a message of the signer protocol.
+	maxMsgSize = 64 * 1024
+)
+
+// Listen listens on addr, a tcp:// or unix:// address, for connections from
+// nodes.
+func Listen(addr string) (net.Listener, error) {
+	protocol, address := cmtnet.ProtocolAndAddress(addr)
+	if protocol != "tcp" && protocol != "unix" {
+		return nil, fmt.Errorf("unsupported signer address %s, expected tcp:// or unix://", addr)
+	}
+	return net.Listen(protocol, address)
+}
+
+// dial connects to the signer listening on addr.
+func dial(addr string, timeout time.Duration) (net.Conn, error) {
+	protocol, address := cmtnet.ProtocolAndAddress(addr)
+	conn, err := net.DialTimeout(protocol, address, timeout)
+	if err != nil {
+		return nil, err
+	}
+	return secureConn(conn, timeout)
+}
+
+// secureConn encrypts a TCP connection with an ephemeral key, as CometBFT's
+// privval socket does. Unix connections are returned as they are.
+func secureConn(conn net.Conn, timeout time.Duration) (net.Conn, error) {
+	if _, ok := conn.(*net.TCPConn); !ok {
+		return conn, nil
+	}
+	if err := conn.SetDeadline(time.Now().Add(timeout)); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	secretConn, err := p2pconn.MakeSecretConnection(conn, ed25519.GenPrivKey())
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return secretConn, nil
+}
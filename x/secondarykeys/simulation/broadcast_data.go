@@ -28,7 +28,7 @@ func SimulateMsgBroadcastData(
 		}
 		_, err := newMsgServer.BroadcastData(ctx, msg)
 		if err != nil {
-			panic("err at broadcast data inside simulate")
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to broadcast data"), nil, err
 		}
 		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "BroadcastData simulation not implemented"), nil, nil
 	}
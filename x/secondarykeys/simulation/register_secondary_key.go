@@ -0,0 +1,60 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"example/common"
+	"example/x/secondarykeys/keeper"
+	"example/x/secondarykeys/types"
+)
+
+// accountKeyTypes are the key types accounts register secondary keys of.
+var accountKeyTypes = []types.KeyType{
+	types.KeyType_KEY_TYPE_SECP256K1,
+	types.KeyType_KEY_TYPE_ED25519,
+	types.KeyType_KEY_TYPE_SECP256R1,
+	types.KeyType_KEY_TYPE_SCHNORR,
+}
+
+func SimulateMsgRegisterSecondaryKey(
+	ak types.AuthKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+	txGen client.TxConfig,
+) simtypes.Operation {
+	newMsgServer := keeper.NewMsgServerImpl(k)
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		simAccount, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgRegisterSecondaryKey{
+			Sender:  simAccount.Address.String(),
+			KeyType: accountKeyTypes[r.Intn(len(accountKeyTypes))],
+		}
+
+		if exists, err := k.AnteHandlerMap.Has(ctx, simAccount.Address); err != nil || exists {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "secondary key already registered"), nil, nil
+		}
+
+		// derive the secondary key from r to keep the simulation deterministic
+		seed := make([]byte, 32)
+		r.Read(seed)
+		priv, err := common.SecondaryPrivKeyFromSeed(msg.KeyType, seed)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to derive secondary key"), nil, nil
+		}
+		msg.PublicKey, msg.Signature, err = common.SignProofOfPossession(priv, simAccount.Address)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to sign proof of possession"), nil, err
+		}
+
+		if _, err := newMsgServer.RegisterSecondaryKey(ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "failed to register secondary key"), nil, err
+		}
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
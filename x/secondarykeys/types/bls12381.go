@@ -0,0 +1,148 @@
+package types
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	blst "github.com/supranational/blst/bindings/go"
+)
+
+const (
+	// BLS12381PubKeySize is the length of a compressed BLS12-381 public key,
+	// a point on G1.
+	BLS12381PubKeySize = 48
+
+	// BLS12381SignatureSize is the length of a compressed BLS12-381
+	// signature, a point on G2.
+	BLS12381SignatureSize = 96
+
+	// BLS12381PrivKeySize is the length of a serialized BLS12-381 private
+	// key.
+	BLS12381PrivKeySize = 32
+)
+
+// BLS12-381 keys follow the proof of possession scheme of the IETF BLS
+// signature draft with minimal public keys. Signatures and proofs of
+// possession are hashed to G2 with different tags, so a signature can never
+// serve as a proof of possession. Registering a key with a proof of
+// possession rules out rogue key attacks on aggregated signatures.
+var (
+	bls12381SignatureDST         = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	bls12381ProofOfPossessionDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+)
+
+// GenBLS12381PrivKey generates a new BLS12-381 private key.
+func GenBLS12381PrivKey() ([]byte, error) {
+	ikm := make([]byte, 32)
+	if _, err := rand.Read(ikm); err != nil {
+		return nil, err
+	}
+	return blst.KeyGen(ikm).Serialize(), nil
+}
+
+// BLS12381PubKey returns the compressed public key of the private key priv.
+func BLS12381PubKey(priv []byte) ([]byte, error) {
+	sk, err := bls12381SecretKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	return new(blst.P1Affine).From(sk).Compress(), nil
+}
+
+// SignBLS12381 signs msg with the private key priv.
+func SignBLS12381(priv, msg []byte) ([]byte, error) {
+	return signBLS12381(priv, msg, bls12381SignatureDST)
+}
+
+// SignBLS12381ProofOfPossession signs the proof of possession digest msg with
+// the private key priv.
+func SignBLS12381ProofOfPossession(priv, msg []byte) ([]byte, error) {
+	return signBLS12381(priv, msg, bls12381ProofOfPossessionDST)
+}
+
+func signBLS12381(priv, msg, dst []byte) ([]byte, error) {
+	sk, err := bls12381SecretKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	return new(blst.P2Affine).Sign(sk, msg, dst).Compress(), nil
+}
+
+func bls12381SecretKey(priv []byte) (*blst.SecretKey, error) {
+	if len(priv) != BLS12381PrivKeySize {
+		return nil, fmt.Errorf("expected a %d byte BLS12-381 private key, got %d", BLS12381PrivKeySize, len(priv))
+	}
+	sk := new(blst.SecretKey).Deserialize(priv)
+	if sk == nil || !sk.Valid() {
+		return nil, errors.New("invalid BLS12-381 private key")
+	}
+	return sk, nil
+}
+
+// bls12381PubKey decompresses pubKey and checks that it is a valid public
+// key: a point of the G1 subgroup other than the identity.
+func bls12381PubKey(pubKey []byte) (*blst.P1Affine, error) {
+	if len(pubKey) != BLS12381PubKeySize {
+		return nil, errorsmod.Wrapf(ErrInvalidPublicKey, "expected %d bytes, got %d", BLS12381PubKeySize, len(pubKey))
+	}
+	pk := new(blst.P1Affine).Uncompress(pubKey)
+	if pk == nil || !pk.KeyValidate() {
+		return nil, errorsmod.Wrap(ErrInvalidPublicKey, "invalid BLS12-381 public key")
+	}
+	return pk, nil
+}
+
+// bls12381Signature decompresses sig and checks that it is a point of the G2
+// subgroup other than the identity.
+func bls12381Signature(sig []byte) *blst.P2Affine {
+	if len(sig) != BLS12381SignatureSize {
+		return nil
+	}
+	signature := new(blst.P2Affine).Uncompress(sig)
+	if signature == nil || !signature.SigValidate(true) {
+		return nil
+	}
+	return signature
+}
+
+func verifyBLS12381(pubKey, msg, sig, dst []byte) bool {
+	pk, err := bls12381PubKey(pubKey)
+	if err != nil {
+		return false
+	}
+	signature := bls12381Signature(sig)
+	return signature != nil && signature.Verify(false, pk, false, msg, dst)
+}
+
+// AggregateBLS12381Signatures aggregates sigs into one signature.
+func AggregateBLS12381Signatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidVoteExtension, "no signatures to aggregate")
+	}
+	var aggregate blst.P2Aggregate
+	if !aggregate.AggregateCompressed(sigs, true) {
+		return nil, errorsmod.Wrap(ErrInvalidVoteExtension, "invalid BLS12-381 signature")
+	}
+	return aggregate.ToAffine().Compress(), nil
+}
+
+// VerifyBLS12381Aggregate reports whether sig aggregates a signature over msg
+// by every key in pubKeys. The keys must have been registered with a proof of
+// possession.
+func VerifyBLS12381Aggregate(pubKeys [][]byte, msg, sig []byte) bool {
+	if len(pubKeys) == 0 {
+		return false
+	}
+	pks := make([]*blst.P1Affine, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		pk, err := bls12381PubKey(pubKey)
+		if err != nil {
+			return false
+		}
+		pks[i] = pk
+	}
+	signature := bls12381Signature(sig)
+	return signature != nil && signature.FastAggregateVerify(false, pks, msg, bls12381SignatureDST)
+}
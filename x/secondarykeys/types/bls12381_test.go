@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"testing"
+
+	"example/x/secondarykeys/types"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func genBLS12381Key(t *testing.T) (priv, pubKey []byte) {
+	t.Helper()
+	priv, err := types.GenBLS12381PrivKey()
+	require.NoError(t, err)
+	pubKey, err = types.BLS12381PubKey(priv)
+	require.NoError(t, err)
+	return priv, pubKey
+}
+
+func TestBLS12381Signature(t *testing.T) {
+	priv, pubKey := genBLS12381Key(t)
+	require.Len(t, pubKey, types.BLS12381PubKeySize)
+	require.NoError(t, types.ValidatePublicKey(types.KeyType_KEY_TYPE_BLS12_381, pubKey))
+	require.Equal(t, types.KeyType_KEY_TYPE_BLS12_381, types.ValidatorKeyType(pubKey))
+
+	hash := crypto.Keccak256([]byte("message"))
+	sig, err := types.SignBLS12381(priv, hash)
+	require.NoError(t, err)
+	require.Len(t, sig, types.BLS12381SignatureSize)
+	require.True(t, types.VerifySignature(types.KeyType_KEY_TYPE_BLS12_381, pubKey, hash, sig))
+	require.False(t, types.VerifySignature(types.KeyType_KEY_TYPE_BLS12_381, pubKey, crypto.Keccak256([]byte("other")), sig))
+
+	// signatures and proofs of possession are not interchangeable
+	pop, err := types.SignBLS12381ProofOfPossession(priv, hash)
+	require.NoError(t, err)
+	require.True(t, types.VerifyProofOfPossession(types.KeyType_KEY_TYPE_BLS12_381, pubKey, hash, pop))
+	require.False(t, types.VerifyProofOfPossession(types.KeyType_KEY_TYPE_BLS12_381, pubKey, hash, sig))
+	require.False(t, types.VerifySignature(types.KeyType_KEY_TYPE_BLS12_381, pubKey, hash, pop))
+
+	// the identity is not a valid public key
+	identity := make([]byte, types.BLS12381PubKeySize)
+	identity[0] = 0xc0
+	require.ErrorIs(t, types.ValidatePublicKey(types.KeyType_KEY_TYPE_BLS12_381, identity), types.ErrInvalidPublicKey)
+	require.ErrorIs(t, types.ValidatePublicKey(types.KeyType_KEY_TYPE_BLS12_381, pubKey[1:]), types.ErrInvalidPublicKey)
+
+	// BLS12-381 keys are for validators only
+	require.ErrorIs(t, types.ValidateAccountPublicKey(types.KeyType_KEY_TYPE_BLS12_381, pubKey), types.ErrInvalidKeyType)
+}
+
+func TestBLS12381Aggregate(t *testing.T) {
+	hash := crypto.Keccak256([]byte("block"))
+	var pubKeys, sigs [][]byte
+	for i := 0; i < 3; i++ {
+		priv, pubKey := genBLS12381Key(t)
+		sig, err := types.SignBLS12381(priv, hash)
+		require.NoError(t, err)
+		pubKeys = append(pubKeys, pubKey)
+		sigs = append(sigs, sig)
+	}
+
+	aggregate, err := types.AggregateBLS12381Signatures(sigs)
+	require.NoError(t, err)
+	require.Len(t, aggregate, types.BLS12381SignatureSize)
+	require.True(t, types.VerifyBLS12381Aggregate(pubKeys, hash, aggregate))
+
+	// every signer must be named, and only signers
+	require.False(t, types.VerifyBLS12381Aggregate(pubKeys[:2], hash, aggregate))
+	require.False(t, types.VerifyBLS12381Aggregate(nil, hash, aggregate))
+	require.False(t, types.VerifyBLS12381Aggregate(pubKeys, crypto.Keccak256([]byte("other")), aggregate))
+
+	_, err = types.AggregateBLS12381Signatures(nil)
+	require.ErrorIs(t, err, types.ErrInvalidVoteExtension)
+	_, err = types.AggregateBLS12381Signatures([][]byte{sigs[0], sigs[1][1:]})
+	require.ErrorIs(t, err, types.ErrInvalidVoteExtension)
+}
+
+func TestParseKeyType(t *testing.T) {
+	for s, expected := range map[string]types.KeyType{
+		"secp256k1":          types.KeyType_KEY_TYPE_SECP256K1,
+		"bls12_381":          types.KeyType_KEY_TYPE_BLS12_381,
+		"KEY_TYPE_BLS12_381": types.KeyType_KEY_TYPE_BLS12_381,
+		"KEY_TYPE_SECP256K1": types.KeyType_KEY_TYPE_SECP256K1,
+		"ed25519":            types.KeyType_KEY_TYPE_ED25519,
+		"secp256r1":          types.KeyType_KEY_TYPE_SECP256R1,
+		"schnorr":            types.KeyType_KEY_TYPE_SCHNORR,
+	} {
+		keyType, err := types.ParseKeyType(s)
+		require.NoError(t, err)
+		require.Equal(t, expected, keyType)
+	}
+
+	for _, s := range []string{"", "unspecified", "rsa"} {
+		_, err := types.ParseKeyType(s)
+		require.ErrorIs(t, err, types.ErrInvalidKeyType)
+	}
+}
@@ -4,15 +4,32 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 )
 
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterSecondaryKey{},
+		&MsgRotateSecondaryKey{},
+		&MsgRevokeSecondaryKey{},
+		&MsgAddSecondaryKeySetMember{},
+		&MsgRemoveSecondaryKeySetMember{},
+		&MsgRecoverAccount{},
+		&MsgCancelRecovery{},
+		&MsgRegisterSessionKey{},
+		&MsgRevokeSessionKey{},
+		&MsgSetSecondarySignatureRequired{},
+		&MsgRegisterValidatorSecondaryKey{},
+		&MsgInjectAttestations{},
 		&MsgBroadcastData{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
+
+	registrar.RegisterImplementations((*txtypes.TxExtensionOptionI)(nil),
+		&SecondarySignatureExtension{},
+	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -8,5 +8,31 @@ import (
 
 // x/secondarykeys module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner              = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidKeyType             = errors.Register(ModuleName, 1101, "invalid secondary key type")
+	ErrInvalidPublicKey           = errors.Register(ModuleName, 1102, "invalid secondary public key")
+	ErrInvalidProofOfPossession   = errors.Register(ModuleName, 1103, "invalid proof of possession")
+	ErrInvalidData                = errors.Register(ModuleName, 1104, "invalid broadcast data")
+	ErrSecondaryKeyNotFound       = errors.Register(ModuleName, 1105, "secondary key not found")
+	ErrSecondaryKeyExists         = errors.Register(ModuleName, 1106, "secondary key already registered")
+	ErrInvalidRotation            = errors.Register(ModuleName, 1107, "invalid secondary key rotation")
+	ErrSecondaryKeyTombstoned     = errors.Register(ModuleName, 1108, "secondary key has been revoked")
+	ErrSecondaryKeyRevoked        = errors.Register(ModuleName, 1109, "account secondary key has been revoked")
+	ErrAccountLocked              = errors.Register(ModuleName, 1110, "account is locked until a new secondary key is registered")
+	ErrSecondaryKeyInUse          = errors.Register(ModuleName, 1111, "secondary key is registered for another account")
+	ErrSecondarySignatureRequired = errors.Register(ModuleName, 1112, "secondary signature required")
+	ErrInvalidParams              = errors.Register(ModuleName, 1113, "invalid params")
+	ErrValidatorNotFound          = errors.Register(ModuleName, 1114, "validator not found")
+	ErrInvalidVoteExtension       = errors.Register(ModuleName, 1115, "invalid vote extension encoding")
+	ErrInvalidSecondarySignature  = errors.Register(ModuleName, 1116, "invalid secondary signature")
+	ErrInvalidKeySet              = errors.Register(ModuleName, 1117, "invalid secondary key set")
+	ErrRecoveryDisabled           = errors.Register(ModuleName, 1118, "account recovery is disabled")
+	ErrRecoveryPending            = errors.Register(ModuleName, 1119, "account recovery already pending")
+	ErrRecoveryNotFound           = errors.Register(ModuleName, 1120, "no pending account recovery")
+	ErrInvalidSessionKey          = errors.Register(ModuleName, 1121, "invalid session key")
+	ErrSessionKeyNotFound         = errors.Register(ModuleName, 1122, "session key not found")
+	ErrSessionKeyExists           = errors.Register(ModuleName, 1123, "session key already registered")
+	ErrSessionKeyExpired          = errors.Register(ModuleName, 1124, "session key expired")
+	ErrSessionKeyScope            = errors.Register(ModuleName, 1125, "message outside the session key scope")
+	ErrSessionKeySpendLimit       = errors.Register(ModuleName, 1126, "session key spend limit exceeded")
 )
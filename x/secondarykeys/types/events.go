@@ -0,0 +1,46 @@
+package types
+
+// x/secondarykeys module event types and attribute keys
+const (
+	EventTypeRegisterSecondaryKey          = "register_secondary_key"
+	EventTypeRotateSecondaryKey            = "rotate_secondary_key"
+	EventTypeRevokeSecondaryKey            = "revoke_secondary_key"
+	EventTypeAddSecondaryKeySetMember      = "add_secondary_key_set_member"
+	EventTypeRemoveSecondaryKeySetMember   = "remove_secondary_key_set_member"
+	EventTypeSetSecondarySignatureRequired = "set_secondary_signature_required"
+	EventTypeRecoverAccount                = "recover_account"
+	EventTypeCancelRecovery                = "cancel_recovery"
+	EventTypeExecuteRecovery               = "execute_recovery"
+	EventTypeRegisterSessionKey            = "register_session_key"
+	EventTypeRevokeSessionKey              = "revoke_session_key"
+	EventTypeUseSessionKey                 = "use_session_key"
+	EventTypeRegisterValidatorSecondaryKey = "register_validator_secondary_key"
+	EventTypeMissingVoteExtension          = "missing_vote_extension"
+	EventTypeJailMissingVoteExtensions     = "jail_missing_vote_extensions"
+
+	AttributeKeyAccount           = "account"
+	AttributeKeyKeyType           = "key_type"
+	AttributeKeyPublicKey         = "public_key"
+	AttributeKeyPreviousPublicKey = "previous_public_key"
+	AttributeKeyRotationSequence  = "rotation_sequence"
+	AttributeKeyLockdown          = "lockdown"
+	AttributeKeyWeight            = "weight"
+	AttributeKeyThreshold         = "threshold"
+	AttributeKeyRequired          = "required"
+	AttributeKeySubmitter         = "submitter"
+	AttributeKeyNewPublicKey      = "new_public_key"
+	AttributeKeyExecuteTime       = "execute_time"
+	AttributeKeyMsgTypeURLs       = "msg_type_urls"
+	AttributeKeySpendLimit        = "spend_limit"
+	AttributeKeyExpiryHeight      = "expiry_height"
+	AttributeKeyExpiryTime        = "expiry_time"
+	AttributeKeySpent             = "spent"
+	AttributeKeyRemaining         = "remaining"
+	AttributeKeyValidator         = "validator"
+	AttributeKeyConsensusAddress  = "consensus_address"
+	AttributeKeyMissedBlocks      = "missed_blocks"
+	AttributeKeyHeight            = "height"
+	AttributeKeyPower             = "power"
+	AttributeKeySlashFraction     = "slash_fraction"
+	AttributeKeyJailedUntil       = "jailed_until"
+)
@@ -2,15 +2,20 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
 type AuthKeeper interface {
 	AddressCodec() address.Codec
-	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI // only used for simulation
+	GetAccount(context.Context, sdk.AccAddress) sdk.AccountI
+	// SetAccount stores the new primary public key of a recovered account.
+	SetAccount(context.Context, sdk.AccountI)
 	// Methods imported from account should be defined here
 }
 
@@ -20,6 +25,21 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 }
 
+// StakingKeeper defines the expected interface for the Staking module.
+type StakingKeeper interface {
+	ValidatorAddressCodec() address.Codec
+	GetValidator(context.Context, sdk.ValAddress) (stakingtypes.Validator, error)
+	ValidatorByConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.ValidatorI, error)
+}
+
+// SlashingKeeper defines the expected interface for the Slashing module. It
+// jails and slashes validators that miss too many vote extensions.
+type SlashingKeeper interface {
+	Jail(context.Context, sdk.ConsAddress) error
+	JailUntil(context.Context, sdk.ConsAddress, time.Time) error
+	SlashWithInfractionReason(ctx context.Context, consAddr sdk.ConsAddress, fraction math.LegacyDec, power, distributionHeight int64, infraction stakingtypes.Infraction) error
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +16,200 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	tombstones := make(map[string]struct{}, len(gs.Tombstones))
+	for _, pubKey := range gs.Tombstones {
+		if _, ok := tombstones[string(pubKey)]; ok {
+			return fmt.Errorf("duplicate tombstone %X", pubKey)
+		}
+		tombstones[string(pubKey)] = struct{}{}
+	}
+
+	accounts := make(map[string]struct{}, len(gs.AccountKeys))
+	pubKeys := make(map[string]string, len(gs.AccountKeys))
+	for _, key := range gs.AccountKeys {
+		if _, err := sdk.AccAddressFromBech32(key.Address); err != nil {
+			return fmt.Errorf("invalid account key address %s: %w", key.Address, err)
+		}
+		if _, ok := accounts[key.Address]; ok {
+			return fmt.Errorf("duplicate account key for %s", key.Address)
+		}
+		accounts[key.Address] = struct{}{}
+
+		if err := key.Validate(); err != nil {
+			return fmt.Errorf("invalid account key for %s: %w", key.Address, err)
+		}
+		for _, member := range key.SecondaryKeySet().Members {
+			if _, ok := tombstones[string(member.PublicKey)]; ok {
+				return fmt.Errorf("account key for %s is tombstoned", key.Address)
+			}
+			if owner, ok := pubKeys[string(member.PublicKey)]; ok && !gs.Params.AllowSharedSecondaryKeys {
+				return fmt.Errorf("account key for %s is also registered for %s", key.Address, owner)
+			}
+			pubKeys[string(member.PublicKey)] = key.Address
+		}
+	}
+
+	validators := make(map[string]struct{}, len(gs.ValidatorKeys))
+	for _, key := range gs.ValidatorKeys {
+		if _, err := sdk.ConsAddressFromBech32(key.ConsensusAddress); err != nil {
+			return fmt.Errorf("invalid validator key address %s: %w", key.ConsensusAddress, err)
+		}
+		if _, ok := validators[key.ConsensusAddress]; ok {
+			return fmt.Errorf("duplicate validator key for %s", key.ConsensusAddress)
+		}
+		validators[key.ConsensusAddress] = struct{}{}
+
+		if err := ValidatePublicKey(ValidatorKeyType(key.PublicKey), key.PublicKey); err != nil {
+			return fmt.Errorf("invalid validator key for %s: %w", key.ConsensusAddress, err)
+		}
+	}
+
+	history := make(map[string]struct{}, len(gs.KeyHistory))
+	for _, record := range gs.KeyHistory {
+		if _, err := sdk.AccAddressFromBech32(record.Address); err != nil {
+			return fmt.Errorf("invalid key history address %s: %w", record.Address, err)
+		}
+		id := fmt.Sprintf("%s/%d", record.Address, record.Sequence)
+		if _, ok := history[id]; ok {
+			return fmt.Errorf("duplicate key history entry %d for %s", record.Sequence, record.Address)
+		}
+		history[id] = struct{}{}
+
+		if err := ValidateAccountPublicKey(record.Entry.KeyType, record.Entry.PublicKey); err != nil {
+			return fmt.Errorf("invalid key history entry %d for %s: %w", record.Sequence, record.Address, err)
+		}
+	}
+
+	revoked := make(map[string]struct{}, len(gs.RevokedAccounts))
+	for _, addr := range gs.RevokedAccounts {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid revoked account %s: %w", addr, err)
+		}
+		if _, ok := revoked[addr]; ok {
+			return fmt.Errorf("duplicate revoked account %s", addr)
+		}
+		if _, ok := accounts[addr]; ok {
+			return fmt.Errorf("revoked account %s has a secondary key", addr)
+		}
+		revoked[addr] = struct{}{}
+	}
+
+	locked := make(map[string]struct{}, len(gs.LockedAccounts))
+	for _, addr := range gs.LockedAccounts {
+		if _, ok := revoked[addr]; !ok {
+			return fmt.Errorf("locked account %s is not revoked", addr)
+		}
+		if _, ok := locked[addr]; ok {
+			return fmt.Errorf("duplicate locked account %s", addr)
+		}
+		locked[addr] = struct{}{}
+	}
+
+	required := make(map[string]struct{}, len(gs.RequiredAccounts))
+	for _, addr := range gs.RequiredAccounts {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid required account %s: %w", addr, err)
+		}
+		if _, ok := required[addr]; ok {
+			return fmt.Errorf("duplicate required account %s", addr)
+		}
+		required[addr] = struct{}{}
+	}
+
+	signingInfos := make(map[string]struct{}, len(gs.ExtensionSigningInfos))
+	for _, info := range gs.ExtensionSigningInfos {
+		if _, err := sdk.ConsAddressFromBech32(info.ConsensusAddress); err != nil {
+			return fmt.Errorf("invalid extension signing info address %s: %w", info.ConsensusAddress, err)
+		}
+		if _, ok := signingInfos[info.ConsensusAddress]; ok {
+			return fmt.Errorf("duplicate extension signing info for %s", info.ConsensusAddress)
+		}
+		signingInfos[info.ConsensusAddress] = struct{}{}
+
+		if info.IndexOffset < 0 || info.MissedBlocksCounter < 0 || info.MissedBlocksCounter > info.IndexOffset {
+			return fmt.Errorf("invalid extension signing info for %s", info.ConsensusAddress)
+		}
+	}
+
+	missedBlocks := make(map[string]struct{}, len(gs.ExtensionMissedBlocks))
+	for _, missed := range gs.ExtensionMissedBlocks {
+		if _, ok := signingInfos[missed.ConsensusAddress]; !ok {
+			return fmt.Errorf("missed extensions of %s have no signing info", missed.ConsensusAddress)
+		}
+		if _, ok := missedBlocks[missed.ConsensusAddress]; ok {
+			return fmt.Errorf("duplicate missed extensions for %s", missed.ConsensusAddress)
+		}
+		missedBlocks[missed.ConsensusAddress] = struct{}{}
+
+		for _, index := range missed.MissedIndexes {
+			if index < 0 || (gs.Params.SignedBlocksWindow > 0 && index >= gs.Params.SignedBlocksWindow) {
+				return fmt.Errorf("missed extension index %d of %s is outside the signed blocks window", index, missed.ConsensusAddress)
+			}
+		}
+	}
+
+	recoveries := make(map[string]struct{}, len(gs.PendingRecoveries))
+	for _, recovery := range gs.PendingRecoveries {
+		if _, err := sdk.AccAddressFromBech32(recovery.Account); err != nil {
+			return fmt.Errorf("invalid pending recovery account %s: %w", recovery.Account, err)
+		}
+		if _, ok := recoveries[recovery.Account]; ok {
+			return fmt.Errorf("duplicate pending recovery for %s", recovery.Account)
+		}
+		recoveries[recovery.Account] = struct{}{}
+
+		if _, ok := accounts[recovery.Account]; !ok {
+			return fmt.Errorf("pending recovery of %s without a secondary key", recovery.Account)
+		}
+		if recovery.NewPublicKey == nil {
+			return fmt.Errorf("pending recovery of %s without a new public key", recovery.Account)
+		}
+	}
+
+	sessionKeys := make(map[string]struct{}, len(gs.SessionKeys))
+	for _, key := range gs.SessionKeys {
+		if _, err := sdk.AccAddressFromBech32(key.Account); err != nil {
+			return fmt.Errorf("invalid session key account %s: %w", key.Account, err)
+		}
+		id := fmt.Sprintf("%s/%X", key.Account, key.PublicKey)
+		if _, ok := sessionKeys[id]; ok {
+			return fmt.Errorf("duplicate session key %X for %s", key.PublicKey, key.Account)
+		}
+		sessionKeys[id] = struct{}{}
+
+		if _, ok := accounts[key.Account]; !ok {
+			return fmt.Errorf("session key of %s without a secondary key", key.Account)
+		}
+		if _, ok := tombstones[string(key.PublicKey)]; ok {
+			return fmt.Errorf("session key %X of %s is tombstoned", key.PublicKey, key.Account)
+		}
+		if err := key.Validate(); err != nil {
+			return fmt.Errorf("invalid session key %X for %s: %w", key.PublicKey, key.Account, err)
+		}
+	}
+
+	allowances := make(map[string]struct{}, len(gs.SessionKeyAllowances))
+	for _, allowance := range gs.SessionKeyAllowances {
+		id := fmt.Sprintf("%s/%X", allowance.Account, allowance.PublicKey)
+		if _, ok := sessionKeys[id]; !ok {
+			return fmt.Errorf("allowance of %X for %s without a session key", allowance.PublicKey, allowance.Account)
+		}
+		if _, ok := allowances[id]; ok {
+			return fmt.Errorf("duplicate allowance of %X for %s", allowance.PublicKey, allowance.Account)
+		}
+		allowances[id] = struct{}{}
+
+		if err := allowance.Remaining.Validate(); err != nil {
+			return fmt.Errorf("invalid allowance of %X for %s: %w", allowance.PublicKey, allowance.Account, err)
+		}
+	}
+	if len(allowances) != len(sessionKeys) {
+		return fmt.Errorf("%d session keys without an allowance", len(sessionKeys)-len(allowances))
+	}
+
+	return nil
 }
@@ -9,6 +9,7 @@ import (
 	math "math"
 	math_bits "math/bits"
 
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -29,6 +30,34 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// account_keys are the secondary keys registered for accounts.
+	AccountKeys []AccountKey `protobuf:"bytes,2,rep,name=account_keys,json=accountKeys,proto3" json:"account_keys"`
+	// validator_keys are the secondary keys of validators.
+	ValidatorKeys []ValidatorKey `protobuf:"bytes,3,rep,name=validator_keys,json=validatorKeys,proto3" json:"validator_keys"`
+	// key_history are the secondary keys accounts rotated away from.
+	KeyHistory []KeyHistoryRecord `protobuf:"bytes,4,rep,name=key_history,json=keyHistory,proto3" json:"key_history"`
+	// tombstones are the revoked secondary public keys.
+	Tombstones [][]byte `protobuf:"bytes,5,rep,name=tombstones,proto3" json:"tombstones,omitempty"`
+	// revoked_accounts are the accounts whose secondary key was revoked.
+	RevokedAccounts []string `protobuf:"bytes,6,rep,name=revoked_accounts,json=revokedAccounts,proto3" json:"revoked_accounts,omitempty"`
+	// locked_accounts are the revoked accounts that are locked down.
+	LockedAccounts []string `protobuf:"bytes,7,rep,name=locked_accounts,json=lockedAccounts,proto3" json:"locked_accounts,omitempty"`
+	// required_accounts are the accounts that require a secondary signature on
+	// every transaction.
+	RequiredAccounts []string `protobuf:"bytes,8,rep,name=required_accounts,json=requiredAccounts,proto3" json:"required_accounts,omitempty"`
+	// extension_signing_infos track the vote extensions of validators over the
+	// signed blocks window.
+	ExtensionSigningInfos []ExtensionSigningInfo `protobuf:"bytes,9,rep,name=extension_signing_infos,json=extensionSigningInfos,proto3" json:"extension_signing_infos"`
+	// extension_missed_blocks are the missed bitmaps of the tracked validators.
+	ExtensionMissedBlocks []ExtensionMissedBlocks `protobuf:"bytes,10,rep,name=extension_missed_blocks,json=extensionMissedBlocks,proto3" json:"extension_missed_blocks"`
+	// pending_recoveries are the account recoveries waiting for their
+	// timelock.
+	PendingRecoveries []PendingRecovery `protobuf:"bytes,11,rep,name=pending_recoveries,json=pendingRecoveries,proto3" json:"pending_recoveries"`
+	// session_keys are the registered session keys.
+	SessionKeys []SessionKey `protobuf:"bytes,12,rep,name=session_keys,json=sessionKeys,proto3" json:"session_keys"`
+	// session_key_allowances are the remaining allowances of session_keys,
+	// one for each of them.
+	SessionKeyAllowances []SessionKeyAllowance `protobuf:"bytes,13,rep,name=session_key_allowances,json=sessionKeyAllowances,proto3" json:"session_key_allowances"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,8 +100,157 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetAccountKeys() []AccountKey {
+	if m != nil {
+		return m.AccountKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetValidatorKeys() []ValidatorKey {
+	if m != nil {
+		return m.ValidatorKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKeyHistory() []KeyHistoryRecord {
+	if m != nil {
+		return m.KeyHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTombstones() [][]byte {
+	if m != nil {
+		return m.Tombstones
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevokedAccounts() []string {
+	if m != nil {
+		return m.RevokedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLockedAccounts() []string {
+	if m != nil {
+		return m.LockedAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRequiredAccounts() []string {
+	if m != nil {
+		return m.RequiredAccounts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExtensionSigningInfos() []ExtensionSigningInfo {
+	if m != nil {
+		return m.ExtensionSigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetExtensionMissedBlocks() []ExtensionMissedBlocks {
+	if m != nil {
+		return m.ExtensionMissedBlocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRecoveries() []PendingRecovery {
+	if m != nil {
+		return m.PendingRecoveries
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSessionKeys() []SessionKey {
+	if m != nil {
+		return m.SessionKeys
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSessionKeyAllowances() []SessionKeyAllowance {
+	if m != nil {
+		return m.SessionKeyAllowances
+	}
+	return nil
+}
+
+// KeyHistoryRecord is a key history entry of an account in genesis.
+type KeyHistoryRecord struct {
+	// address is the account that rotated its key.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// sequence is the rotation sequence of the entry.
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// entry is the replaced key.
+	Entry SecondaryKeyHistoryEntry `protobuf:"bytes,3,opt,name=entry,proto3" json:"entry"`
+}
+
+func (m *KeyHistoryRecord) Reset()         { *m = KeyHistoryRecord{} }
+func (m *KeyHistoryRecord) String() string { return proto.CompactTextString(m) }
+func (*KeyHistoryRecord) ProtoMessage()    {}
+func (*KeyHistoryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d1dd2ae947647683, []int{1}
+}
+func (m *KeyHistoryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KeyHistoryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KeyHistoryRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KeyHistoryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KeyHistoryRecord.Merge(m, src)
+}
+func (m *KeyHistoryRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *KeyHistoryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_KeyHistoryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KeyHistoryRecord proto.InternalMessageInfo
+
+func (m *KeyHistoryRecord) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *KeyHistoryRecord) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *KeyHistoryRecord) GetEntry() SecondaryKeyHistoryEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return SecondaryKeyHistoryEntry{}
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "example.secondarykeys.v1.GenesisState")
+	proto.RegisterType((*KeyHistoryRecord)(nil), "example.secondarykeys.v1.KeyHistoryRecord")
 }
 
 func init() {
@@ -80,20 +258,50 @@ func init() {
 }
 
 var fileDescriptor_d1dd2ae947647683 = []byte{
-	// 203 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4b, 0xad, 0x48, 0xcc,
-	0x2d, 0xc8, 0x49, 0xd5, 0x2f, 0x4e, 0x4d, 0xce, 0xcf, 0x4b, 0x49, 0x2c, 0xaa, 0xcc, 0x4e, 0xad,
-	0x2c, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x80, 0xaa, 0xd3, 0x43, 0x51, 0xa7, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98,
-	0x9b, 0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x8a, 0xa5, 0x54, 0x71, 0x1a, 0x5a, 0x90, 0x58, 0x94,
-	0x98, 0x0b, 0x35, 0x53, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2,
-	0x4a, 0xc1, 0x5c, 0x3c, 0xee, 0x10, 0xab, 0x83, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x9c, 0xb9, 0xd8,
-	0x20, 0xba, 0x24, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0x14, 0xf4, 0x70, 0x39, 0x45, 0x2f, 0x00,
-	0xac, 0xce, 0x89, 0xf3, 0xc4, 0x3d, 0x79, 0x86, 0x15, 0xcf, 0x37, 0x68, 0x31, 0x06, 0x41, 0xb5,
-	0x3a, 0x99, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x2c, 0xcc, 0xad,
-	0x15, 0x68, 0xae, 0x2d, 0xa9, 0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x3b, 0xca, 0x18, 0x10, 0x00,
-	0x00, 0xff, 0xff, 0xe6, 0x39, 0x64, 0xa3, 0x28, 0x01, 0x00, 0x00,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xc7, 0xe3, 0xa6, 0x7f, 0x37, 0xe9, 0xbf, 0x55, 0x7f, 0x3f, 0x96, 0x4a, 0x98, 0xa8, 0x82,
+	0x12, 0x0a, 0x4d, 0xd4, 0x70, 0xe0, 0x9c, 0x54, 0x15, 0xa0, 0x0a, 0x09, 0x39, 0x52, 0x85, 0xb8,
+	0x58, 0xae, 0x3d, 0x0d, 0x56, 0x92, 0xdd, 0x74, 0xc7, 0x0d, 0xf5, 0x5b, 0xf0, 0x18, 0x1c, 0x39,
+	0x20, 0xf1, 0x0a, 0x3d, 0x56, 0x9c, 0x7a, 0x42, 0xa8, 0x3d, 0xf0, 0x1a, 0x28, 0xbb, 0xb6, 0xd9,
+	0x86, 0x3a, 0xed, 0x25, 0xca, 0xce, 0x7e, 0xbf, 0x9f, 0xf1, 0x8c, 0x76, 0x86, 0x6c, 0xc2, 0xa9,
+	0xd7, 0x1f, 0xf4, 0xa0, 0x8e, 0xe0, 0x0b, 0x1e, 0x78, 0x32, 0xee, 0x42, 0x8c, 0xf5, 0xe1, 0x4e,
+	0xbd, 0x03, 0x1c, 0x30, 0xc4, 0xda, 0x40, 0x8a, 0x48, 0x50, 0x96, 0xe8, 0x6a, 0xd7, 0x74, 0xb5,
+	0xe1, 0xce, 0xfa, 0xaa, 0xd7, 0x0f, 0xb9, 0xa8, 0xab, 0x5f, 0x2d, 0x5e, 0xbf, 0xef, 0x0b, 0xec,
+	0x0b, 0x74, 0xd5, 0xa9, 0xae, 0x0f, 0xc9, 0xd5, 0xe3, 0xdc, 0x7c, 0x03, 0x4f, 0x7a, 0xfd, 0x54,
+	0xf6, 0x24, 0x57, 0x26, 0xc1, 0x17, 0x43, 0x90, 0x71, 0x22, 0x7c, 0x9e, 0x2b, 0xcc, 0x02, 0x6e,
+	0x17, 0x52, 0xf5, 0xd6, 0x04, 0x35, 0x62, 0x28, 0xb8, 0xa1, 0x7d, 0x96, 0xaf, 0x0d, 0x3b, 0x3c,
+	0xe4, 0x1d, 0x37, 0xe4, 0x47, 0x69, 0xc5, 0x6b, 0x1d, 0xd1, 0x11, 0xba, 0xdc, 0xd1, 0x3f, 0x1d,
+	0xdd, 0xb8, 0x98, 0x27, 0xe5, 0x57, 0xba, 0x8d, 0xed, 0xc8, 0x8b, 0x80, 0xee, 0x92, 0x59, 0x5d,
+	0x26, 0xb3, 0x2a, 0x56, 0xb5, 0xd4, 0xa8, 0xd4, 0xf2, 0xda, 0x5a, 0x7b, 0xa7, 0x74, 0xad, 0x85,
+	0xb3, 0x9f, 0x0f, 0x0b, 0x5f, 0x7e, 0x7f, 0xdd, 0xb2, 0x9c, 0xc4, 0x4a, 0x1d, 0x52, 0xf6, 0x7c,
+	0x5f, 0x9c, 0xf0, 0x68, 0xf4, 0xb5, 0xc8, 0xa6, 0x2a, 0xc5, 0x6a, 0xa9, 0xf1, 0x28, 0x1f, 0xd5,
+	0xd4, 0xea, 0x7d, 0x88, 0x4d, 0x5c, 0xc9, 0xcb, 0xc2, 0x48, 0xdf, 0x93, 0xa5, 0xa1, 0xd7, 0x0b,
+	0x03, 0x2f, 0x12, 0x52, 0x53, 0x8b, 0x8a, 0xba, 0x99, 0x4f, 0x3d, 0x48, 0xf5, 0x63, 0xdc, 0xc5,
+	0xa1, 0x71, 0x81, 0xf4, 0x80, 0x94, 0xba, 0x10, 0xbb, 0x1f, 0x43, 0x8c, 0x84, 0x8c, 0xd9, 0xb4,
+	0xc2, 0x6e, 0xe5, 0x63, 0xf7, 0x21, 0x7e, 0xad, 0xb5, 0x0e, 0xf8, 0x42, 0x06, 0x26, 0x9a, 0x74,
+	0xb3, 0x4b, 0x6a, 0x13, 0x12, 0x89, 0xfe, 0x21, 0x46, 0x82, 0x03, 0xb2, 0x99, 0x4a, 0xb1, 0x5a,
+	0x76, 0x8c, 0x08, 0xdd, 0x25, 0x2b, 0x12, 0x86, 0xa2, 0x0b, 0x81, 0x9b, 0x14, 0x8a, 0x6c, 0xb6,
+	0x52, 0xac, 0x2e, 0xb4, 0xd8, 0x8f, 0x6f, 0xdb, 0x6b, 0xc9, 0xa3, 0x6c, 0x06, 0x81, 0x04, 0xc4,
+	0x76, 0x24, 0x43, 0xde, 0x71, 0x96, 0x13, 0x47, 0xd2, 0x30, 0xa4, 0x4d, 0xb2, 0xdc, 0x13, 0xfe,
+	0x35, 0xc6, 0xdc, 0x2d, 0x8c, 0x25, 0x6d, 0xc8, 0x10, 0x7b, 0x64, 0x55, 0xc2, 0xf1, 0x49, 0x28,
+	0x4d, 0xc8, 0xfc, 0x2d, 0x90, 0x95, 0xd4, 0x92, 0x61, 0x8e, 0xc9, 0x3d, 0x38, 0x8d, 0x80, 0xab,
+	0x47, 0x6a, 0x3e, 0x40, 0x64, 0x0b, 0xaa, 0xa5, 0xb5, 0xfc, 0x96, 0xee, 0xa5, 0xc6, 0xb6, 0xf6,
+	0xbd, 0xe1, 0x47, 0xc2, 0x6c, 0xeb, 0x7f, 0x70, 0x83, 0x00, 0xa9, 0x34, 0x53, 0xf6, 0x43, 0x44,
+	0x08, 0xdc, 0xc3, 0x51, 0x75, 0xc8, 0x88, 0x4a, 0x59, 0xbf, 0x43, 0xca, 0xb7, 0xca, 0xd7, 0x52,
+	0xb6, 0x9b, 0x73, 0x9a, 0x0a, 0xea, 0x13, 0x3a, 0x00, 0x1e, 0x8c, 0x8a, 0x4b, 0x06, 0x3d, 0x04,
+	0x64, 0x25, 0x95, 0xee, 0xe9, 0x84, 0x61, 0xd1, 0x1e, 0x27, 0xd9, 0x0d, 0x66, 0xa2, 0xd5, 0xc1,
+	0xb5, 0xbb, 0x10, 0xd4, 0x00, 0x19, 0xe3, 0x8e, 0xac, 0x7c, 0xdb, 0x00, 0xb5, 0xb5, 0x7a, 0x7c,
+	0x80, 0x30, 0x0b, 0x23, 0xe5, 0xe4, 0x7f, 0x83, 0xe9, 0x7a, 0xbd, 0x9e, 0xf8, 0xe4, 0x71, 0x1f,
+	0x90, 0x2d, 0x2a, 0xfa, 0xf6, 0x5d, 0xe8, 0xcd, 0xd4, 0x65, 0xa6, 0x59, 0xc3, 0x7f, 0xef, 0x71,
+	0xe3, 0xbb, 0x45, 0x56, 0xc6, 0x47, 0x85, 0x36, 0xc8, 0x9c, 0xa7, 0xdf, 0x91, 0xda, 0x2f, 0x93,
+	0x5e, 0x58, 0x2a, 0xa4, 0xeb, 0x64, 0x1e, 0xe1, 0xf8, 0x04, 0xb8, 0x0f, 0x6c, 0xaa, 0x62, 0x55,
+	0xa7, 0x9d, 0xec, 0x4c, 0xdb, 0x64, 0x06, 0x78, 0x24, 0x63, 0x56, 0x54, 0xdb, 0xaa, 0x31, 0xa9,
+	0x86, 0x24, 0xf0, 0xf7, 0x9b, 0xf6, 0x46, 0x4e, 0xb3, 0x10, 0xcd, 0x6a, 0xbd, 0x3c, 0xbb, 0xb4,
+	0xad, 0xf3, 0x4b, 0xdb, 0xfa, 0x75, 0x69, 0x5b, 0x9f, 0xaf, 0xec, 0xc2, 0xf9, 0x95, 0x5d, 0xb8,
+	0xb8, 0xb2, 0x0b, 0x1f, 0x1e, 0xa4, 0x1b, 0xf7, 0x74, 0x6c, 0xe7, 0x46, 0xf1, 0x00, 0xf0, 0x70,
+	0x56, 0x2d, 0xd5, 0x17, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x52, 0x0b, 0x0b, 0x11, 0xb3, 0x06,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -116,6 +324,154 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SessionKeyAllowances) > 0 {
+		for iNdEx := len(m.SessionKeyAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionKeyAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.SessionKeys) > 0 {
+		for iNdEx := len(m.SessionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.PendingRecoveries) > 0 {
+		for iNdEx := len(m.PendingRecoveries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRecoveries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ExtensionMissedBlocks) > 0 {
+		for iNdEx := len(m.ExtensionMissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtensionMissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ExtensionSigningInfos) > 0 {
+		for iNdEx := len(m.ExtensionSigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ExtensionSigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.RequiredAccounts) > 0 {
+		for iNdEx := len(m.RequiredAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAccounts[iNdEx])
+			copy(dAtA[i:], m.RequiredAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RequiredAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.LockedAccounts) > 0 {
+		for iNdEx := len(m.LockedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LockedAccounts[iNdEx])
+			copy(dAtA[i:], m.LockedAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.LockedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RevokedAccounts) > 0 {
+		for iNdEx := len(m.RevokedAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedAccounts[iNdEx])
+			copy(dAtA[i:], m.RevokedAccounts[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RevokedAccounts[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Tombstones) > 0 {
+		for iNdEx := len(m.Tombstones) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tombstones[iNdEx])
+			copy(dAtA[i:], m.Tombstones[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.Tombstones[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.KeyHistory) > 0 {
+		for iNdEx := len(m.KeyHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ValidatorKeys) > 0 {
+		for iNdEx := len(m.ValidatorKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ValidatorKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AccountKeys) > 0 {
+		for iNdEx := len(m.AccountKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -129,6 +485,51 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KeyHistoryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KeyHistoryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KeyHistoryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Entry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -148,6 +549,96 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AccountKeys) > 0 {
+		for _, e := range m.AccountKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ValidatorKeys) > 0 {
+		for _, e := range m.ValidatorKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KeyHistory) > 0 {
+		for _, e := range m.KeyHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Tombstones) > 0 {
+		for _, b := range m.Tombstones {
+			l = len(b)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevokedAccounts) > 0 {
+		for _, s := range m.RevokedAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LockedAccounts) > 0 {
+		for _, s := range m.LockedAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RequiredAccounts) > 0 {
+		for _, s := range m.RequiredAccounts {
+			l = len(s)
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExtensionSigningInfos) > 0 {
+		for _, e := range m.ExtensionSigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ExtensionMissedBlocks) > 0 {
+		for _, e := range m.ExtensionMissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRecoveries) > 0 {
+		for _, e := range m.PendingRecoveries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SessionKeys) > 0 {
+		for _, e := range m.SessionKeys {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SessionKeyAllowances) > 0 {
+		for _, e := range m.SessionKeyAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *KeyHistoryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	l = m.Entry.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -219,6 +710,540 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountKeys = append(m.AccountKeys, AccountKey{})
+			if err := m.AccountKeys[len(m.AccountKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorKeys = append(m.ValidatorKeys, ValidatorKey{})
+			if err := m.ValidatorKeys[len(m.ValidatorKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyHistory = append(m.KeyHistory, KeyHistoryRecord{})
+			if err := m.KeyHistory[len(m.KeyHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tombstones", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tombstones = append(m.Tombstones, make([]byte, postIndex-iNdEx))
+			copy(m.Tombstones[len(m.Tombstones)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedAccounts = append(m.RevokedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockedAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LockedAccounts = append(m.LockedAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAccounts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredAccounts = append(m.RequiredAccounts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionSigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionSigningInfos = append(m.ExtensionSigningInfos, ExtensionSigningInfo{})
+			if err := m.ExtensionSigningInfos[len(m.ExtensionSigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionMissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionMissedBlocks = append(m.ExtensionMissedBlocks, ExtensionMissedBlocks{})
+			if err := m.ExtensionMissedBlocks[len(m.ExtensionMissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRecoveries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRecoveries = append(m.PendingRecoveries, PendingRecovery{})
+			if err := m.PendingRecoveries[len(m.PendingRecoveries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeys = append(m.SessionKeys, SessionKey{})
+			if err := m.SessionKeys[len(m.SessionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeyAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeyAllowances = append(m.SessionKeyAllowances, SessionKeyAllowance{})
+			if err := m.SessionKeyAllowances[len(m.SessionKeyAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KeyHistoryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KeyHistoryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KeyHistoryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Entry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,14 +1,46 @@
 package types_test
 
 import (
+	stded25519 "crypto/ed25519"
 	"testing"
+	"time"
 
+	"example/testutil/sample"
 	"example/x/secondarykeys/types"
 
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesisState_Validate(t *testing.T) {
+	priv, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	pubKey := crypto.FromECDSAPub(&priv.PublicKey)
+	secp256k1 := types.KeyType_KEY_TYPE_SECP256K1
+	account := sample.AccAddress()
+	validator := sdk.ConsAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	blsPriv, err := types.GenBLS12381PrivKey()
+	require.NoError(t, err)
+	blsPubKey, err := types.BLS12381PubKey(blsPriv)
+	require.NoError(t, err)
+	edPubKey, _, err := stded25519.GenerateKey(nil)
+	require.NoError(t, err)
+	recoveryPubKey, err := codectypes.NewAnyWithValue(ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	sessionKey := types.SessionKey{
+		Account:      account,
+		KeyType:      types.KeyType_KEY_TYPE_ED25519,
+		PublicKey:    edPubKey,
+		MsgTypeUrls:  []string{"/cosmos.bank.v1beta1.MsgSend"},
+		SpendLimit:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		ExpiryHeight: 100,
+	}
+	allowance := types.SessionKeyAllowance{Account: account, PublicKey: edPubKey, Remaining: sdk.NewCoins(sdk.NewInt64Coin("stake", 60))}
+
 	tests := []struct {
 		desc     string
 		genState *types.GenesisState
@@ -20,9 +52,387 @@ func TestGenesisState_Validate(t *testing.T) {
 			valid:    true,
 		},
 		{
-			desc:     "valid genesis state",
-			genState: &types.GenesisState{},
-			valid:    true,
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				AccountKeys:     []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				ValidatorKeys:   []types.ValidatorKey{{ConsensusAddress: validator, PublicKey: pubKey}},
+				RevokedAccounts: []string{sample.AccAddress()},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate account key",
+			genState: &types.GenesisState{
+				Params:      types.Params{AllowSharedSecondaryKeys: true},
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}, {Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "shared account key",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}, {Address: sample.AccAddress(), PublicKey: pubKey, KeyType: secp256k1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "shared account key allowed by params",
+			genState: &types.GenesisState{
+				Params:      types.Params{AllowSharedSecondaryKeys: true},
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}, {Address: sample.AccAddress(), PublicKey: pubKey, KeyType: secp256k1}},
+			},
+			valid: true,
+		},
+		{
+			desc: "malformed account key",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: pubKey[:33], KeyType: secp256k1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "ed25519 account key",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: edPubKey, KeyType: types.KeyType_KEY_TYPE_ED25519}},
+			},
+			valid: true,
+		},
+		{
+			desc: "account key without a key type",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: pubKey}},
+			},
+			valid: false,
+		},
+		{
+			desc: "BLS12-381 account key",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: blsPubKey, KeyType: types.KeyType_KEY_TYPE_BLS12_381}},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid account address",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: "invalid", PublicKey: pubKey, KeyType: secp256k1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "tombstoned account key",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				Tombstones:  [][]byte{pubKey},
+			},
+			valid: false,
+		},
+		{
+			desc: "account key set",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: account, KeySet: &types.SecondaryKeySet{
+					Members:   []types.SecondaryKeySetMember{{KeyType: secp256k1, PublicKey: pubKey, Weight: 1}, {KeyType: types.KeyType_KEY_TYPE_ED25519, PublicKey: edPubKey, Weight: 1}},
+					Threshold: 2,
+				}}},
+			},
+			valid: true,
+		},
+		{
+			desc: "account key set with a single key",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1, KeySet: &types.SecondaryKeySet{
+					Members:   []types.SecondaryKeySetMember{{KeyType: types.KeyType_KEY_TYPE_ED25519, PublicKey: edPubKey, Weight: 1}},
+					Threshold: 1,
+				}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "account key set threshold above its weight",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: account, KeySet: &types.SecondaryKeySet{
+					Members:   []types.SecondaryKeySetMember{{KeyType: secp256k1, PublicKey: pubKey, Weight: 1}, {KeyType: types.KeyType_KEY_TYPE_ED25519, PublicKey: edPubKey, Weight: 1}},
+					Threshold: 3,
+				}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "tombstoned key in an account key set",
+			genState: &types.GenesisState{
+				AccountKeys: []types.AccountKey{{Address: account, KeySet: &types.SecondaryKeySet{
+					Members:   []types.SecondaryKeySetMember{{KeyType: secp256k1, PublicKey: pubKey, Weight: 1}, {KeyType: types.KeyType_KEY_TYPE_ED25519, PublicKey: edPubKey, Weight: 1}},
+					Threshold: 1,
+				}}},
+				Tombstones: [][]byte{edPubKey},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending recovery",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				AccountKeys:       []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				PendingRecoveries: []types.PendingRecovery{{Account: account, NewPublicKey: recoveryPubKey, ExecuteTime: time.Now()}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate pending recovery",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				PendingRecoveries: []types.PendingRecovery{
+					{Account: account, NewPublicKey: recoveryPubKey, ExecuteTime: time.Now()},
+					{Account: account, NewPublicKey: recoveryPubKey, ExecuteTime: time.Now()},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending recovery without a secondary key",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				PendingRecoveries: []types.PendingRecovery{{Account: account, NewPublicKey: recoveryPubKey, ExecuteTime: time.Now()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending recovery without a new public key",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				AccountKeys:       []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				PendingRecoveries: []types.PendingRecovery{{Account: account, ExecuteTime: time.Now()}},
+			},
+			valid: false,
+		},
+		{
+			desc: "session key",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				AccountKeys:          []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				SessionKeys:          []types.SessionKey{sessionKey},
+				SessionKeyAllowances: []types.SessionKeyAllowance{allowance},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate session key",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				AccountKeys:          []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				SessionKeys:          []types.SessionKey{sessionKey, sessionKey},
+				SessionKeyAllowances: []types.SessionKeyAllowance{allowance},
+			},
+			valid: false,
+		},
+		{
+			desc: "session key without a secondary key",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				SessionKeys:          []types.SessionKey{sessionKey},
+				SessionKeyAllowances: []types.SessionKeyAllowance{allowance},
+			},
+			valid: false,
+		},
+		{
+			desc: "tombstoned session key",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				AccountKeys:          []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				SessionKeys:          []types.SessionKey{sessionKey},
+				SessionKeyAllowances: []types.SessionKeyAllowance{allowance},
+				Tombstones:           [][]byte{edPubKey},
+			},
+			valid: false,
+		},
+		{
+			desc: "session key without an allowance",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				AccountKeys: []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				SessionKeys: []types.SessionKey{sessionKey},
+			},
+			valid: false,
+		},
+		{
+			desc: "allowance without a session key",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				AccountKeys:          []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				SessionKeyAllowances: []types.SessionKeyAllowance{allowance},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative recovery delay",
+			genState: &types.GenesisState{
+				Params: types.Params{RecoveryDelay: -time.Hour},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative attestation retention window",
+			genState: &types.GenesisState{
+				Params: types.Params{AttestationRetentionWindow: -1},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate validator key",
+			genState: &types.GenesisState{
+				ValidatorKeys: []types.ValidatorKey{{ConsensusAddress: validator, PublicKey: pubKey}, {ConsensusAddress: validator, PublicKey: pubKey}},
+			},
+			valid: false,
+		},
+		{
+			desc: "malformed validator key",
+			genState: &types.GenesisState{
+				ValidatorKeys: []types.ValidatorKey{{ConsensusAddress: validator, PublicKey: []byte("key")}},
+			},
+			valid: false,
+		},
+		{
+			desc: "BLS12-381 validator key",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				ValidatorKeys: []types.ValidatorKey{{ConsensusAddress: validator, PublicKey: blsPubKey}},
+			},
+			valid: true,
+		},
+		{
+			desc: "BLS12-381 key in the key history",
+			genState: &types.GenesisState{
+				KeyHistory: []types.KeyHistoryRecord{
+					{Address: account, Entry: types.SecondaryKeyHistoryEntry{KeyType: types.KeyType_KEY_TYPE_BLS12_381, PublicKey: blsPubKey}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate key history entry",
+			genState: &types.GenesisState{
+				KeyHistory: []types.KeyHistoryRecord{
+					{Address: account, Entry: types.SecondaryKeyHistoryEntry{KeyType: types.KeyType_KEY_TYPE_SECP256K1, PublicKey: pubKey}},
+					{Address: account, Entry: types.SecondaryKeyHistoryEntry{KeyType: types.KeyType_KEY_TYPE_SECP256K1, PublicKey: pubKey}},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "revoked account with a key",
+			genState: &types.GenesisState{
+				AccountKeys:     []types.AccountKey{{Address: account, PublicKey: pubKey, KeyType: secp256k1}},
+				RevokedAccounts: []string{account},
+			},
+			valid: false,
+		},
+		{
+			desc: "locked account that is not revoked",
+			genState: &types.GenesisState{
+				LockedAccounts: []string{account},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate required account",
+			genState: &types.GenesisState{
+				RequiredAccounts: []string{account, account},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid required msg type url",
+			genState: &types.GenesisState{
+				Params: types.Params{RequiredMsgTypeUrls: []string{""}},
+			},
+			valid: false,
+		},
+		{
+			desc: "empty webauthn relying party ID",
+			genState: &types.GenesisState{
+				Params: types.Params{WebauthnRpIds: []string{""}},
+			},
+			valid: false,
+		},
+		{
+			desc: "negative signed blocks window",
+			genState: &types.GenesisState{
+				Params: types.Params{SignedBlocksWindow: -1},
+			},
+			valid: false,
+		},
+		{
+			desc: "signed blocks window without fractions",
+			genState: &types.GenesisState{
+				Params: types.Params{SignedBlocksWindow: 100},
+			},
+			valid: false,
+		},
+		{
+			desc: "min signed per window above one",
+			genState: &types.GenesisState{
+				Params: types.NewParams(100, math.LegacyNewDec(2), time.Minute, math.LegacyZeroDec()),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative slash fraction",
+			genState: &types.GenesisState{
+				Params: types.NewParams(100, math.LegacyOneDec(), time.Minute, math.LegacyNewDec(-1)),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative downtime jail duration",
+			genState: &types.GenesisState{
+				Params: types.NewParams(100, math.LegacyOneDec(), -time.Minute, math.LegacyZeroDec()),
+			},
+			valid: false,
+		},
+		{
+			desc: "valid extension signing info",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				ExtensionSigningInfos: []types.ExtensionSigningInfo{{ConsensusAddress: validator, IndexOffset: 3, MissedBlocksCounter: 1}},
+				ExtensionMissedBlocks: []types.ExtensionMissedBlocks{{ConsensusAddress: validator, MissedIndexes: []int64{2}}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate extension signing info",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ExtensionSigningInfos: []types.ExtensionSigningInfo{
+					{ConsensusAddress: validator},
+					{ConsensusAddress: validator},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "missed blocks counter above index offset",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				ExtensionSigningInfos: []types.ExtensionSigningInfo{{ConsensusAddress: validator, IndexOffset: 1, MissedBlocksCounter: 2}},
+			},
+			valid: false,
+		},
+		{
+			desc: "missed extensions without signing info",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				ExtensionMissedBlocks: []types.ExtensionMissedBlocks{{ConsensusAddress: validator, MissedIndexes: []int64{2}}},
+			},
+			valid: false,
+		},
+		{
+			desc: "missed extension index outside the window",
+			genState: &types.GenesisState{
+				Params:                types.DefaultParams(),
+				ExtensionSigningInfos: []types.ExtensionSigningInfo{{ConsensusAddress: validator}},
+				ExtensionMissedBlocks: []types.ExtensionMissedBlocks{{ConsensusAddress: validator, MissedIndexes: []int64{types.DefaultSignedBlocksWindow}}},
+			},
+			valid: false,
 		},
 	}
 	for _, tc := range tests {
@@ -0,0 +1,144 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// addMemberDomain and removeMemberDomain separate key set change digests from
+// the other digests signed by secondary keys.
+const (
+	addMemberDomain    = "add_member"
+	removeMemberDomain = "remove_member"
+)
+
+// NewSingleKeySet returns the key set of the single key pubKey, with weight
+// and threshold 1, as registered by MsgRegisterSecondaryKey.
+func NewSingleKeySet(pubKey SecondaryPubKey) SecondaryKeySet {
+	return SecondaryKeySet{
+		Members:   []SecondaryKeySetMember{{KeyType: pubKey.KeyType, PublicKey: pubKey.Key, Weight: 1}},
+		Threshold: 1,
+	}
+}
+
+// PubKey returns the public key of the member.
+func (m SecondaryKeySetMember) PubKey() SecondaryPubKey {
+	return NewSecondaryPubKey(m.KeyType, m.PublicKey)
+}
+
+// Member returns the member of s with the given public key.
+func (s SecondaryKeySet) Member(pubKey []byte) (SecondaryKeySetMember, bool) {
+	for _, member := range s.Members {
+		if bytes.Equal(member.PublicKey, pubKey) {
+			return member, true
+		}
+	}
+	return SecondaryKeySetMember{}, false
+}
+
+// SingleKey returns the key of s if it is its only member.
+func (s SecondaryKeySet) SingleKey() (SecondaryPubKey, bool) {
+	if len(s.Members) != 1 {
+		return SecondaryPubKey{}, false
+	}
+	return s.Members[0].PubKey(), true
+}
+
+// TotalWeight returns the sum of the weights of the members of s.
+func (s SecondaryKeySet) TotalWeight() uint64 {
+	var total uint64
+	for _, member := range s.Members {
+		total += uint64(member.Weight)
+	}
+	return total
+}
+
+// Validate checks that s has at least one member, that its members are
+// distinct account keys of a positive weight and that they can reach its
+// positive threshold.
+func (s SecondaryKeySet) Validate() error {
+	if len(s.Members) == 0 {
+		return errorsmod.Wrap(ErrInvalidKeySet, "no members")
+	}
+	seen := make(map[string]struct{}, len(s.Members))
+	for _, member := range s.Members {
+		if err := ValidateAccountPublicKey(member.KeyType, member.PublicKey); err != nil {
+			return err
+		}
+		if _, ok := seen[string(member.PublicKey)]; ok {
+			return errorsmod.Wrapf(ErrInvalidKeySet, "duplicate member %X", member.PublicKey)
+		}
+		seen[string(member.PublicKey)] = struct{}{}
+		if member.Weight == 0 {
+			return errorsmod.Wrapf(ErrInvalidKeySet, "member %X has no weight", member.PublicKey)
+		}
+	}
+	if s.Threshold == 0 {
+		return errorsmod.Wrap(ErrInvalidKeySet, "threshold must be positive")
+	}
+	if total := s.TotalWeight(); uint64(s.Threshold) > total {
+		return errorsmod.Wrapf(ErrInvalidKeySet, "threshold %d exceeds the total weight %d", s.Threshold, total)
+	}
+	return nil
+}
+
+// NewAccountKey returns the AccountKey of the key set of address. A single key
+// of weight and threshold 1 is given by its public key and type alone.
+func NewAccountKey(address string, keySet SecondaryKeySet) AccountKey {
+	if pubKey, ok := keySet.SingleKey(); ok && keySet.Members[0].Weight == 1 && keySet.Threshold == 1 {
+		return AccountKey{Address: address, PublicKey: pubKey.Key, KeyType: pubKey.KeyType}
+	}
+	return AccountKey{Address: address, KeySet: &keySet}
+}
+
+// SecondaryKeySet returns the key set of the account.
+func (k AccountKey) SecondaryKeySet() SecondaryKeySet {
+	if k.KeySet != nil {
+		return *k.KeySet
+	}
+	return NewSingleKeySet(NewSecondaryPubKey(k.KeyType, k.PublicKey))
+}
+
+// Validate checks that k holds either a single key or a valid key set.
+func (k AccountKey) Validate() error {
+	if k.KeySet == nil {
+		return ValidateAccountPublicKey(k.KeyType, k.PublicKey)
+	}
+	if len(k.PublicKey) != 0 || k.KeyType != KeyType_KEY_TYPE_UNSPECIFIED {
+		return errorsmod.Wrap(ErrInvalidKeySet, "both a public key and a key set")
+	}
+	return k.KeySet.Validate()
+}
+
+// AddKeySetMemberBytes returns the digest the current members of sender's key
+// set and the new key sign to add the new key. Like a rotation it is signed
+// over the rotation sequence, which removing a member increments.
+func AddKeySetMemberBytes(sender sdk.AccAddress, sequence uint64, keyType KeyType, pubKey []byte, weight, threshold uint32) []byte {
+	msg := make([]byte, 0, len(ModuleName)+len(addMemberDomain)+len(sender)+8+4+len(pubKey)+8)
+	msg = append(msg, ModuleName...)
+	msg = append(msg, addMemberDomain...)
+	msg = append(msg, sender...)
+	msg = binary.BigEndian.AppendUint64(msg, sequence)
+	msg = binary.BigEndian.AppendUint32(msg, uint32(keyType))
+	msg = append(msg, pubKey...)
+	msg = binary.BigEndian.AppendUint32(msg, weight)
+	msg = binary.BigEndian.AppendUint32(msg, threshold)
+	return crypto.Keccak256(msg)
+}
+
+// RemoveKeySetMemberBytes returns the digest the current members of sender's
+// key set sign to remove pubKey from it.
+func RemoveKeySetMemberBytes(sender sdk.AccAddress, sequence uint64, pubKey []byte, threshold uint32) []byte {
+	msg := make([]byte, 0, len(ModuleName)+len(removeMemberDomain)+len(sender)+8+len(pubKey)+4)
+	msg = append(msg, ModuleName...)
+	msg = append(msg, removeMemberDomain...)
+	msg = append(msg, sender...)
+	msg = binary.BigEndian.AppendUint64(msg, sequence)
+	msg = append(msg, pubKey...)
+	msg = binary.BigEndian.AppendUint32(msg, threshold)
+	return crypto.Keccak256(msg)
+}
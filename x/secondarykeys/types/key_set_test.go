@@ -0,0 +1,110 @@
+package types_test
+
+import (
+	"crypto/ed25519"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"example/testutil/sample"
+	"example/x/secondarykeys/types"
+)
+
+func TestSecondaryKeySet_Validate(t *testing.T) {
+	a, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	b, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	ed25519Type := types.KeyType_KEY_TYPE_ED25519
+
+	tests := []struct {
+		desc   string
+		keySet types.SecondaryKeySet
+		errMsg string
+	}{
+		{
+			desc:   "single key",
+			keySet: types.NewSingleKeySet(types.NewSecondaryPubKey(ed25519Type, a)),
+		},
+		{
+			desc: "weighted threshold",
+			keySet: types.SecondaryKeySet{
+				Members:   []types.SecondaryKeySetMember{{KeyType: ed25519Type, PublicKey: a, Weight: 2}, {KeyType: ed25519Type, PublicKey: b, Weight: 1}},
+				Threshold: 3,
+			},
+		},
+		{
+			desc:   "no members",
+			keySet: types.SecondaryKeySet{Threshold: 1},
+			errMsg: "no members",
+		},
+		{
+			desc: "duplicate member",
+			keySet: types.SecondaryKeySet{
+				Members:   []types.SecondaryKeySetMember{{KeyType: ed25519Type, PublicKey: a, Weight: 1}, {KeyType: ed25519Type, PublicKey: a, Weight: 1}},
+				Threshold: 1,
+			},
+			errMsg: "duplicate member",
+		},
+		{
+			desc: "member without weight",
+			keySet: types.SecondaryKeySet{
+				Members:   []types.SecondaryKeySetMember{{KeyType: ed25519Type, PublicKey: a, Weight: 1}, {KeyType: ed25519Type, PublicKey: b}},
+				Threshold: 1,
+			},
+			errMsg: "has no weight",
+		},
+		{
+			desc: "zero threshold",
+			keySet: types.SecondaryKeySet{
+				Members: []types.SecondaryKeySetMember{{KeyType: ed25519Type, PublicKey: a, Weight: 1}},
+			},
+			errMsg: "threshold must be positive",
+		},
+		{
+			desc: "threshold above the total weight",
+			keySet: types.SecondaryKeySet{
+				Members:   []types.SecondaryKeySetMember{{KeyType: ed25519Type, PublicKey: a, Weight: 2}, {KeyType: ed25519Type, PublicKey: b, Weight: 1}},
+				Threshold: 4,
+			},
+			errMsg: "threshold 4 exceeds the total weight 3",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.keySet.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrInvalidKeySet)
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestNewAccountKey(t *testing.T) {
+	a, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	b, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	address := sample.AccAddress()
+	ed25519Type := types.KeyType_KEY_TYPE_ED25519
+
+	// a single key keeps the form accounts had before key sets
+	single := types.NewSingleKeySet(types.NewSecondaryPubKey(ed25519Type, a))
+	key := types.NewAccountKey(address, single)
+	require.Equal(t, types.AccountKey{Address: address, PublicKey: a, KeyType: ed25519Type}, key)
+	require.Equal(t, single, key.SecondaryKeySet())
+
+	// a single key of another weight or threshold is stored as a key set
+	for _, keySet := range []types.SecondaryKeySet{
+		{Members: []types.SecondaryKeySetMember{{KeyType: ed25519Type, PublicKey: a, Weight: 2}}, Threshold: 1},
+		{Members: []types.SecondaryKeySetMember{{KeyType: ed25519Type, PublicKey: a, Weight: 1}, {KeyType: ed25519Type, PublicKey: b, Weight: 1}}, Threshold: 2},
+	} {
+		key := types.NewAccountKey(address, keySet)
+		require.Empty(t, key.PublicKey)
+		require.Equal(t, keySet, key.SecondaryKeySet())
+		require.NoError(t, key.Validate())
+	}
+}
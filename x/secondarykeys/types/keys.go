@@ -13,7 +13,42 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// AnteHandlerPrefix marks a memo that carries a secondary signature.
+	AnteHandlerPrefix = "SECONDARY"
 )
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_secondarykeys")
+
+// LastBlockHashKey is the prefix of the hash of the last block, which the
+// vote extensions included in the next block are signed over.
+var LastBlockHashKey = collections.NewPrefix(8)
+
+// AttestationsKey is the prefix of the attestations, keyed by the height of
+// the attested block.
+var AttestationsKey = collections.NewPrefix(9)
+
+// ExtensionSigningInfosKey is the prefix of the vote extension signing infos,
+// keyed by consensus address.
+var ExtensionSigningInfosKey = collections.NewPrefix(10)
+
+// ExtensionMissedBlocksKey is the prefix of the missed vote extension bitmaps,
+// keyed by consensus address and index in the signed blocks window.
+var ExtensionMissedBlocksKey = collections.NewPrefix(11)
+
+// PendingRecoveriesKey is the prefix of the pending account recoveries, keyed
+// by the recovered account.
+var PendingRecoveriesKey = collections.NewPrefix(12)
+
+// RecoveryQueueKey is the prefix of the pending account recoveries keyed by
+// their execute time, for the end blocker to find the expired timelocks.
+var RecoveryQueueKey = collections.NewPrefix(13)
+
+// SessionKeysKey is the prefix of the session keys, keyed by account and
+// session public key.
+var SessionKeysKey = collections.NewPrefix(14)
+
+// SessionKeyAllowancesKey is the prefix of the remaining allowances of the
+// session keys, keyed like the session keys.
+var SessionKeyAllowancesKey = collections.NewPrefix(15)
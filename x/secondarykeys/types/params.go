@@ -1,17 +1,169 @@
 package types
 
+import (
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// Default parameter values. The vote extension tracking defaults to the
+// downtime parameters of x/slashing, without slashing. An account recovery
+// leaves the current primary key a week to cancel it, and attestations are
+// kept for about a week of 6 second blocks.
+const (
+	DefaultSignedBlocksWindow         = int64(100)
+	DefaultDowntimeJailDuration       = 10 * time.Minute
+	DefaultRecoveryDelay              = 7 * 24 * time.Hour
+	DefaultAttestationRetentionWindow = int64(100000)
+)
+
+var (
+	DefaultMinSignedPerWindow            = math.LegacyNewDecWithPrec(5, 1)
+	DefaultSlashFractionMissingExtension = math.LegacyZeroDec()
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	signedBlocksWindow int64,
+	minSignedPerWindow math.LegacyDec,
+	downtimeJailDuration time.Duration,
+	slashFractionMissingExtension math.LegacyDec,
+) Params {
+	return Params{
+		SignedBlocksWindow:            signedBlocksWindow,
+		MinSignedPerWindow:            minSignedPerWindow,
+		DowntimeJailDuration:          downtimeJailDuration,
+		SlashFractionMissingExtension: slashFractionMissingExtension,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	params := NewParams(
+		DefaultSignedBlocksWindow,
+		DefaultMinSignedPerWindow,
+		DefaultDowntimeJailDuration,
+		DefaultSlashFractionMissingExtension,
+	)
+	params.RecoveryDelay = DefaultRecoveryDelay
+	params.AttestationRetentionWindow = DefaultAttestationRetentionWindow
+	return params
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if err := p.validateExtensionTracking(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(p.RequiredMsgTypeUrls))
+	for _, typeURL := range p.RequiredMsgTypeUrls {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid msg type url %q", typeURL)
+		}
+		if _, ok := seen[typeURL]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate msg type url %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	seen = make(map[string]struct{}, len(p.WebauthnRpIds))
+	for _, rpID := range p.WebauthnRpIds {
+		if !validRPID(rpID) {
+			return errorsmod.Wrapf(ErrInvalidParams, "invalid webauthn relying party ID %q", rpID)
+		}
+		if _, ok := seen[rpID]; ok {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicate webauthn relying party ID %s", rpID)
+		}
+		seen[rpID] = struct{}{}
+	}
+
+	if p.RecoveryDelay < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "recovery delay must not be negative: %s", p.RecoveryDelay)
+	}
+	if p.AttestationRetentionWindow < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "attestation retention window must not be negative: %d", p.AttestationRetentionWindow)
+	}
+
+	return nil
+}
 
+// validRPID reports whether rpID is a lower case domain name, as browsers
+// use for relying party IDs, without a scheme, port or path.
+func validRPID(rpID string) bool {
+	if rpID == "" || len(rpID) > 253 {
+		return false
+	}
+	for _, label := range strings.Split(rpID, ".") {
+		if label == "" || len(label) > 63 || label[0] == '-' || label[len(label)-1] == '-' {
+			return false
+		}
+		for _, c := range label {
+			if (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '-' {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// validateExtensionTracking validates the vote extension tracking params. The
+// fractions may be left unset while the tracking is disabled.
+func (p Params) validateExtensionTracking() error {
+	if p.SignedBlocksWindow < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "signed blocks window must not be negative: %d", p.SignedBlocksWindow)
+	}
+	if p.DowntimeJailDuration < 0 {
+		return errorsmod.Wrapf(ErrInvalidParams, "downtime jail duration must not be negative: %s", p.DowntimeJailDuration)
+	}
+	for _, param := range []struct {
+		name     string
+		fraction math.LegacyDec
+	}{
+		{"min signed per window", p.MinSignedPerWindow},
+		{"slash fraction missing extension", p.SlashFractionMissingExtension},
+	} {
+		name, fraction := param.name, param.fraction
+		if fraction.IsNil() {
+			if p.SignedBlocksWindow > 0 {
+				return errorsmod.Wrapf(ErrInvalidParams, "%s must be set", name)
+			}
+			continue
+		}
+		if fraction.IsNegative() || fraction.GT(math.LegacyOneDec()) {
+			return errorsmod.Wrapf(ErrInvalidParams, "%s must be between 0 and 1: %s", name, fraction)
+		}
+	}
 	return nil
 }
+
+// ExtensionTrackingEnabled reports whether the vote extensions of validators
+// are tracked over a signed blocks window.
+func (p Params) ExtensionTrackingEnabled() bool {
+	return p.SignedBlocksWindow > 0
+}
+
+// MinSignedBlocks returns the number of blocks in the window a validator must
+// include a valid vote extension in, rounded like x/slashing.
+func (p Params) MinSignedBlocks() int64 {
+	return p.MinSignedPerWindow.MulInt64(p.SignedBlocksWindow).RoundInt64()
+}
+
+// RequiresSecondarySignature reports whether a Msg with the given type URL
+// requires a secondary signature.
+func (p Params) RequiresSecondarySignature(typeURL string) bool {
+	for _, required := range p.RequiredMsgTypeUrls {
+		if required == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// RecoveryEnabled reports whether accounts can be recovered with
+// MsgRecoverAccount.
+func (p Params) RecoveryEnabled() bool {
+	return p.RecoveryDelay > 0
+}
@@ -8,16 +8,22 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
+	cosmossdk_io_math "cosmossdk.io/math"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -27,6 +33,38 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// allow_shared_secondary_keys allows the same secondary public key to be
+	// registered for more than one account.
+	AllowSharedSecondaryKeys bool `protobuf:"varint,1,opt,name=allow_shared_secondary_keys,json=allowSharedSecondaryKeys,proto3" json:"allow_shared_secondary_keys,omitempty"`
+	// required_msg_type_urls lists the Msg type URLs, such as
+	// "/cosmos.bank.v1beta1.MsgSend", that require a valid secondary signature
+	// from every signer with a registered secondary key.
+	RequiredMsgTypeUrls []string `protobuf:"bytes,2,rep,name=required_msg_type_urls,json=requiredMsgTypeUrls,proto3" json:"required_msg_type_urls,omitempty"`
+	// signed_blocks_window is the number of blocks over which the vote
+	// extensions of each validator with a registered secondary key are tracked.
+	// Zero disables the tracking.
+	SignedBlocksWindow int64 `protobuf:"varint,3,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// min_signed_per_window is the fraction of signed_blocks_window in which a
+	// validator must include a valid vote extension to avoid being jailed.
+	MinSignedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_signed_per_window,json=minSignedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_signed_per_window"`
+	// downtime_jail_duration is how long a validator that missed too many vote
+	// extensions stays jailed.
+	DowntimeJailDuration time.Duration `protobuf:"bytes,5,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration"`
+	// slash_fraction_missing_extension is the fraction of stake slashed from a
+	// validator jailed for missing vote extensions. Zero only jails it.
+	SlashFractionMissingExtension cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=slash_fraction_missing_extension,json=slashFractionMissingExtension,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_missing_extension"`
+	// webauthn_rp_ids lists the WebAuthn relying party IDs, such as
+	// "example.com", that KEY_TYPE_WEBAUTHN assertions may be made for. While
+	// it is empty WebAuthn keys can neither be registered nor sign.
+	WebauthnRpIds []string `protobuf:"bytes,7,rep,name=webauthn_rp_ids,json=webauthnRpIds,proto3" json:"webauthn_rp_ids,omitempty"`
+	// recovery_delay is the timelock of MsgRecoverAccount: how long the current
+	// primary key of a recovered account has to cancel the recovery. Zero
+	// disables account recovery.
+	RecoveryDelay time.Duration `protobuf:"bytes,8,opt,name=recovery_delay,json=recoveryDelay,proto3,stdduration" json:"recovery_delay"`
+	// attestation_retention_window is the number of blocks the attestation of
+	// a block is kept for. Older attestations are pruned at the end of each
+	// block. Zero keeps every attestation.
+	AttestationRetentionWindow int64 `protobuf:"varint,9,opt,name=attestation_retention_window,json=attestationRetentionWindow,proto3" json:"attestation_retention_window,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -62,6 +100,55 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetAllowSharedSecondaryKeys() bool {
+	if m != nil {
+		return m.AllowSharedSecondaryKeys
+	}
+	return false
+}
+
+func (m *Params) GetRequiredMsgTypeUrls() []string {
+	if m != nil {
+		return m.RequiredMsgTypeUrls
+	}
+	return nil
+}
+
+func (m *Params) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDowntimeJailDuration() time.Duration {
+	if m != nil {
+		return m.DowntimeJailDuration
+	}
+	return 0
+}
+
+func (m *Params) GetWebauthnRpIds() []string {
+	if m != nil {
+		return m.WebauthnRpIds
+	}
+	return nil
+}
+
+func (m *Params) GetRecoveryDelay() time.Duration {
+	if m != nil {
+		return m.RecoveryDelay
+	}
+	return 0
+}
+
+func (m *Params) GetAttestationRetentionWindow() int64 {
+	if m != nil {
+		return m.AttestationRetentionWindow
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "example.secondarykeys.v1.Params")
 }
@@ -71,18 +158,44 @@ func init() {
 }
 
 var fileDescriptor_87c8a37bf883ee22 = []byte{
-	// 165 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4d, 0xad, 0x48, 0xcc,
-	0x2d, 0xc8, 0x49, 0xd5, 0x2f, 0x4e, 0x4d, 0xce, 0xcf, 0x4b, 0x49, 0x2c, 0xaa, 0xcc, 0x4e, 0xad,
-	0x2c, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f,
-	0xc9, 0x17, 0x92, 0x80, 0x2a, 0xd3, 0x43, 0x51, 0xa6, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b,
-	0x99, 0x97, 0xaf, 0x0f, 0x26, 0x21, 0x8a, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x4c, 0x7d,
-	0x10, 0x0b, 0x22, 0xaa, 0x64, 0xc8, 0xc5, 0x16, 0x00, 0x36, 0xd2, 0x4a, 0xfd, 0xc5, 0x02, 0x79,
-	0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0xe4, 0x60, 0x96, 0x57, 0xa0, 0x59, 0x0f, 0x51, 0xe8, 0x64, 0x7e,
-	0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7,
-	0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0xb2, 0xb8, 0x74, 0x96, 0x54, 0x16,
-	0xa4, 0x16, 0x27, 0xb1, 0x81, 0xad, 0x34, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xb5, 0x7c, 0x80,
-	0xc3, 0xde, 0x00, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6b, 0xd4, 0x40,
+	0x14, 0xdf, 0x58, 0x5b, 0xdb, 0xd1, 0x2a, 0xc6, 0x5a, 0xd2, 0xad, 0xcd, 0x06, 0x41, 0x5d, 0x0a,
+	0x26, 0xd6, 0x82, 0x82, 0x20, 0xc8, 0xb2, 0x0a, 0x7e, 0x14, 0x4b, 0x56, 0x11, 0x3c, 0x38, 0xcc,
+	0x26, 0xaf, 0xd9, 0x71, 0x93, 0x99, 0x38, 0x93, 0xfd, 0xc8, 0xc9, 0xbb, 0x27, 0x8f, 0x1e, 0x3d,
+	0x7a, 0xec, 0xc1, 0x3f, 0xa2, 0xc7, 0xe2, 0x49, 0x3c, 0xac, 0xb2, 0x7b, 0xa8, 0x7f, 0x86, 0x64,
+	0x92, 0x11, 0x15, 0x3c, 0x88, 0x97, 0x90, 0x79, 0xbf, 0x8f, 0x79, 0xf3, 0x7b, 0x33, 0xe8, 0x12,
+	0x8c, 0x49, 0x92, 0xc6, 0xe0, 0x49, 0x08, 0x38, 0x0b, 0x89, 0xc8, 0xfb, 0x90, 0x4b, 0x6f, 0xb8,
+	0xe5, 0xa5, 0x44, 0x90, 0x44, 0xba, 0xa9, 0xe0, 0x19, 0x37, 0xad, 0x8a, 0xe6, 0xfe, 0x46, 0x73,
+	0x87, 0x5b, 0xf5, 0xb3, 0x24, 0xa1, 0x8c, 0x7b, 0xea, 0x5b, 0x92, 0xeb, 0x6b, 0x01, 0x97, 0x09,
+	0x97, 0x58, 0xad, 0xbc, 0x72, 0x51, 0x41, 0x2b, 0x11, 0x8f, 0x78, 0x59, 0x2f, 0xfe, 0xaa, 0xaa,
+	0x1d, 0x71, 0x1e, 0xc5, 0xe0, 0xa9, 0x55, 0x77, 0xb0, 0xe7, 0x85, 0x03, 0x41, 0x32, 0xca, 0x59,
+	0x89, 0x5f, 0x9c, 0xcc, 0xa3, 0x85, 0x5d, 0xd5, 0x8e, 0x79, 0x1b, 0xad, 0x93, 0x38, 0xe6, 0x23,
+	0x2c, 0x7b, 0x44, 0x40, 0x88, 0x7f, 0xf6, 0x83, 0x8b, 0x86, 0x2c, 0xc3, 0x31, 0x9a, 0x8b, 0xbe,
+	0xa5, 0x28, 0x1d, 0xc5, 0xe8, 0x68, 0xc2, 0x43, 0xc8, 0xa5, 0xb9, 0x8d, 0x56, 0x05, 0xbc, 0x1a,
+	0xd0, 0x42, 0x9a, 0xc8, 0x08, 0x67, 0x79, 0x0a, 0x78, 0x20, 0x62, 0x69, 0x1d, 0x73, 0xe6, 0x9a,
+	0x4b, 0xfe, 0x39, 0x8d, 0xee, 0xc8, 0xe8, 0x49, 0x9e, 0xc2, 0x53, 0x11, 0x4b, 0xf3, 0x1a, 0x5a,
+	0x91, 0x34, 0x62, 0x10, 0xe2, 0x6e, 0xcc, 0x83, 0xbe, 0xc4, 0x23, 0xca, 0x42, 0x3e, 0xb2, 0xe6,
+	0x1c, 0xa3, 0x39, 0xe7, 0x9b, 0x25, 0xd6, 0x52, 0xd0, 0x33, 0x85, 0x98, 0x14, 0x9d, 0x4f, 0x28,
+	0xc3, 0x95, 0x2a, 0x05, 0xa1, 0x25, 0xc7, 0x1d, 0xa3, 0x79, 0xaa, 0x75, 0xe3, 0x60, 0xd2, 0xa8,
+	0x7d, 0x99, 0x34, 0xd6, 0xcb, 0x6c, 0x64, 0xd8, 0x77, 0x29, 0xf7, 0x12, 0x92, 0xf5, 0xdc, 0x47,
+	0x10, 0x91, 0x20, 0x6f, 0x43, 0xf0, 0xe9, 0xe3, 0x55, 0x54, 0x45, 0xd7, 0x86, 0xe0, 0xc3, 0xd1,
+	0xfe, 0xa6, 0xe1, 0x9b, 0x09, 0x65, 0x1d, 0xe5, 0xb9, 0x0b, 0xa2, 0xda, 0xea, 0x05, 0x5a, 0x0d,
+	0xf9, 0x88, 0x65, 0x34, 0x01, 0xfc, 0x92, 0xd0, 0x18, 0xeb, 0xec, 0xac, 0x79, 0xc7, 0x68, 0x9e,
+	0xbc, 0xbe, 0xe6, 0x96, 0xe1, 0xba, 0x3a, 0x5c, 0xb7, 0x5d, 0x11, 0x5a, 0xcb, 0x45, 0x1b, 0xef,
+	0xbe, 0x36, 0x8c, 0xd2, 0x7d, 0x45, 0xfb, 0x3c, 0x20, 0x34, 0xd6, 0x24, 0xf3, 0x35, 0x72, 0x64,
+	0x4c, 0x64, 0x0f, 0xef, 0x09, 0x12, 0x14, 0x15, 0x9c, 0x50, 0x29, 0x29, 0x8b, 0x30, 0x8c, 0x33,
+	0x60, 0xb2, 0xd8, 0x69, 0xe1, 0xbf, 0x4e, 0xb5, 0xa1, 0xfc, 0xef, 0x55, 0xf6, 0x3b, 0xa5, 0xfb,
+	0x5d, 0x6d, 0x6e, 0x5e, 0x46, 0x67, 0x46, 0xd0, 0x25, 0x83, 0xac, 0xc7, 0xb0, 0x48, 0x31, 0x0d,
+	0xa5, 0x75, 0x42, 0xcd, 0x6a, 0x59, 0x97, 0xfd, 0xf4, 0x7e, 0x28, 0xcd, 0xc7, 0xe8, 0xb4, 0x80,
+	0x80, 0x0f, 0x41, 0xe4, 0x38, 0x84, 0x98, 0xe4, 0xd6, 0xe2, 0x3f, 0x06, 0xb0, 0xac, 0xf5, 0xed,
+	0x42, 0x6e, 0xde, 0x41, 0x17, 0x48, 0x96, 0x81, 0xcc, 0x14, 0x19, 0x0b, 0xc8, 0x80, 0xa9, 0xbf,
+	0x6a, 0x96, 0x4b, 0x6a, 0xfc, 0xf5, 0x5f, 0x38, 0xbe, 0xa6, 0x94, 0xb3, 0xb9, 0x75, 0xe5, 0xfb,
+	0xfb, 0x86, 0xf1, 0xe6, 0x68, 0x7f, 0xd3, 0xd6, 0xaf, 0x6c, 0xfc, 0xc7, 0x3b, 0x2b, 0x6f, 0x75,
+	0xeb, 0xe6, 0xc1, 0xd4, 0x36, 0x0e, 0xa7, 0xb6, 0xf1, 0x6d, 0x6a, 0x1b, 0x6f, 0x67, 0x76, 0xed,
+	0x70, 0x66, 0xd7, 0x3e, 0xcf, 0xec, 0xda, 0xf3, 0x8d, 0xbf, 0x29, 0x8b, 0x9b, 0x2b, 0xbb, 0x0b,
+	0xea, 0x50, 0xdb, 0x3f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x8f, 0x99, 0xab, 0x17, 0xc7, 0x03, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -104,6 +217,43 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.AllowSharedSecondaryKeys != that1.AllowSharedSecondaryKeys {
+		return false
+	}
+	if len(this.RequiredMsgTypeUrls) != len(that1.RequiredMsgTypeUrls) {
+		return false
+	}
+	for i := range this.RequiredMsgTypeUrls {
+		if this.RequiredMsgTypeUrls[i] != that1.RequiredMsgTypeUrls[i] {
+			return false
+		}
+	}
+	if this.SignedBlocksWindow != that1.SignedBlocksWindow {
+		return false
+	}
+	if !this.MinSignedPerWindow.Equal(that1.MinSignedPerWindow) {
+		return false
+	}
+	if this.DowntimeJailDuration != that1.DowntimeJailDuration {
+		return false
+	}
+	if !this.SlashFractionMissingExtension.Equal(that1.SlashFractionMissingExtension) {
+		return false
+	}
+	if len(this.WebauthnRpIds) != len(that1.WebauthnRpIds) {
+		return false
+	}
+	for i := range this.WebauthnRpIds {
+		if this.WebauthnRpIds[i] != that1.WebauthnRpIds[i] {
+			return false
+		}
+	}
+	if this.RecoveryDelay != that1.RecoveryDelay {
+		return false
+	}
+	if this.AttestationRetentionWindow != that1.AttestationRetentionWindow {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +276,80 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AttestationRetentionWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AttestationRetentionWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.RecoveryDelay, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecoveryDelay):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x42
+	if len(m.WebauthnRpIds) > 0 {
+		for iNdEx := len(m.WebauthnRpIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WebauthnRpIds[iNdEx])
+			copy(dAtA[i:], m.WebauthnRpIds[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.WebauthnRpIds[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.SlashFractionMissingExtension.Size()
+		i -= size
+		if _, err := m.SlashFractionMissingExtension.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinSignedPerWindow.Size()
+		i -= size
+		if _, err := m.MinSignedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RequiredMsgTypeUrls) > 0 {
+		for iNdEx := len(m.RequiredMsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredMsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.RequiredMsgTypeUrls[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.RequiredMsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AllowSharedSecondaryKeys {
+		i--
+		if m.AllowSharedSecondaryKeys {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -146,6 +370,35 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.AllowSharedSecondaryKeys {
+		n += 2
+	}
+	if len(m.RequiredMsgTypeUrls) > 0 {
+		for _, s := range m.RequiredMsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovParams(uint64(m.SignedBlocksWindow))
+	}
+	l = m.MinSignedPerWindow.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovParams(uint64(l))
+	l = m.SlashFractionMissingExtension.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.WebauthnRpIds) > 0 {
+		for _, s := range m.WebauthnRpIds {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.RecoveryDelay)
+	n += 1 + l + sovParams(uint64(l))
+	if m.AttestationRetentionWindow != 0 {
+		n += 1 + sovParams(uint64(m.AttestationRetentionWindow))
+	}
 	return n
 }
 
@@ -184,6 +437,260 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowSharedSecondaryKeys", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowSharedSecondaryKeys = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredMsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredMsgTypeUrls = append(m.RequiredMsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSignedPerWindow", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSignedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionMissingExtension", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionMissingExtension.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WebauthnRpIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WebauthnRpIds = append(m.WebauthnRpIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryDelay", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.RecoveryDelay, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationRetentionWindow", wireType)
+			}
+			m.AttestationRetentionWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationRetentionWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -10,7 +10,8 @@ import (
 	math "math"
 	math_bits "math/bits"
 
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -115,216 +116,4049 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "example.secondarykeys.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "example.secondarykeys.v1.QueryParamsResponse")
+// QuerySecondaryKeyRequest is request type for the Query/SecondaryKey RPC
+// method.
+type QuerySecondaryKeyRequest struct {
+	// address is the account to query.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("example/secondarykeys/v1/query.proto", fileDescriptor_e2f661ee777dc844)
+func (m *QuerySecondaryKeyRequest) Reset()         { *m = QuerySecondaryKeyRequest{} }
+func (m *QuerySecondaryKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySecondaryKeyRequest) ProtoMessage()    {}
+func (*QuerySecondaryKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{2}
+}
+func (m *QuerySecondaryKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySecondaryKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySecondaryKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySecondaryKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySecondaryKeyRequest.Merge(m, src)
+}
+func (m *QuerySecondaryKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySecondaryKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySecondaryKeyRequest.DiscardUnknown(m)
 }
 
-var fileDescriptor_e2f661ee777dc844 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0xad, 0x48, 0xcc,
-	0x2d, 0xc8, 0x49, 0xd5, 0x2f, 0x4e, 0x4d, 0xce, 0xcf, 0x4b, 0x49, 0x2c, 0xaa, 0xcc, 0x4e, 0xad,
-	0x2c, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x80, 0xaa, 0xd2, 0x43, 0x51, 0xa5, 0x57, 0x66, 0x28, 0x25, 0x98, 0x98, 0x9b, 0x99,
-	0x97, 0xaf, 0x0f, 0x26, 0x21, 0x8a, 0xa5, 0xb4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93,
-	0x12, 0x8b, 0x53, 0x21, 0xa6, 0xe8, 0x97, 0x19, 0x26, 0xa5, 0x96, 0x24, 0x1a, 0xea, 0x17, 0x24,
-	0xa6, 0x67, 0xe6, 0x25, 0x96, 0x64, 0xe6, 0xe7, 0x41, 0xd5, 0xaa, 0xe2, 0xb4, 0xbe, 0x20, 0xb1,
-	0x28, 0x31, 0xb7, 0x18, 0xaa, 0x4c, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0xa0,
-	0xa2, 0x32, 0xe9, 0xf9, 0xf9, 0xe9, 0x39, 0xa9, 0xfa, 0x89, 0x05, 0x99, 0xfa, 0x89, 0x79, 0x79,
-	0xf9, 0x25, 0x60, 0x93, 0xa1, 0x7a, 0x94, 0x44, 0xb8, 0x84, 0x02, 0x41, 0x96, 0x07, 0x80, 0x0d,
-	0x0a, 0x4a, 0x2d, 0x2c, 0x4d, 0x2d, 0x2e, 0x51, 0x8a, 0xe2, 0x12, 0x46, 0x11, 0x2d, 0x2e, 0xc8,
-	0xcf, 0x2b, 0x4e, 0x15, 0x72, 0xe6, 0x62, 0x83, 0x58, 0x28, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d,
-	0xa4, 0xa0, 0x87, 0xcb, 0xc7, 0x7a, 0x10, 0x9d, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33, 0xac, 0x78,
-	0xbe, 0x41, 0x8b, 0x31, 0x08, 0xaa, 0xd5, 0x68, 0x26, 0x23, 0x17, 0x2b, 0xd8, 0x70, 0xa1, 0x7e,
-	0x46, 0x2e, 0x36, 0x88, 0x3a, 0x21, 0x1d, 0xdc, 0x26, 0x61, 0x3a, 0x4f, 0x4a, 0x97, 0x48, 0xd5,
-	0x10, 0x67, 0x2b, 0x69, 0x34, 0x5d, 0x7e, 0x32, 0x99, 0x49, 0x49, 0x48, 0x41, 0x9f, 0x40, 0x38,
-	0x3a, 0x99, 0x9f, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13,
-	0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x2c, 0x4c, 0x6b,
-	0x05, 0x9a, 0xe6, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x68, 0x1a, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0x09, 0x0c, 0x8f, 0xb3, 0x29, 0x02, 0x00, 0x00,
+var xxx_messageInfo_QuerySecondaryKeyRequest proto.InternalMessageInfo
+
+func (m *QuerySecondaryKeyRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+// QuerySecondaryKeyResponse is response type for the Query/SecondaryKey RPC
+// method.
+type QuerySecondaryKeyResponse struct {
+	// secondary_key is the registered key. It is empty if the account has no
+	// secondary key.
+	SecondaryKey *AccountKey `protobuf:"bytes,1,opt,name=secondary_key,json=secondaryKey,proto3" json:"secondary_key,omitempty"`
+	// revoked is true if the account's last secondary key was revoked and no
+	// new key has been registered since.
+	Revoked bool `protobuf:"varint,2,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// locked is true if the account is locked down until a new secondary key
+	// is registered.
+	Locked bool `protobuf:"varint,3,opt,name=locked,proto3" json:"locked,omitempty"`
+	// secondary_signature_required is true if every transaction of the account
+	// requires a valid secondary signature.
+	SecondarySignatureRequired bool `protobuf:"varint,4,opt,name=secondary_signature_required,json=secondarySignatureRequired,proto3" json:"secondary_signature_required,omitempty"`
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QuerySecondaryKeyResponse) Reset()         { *m = QuerySecondaryKeyResponse{} }
+func (m *QuerySecondaryKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySecondaryKeyResponse) ProtoMessage()    {}
+func (*QuerySecondaryKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{3}
+}
+func (m *QuerySecondaryKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySecondaryKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySecondaryKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySecondaryKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySecondaryKeyResponse.Merge(m, src)
+}
+func (m *QuerySecondaryKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySecondaryKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySecondaryKeyResponse.DiscardUnknown(m)
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+var xxx_messageInfo_QuerySecondaryKeyResponse proto.InternalMessageInfo
+
+func (m *QuerySecondaryKeyResponse) GetSecondaryKey() *AccountKey {
+	if m != nil {
+		return m.SecondaryKey
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySecondaryKeyResponse) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QuerySecondaryKeyResponse) GetLocked() bool {
+	if m != nil {
+		return m.Locked
+	}
+	return false
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySecondaryKeyResponse) GetSecondarySignatureRequired() bool {
+	if m != nil {
+		return m.SecondarySignatureRequired
 	}
-	return out, nil
+	return false
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+// QueryAllSecondaryKeysRequest is request type for the Query/AllSecondaryKeys
+// RPC method.
+type QueryAllSecondaryKeysRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllSecondaryKeysRequest) Reset()         { *m = QueryAllSecondaryKeysRequest{} }
+func (m *QueryAllSecondaryKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSecondaryKeysRequest) ProtoMessage()    {}
+func (*QueryAllSecondaryKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{4}
+}
+func (m *QueryAllSecondaryKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSecondaryKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSecondaryKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllSecondaryKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSecondaryKeysRequest.Merge(m, src)
+}
+func (m *QueryAllSecondaryKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSecondaryKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSecondaryKeysRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryAllSecondaryKeysRequest proto.InternalMessageInfo
+
+func (m *QueryAllSecondaryKeysRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryAllSecondaryKeysResponse is response type for the
+// Query/AllSecondaryKeys RPC method.
+type QueryAllSecondaryKeysResponse struct {
+	// secondary_keys are the registered account keys.
+	SecondaryKeys []AccountKey `protobuf:"bytes,1,rep,name=secondary_keys,json=secondaryKeys,proto3" json:"secondary_keys"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *QueryAllSecondaryKeysResponse) Reset()         { *m = QueryAllSecondaryKeysResponse{} }
+func (m *QueryAllSecondaryKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllSecondaryKeysResponse) ProtoMessage()    {}
+func (*QueryAllSecondaryKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{5}
+}
+func (m *QueryAllSecondaryKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllSecondaryKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllSecondaryKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
+}
+func (m *QueryAllSecondaryKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllSecondaryKeysResponse.Merge(m, src)
+}
+func (m *QueryAllSecondaryKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllSecondaryKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllSecondaryKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllSecondaryKeysResponse proto.InternalMessageInfo
+
+func (m *QueryAllSecondaryKeysResponse) GetSecondaryKeys() []AccountKey {
+	if m != nil {
+		return m.SecondaryKeys
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/example.secondarykeys.v1.Query/Params",
+	return nil
+}
+
+func (m *QueryAllSecondaryKeysResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	return nil
+}
+
+// QuerySecondaryKeyOwnerRequest is request type for the
+// Query/SecondaryKeyOwner RPC method.
+type QuerySecondaryKeyOwnerRequest struct {
+	// public_key is the secondary public key to look up.
+	PublicKey []byte `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (m *QuerySecondaryKeyOwnerRequest) Reset()         { *m = QuerySecondaryKeyOwnerRequest{} }
+func (m *QuerySecondaryKeyOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySecondaryKeyOwnerRequest) ProtoMessage()    {}
+func (*QuerySecondaryKeyOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{6}
+}
+func (m *QuerySecondaryKeyOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySecondaryKeyOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySecondaryKeyOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QuerySecondaryKeyOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySecondaryKeyOwnerRequest.Merge(m, src)
+}
+func (m *QuerySecondaryKeyOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySecondaryKeyOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySecondaryKeyOwnerRequest.DiscardUnknown(m)
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "example.secondarykeys.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "example/secondarykeys/v1/query.proto",
+var xxx_messageInfo_QuerySecondaryKeyOwnerRequest proto.InternalMessageInfo
+
+func (m *QuerySecondaryKeyOwnerRequest) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// QuerySecondaryKeyOwnerResponse is response type for the
+// Query/SecondaryKeyOwner RPC method.
+type QuerySecondaryKeyOwnerResponse struct {
+	// owners are the accounts the public key is registered for.
+	Owners []string `protobuf:"bytes,1,rep,name=owners,proto3" json:"owners,omitempty"`
+}
+
+func (m *QuerySecondaryKeyOwnerResponse) Reset()         { *m = QuerySecondaryKeyOwnerResponse{} }
+func (m *QuerySecondaryKeyOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySecondaryKeyOwnerResponse) ProtoMessage()    {}
+func (*QuerySecondaryKeyOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{7}
+}
+func (m *QuerySecondaryKeyOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySecondaryKeyOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySecondaryKeyOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QuerySecondaryKeyOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySecondaryKeyOwnerResponse.Merge(m, src)
+}
+func (m *QuerySecondaryKeyOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySecondaryKeyOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySecondaryKeyOwnerResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QuerySecondaryKeyOwnerResponse proto.InternalMessageInfo
+
+func (m *QuerySecondaryKeyOwnerResponse) GetOwners() []string {
+	if m != nil {
+		return m.Owners
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryValidatorSecondaryKeyRequest is request type for the
+// Query/ValidatorSecondaryKey RPC method.
+type QueryValidatorSecondaryKeyRequest struct {
+	// consensus_address is the validator's consensus address.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryValidatorSecondaryKeyRequest) Reset()         { *m = QueryValidatorSecondaryKeyRequest{} }
+func (m *QueryValidatorSecondaryKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSecondaryKeyRequest) ProtoMessage()    {}
+func (*QueryValidatorSecondaryKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{8}
+}
+func (m *QueryValidatorSecondaryKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSecondaryKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSecondaryKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryValidatorSecondaryKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSecondaryKeyRequest.Merge(m, src)
+}
+func (m *QueryValidatorSecondaryKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSecondaryKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSecondaryKeyRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryValidatorSecondaryKeyRequest proto.InternalMessageInfo
+
+func (m *QueryValidatorSecondaryKeyRequest) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+// QueryValidatorSecondaryKeyResponse is response type for the
+// Query/ValidatorSecondaryKey RPC method.
+type QueryValidatorSecondaryKeyResponse struct {
+	// secondary_key is the validator's registered key.
+	SecondaryKey ValidatorKey `protobuf:"bytes,1,opt,name=secondary_key,json=secondaryKey,proto3" json:"secondary_key"`
+}
+
+func (m *QueryValidatorSecondaryKeyResponse) Reset()         { *m = QueryValidatorSecondaryKeyResponse{} }
+func (m *QueryValidatorSecondaryKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidatorSecondaryKeyResponse) ProtoMessage()    {}
+func (*QueryValidatorSecondaryKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{9}
+}
+func (m *QueryValidatorSecondaryKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidatorSecondaryKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidatorSecondaryKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidatorSecondaryKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidatorSecondaryKeyResponse.Merge(m, src)
+}
+func (m *QueryValidatorSecondaryKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidatorSecondaryKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidatorSecondaryKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryValidatorSecondaryKeyResponse proto.InternalMessageInfo
+
+func (m *QueryValidatorSecondaryKeyResponse) GetSecondaryKey() ValidatorKey {
+	if m != nil {
+		return m.SecondaryKey
+	}
+	return ValidatorKey{}
+}
+
+// QueryAllValidatorSecondaryKeysRequest is request type for the
+// Query/AllValidatorSecondaryKeys RPC method.
+type QueryAllValidatorSecondaryKeysRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllValidatorSecondaryKeysRequest) Reset()         { *m = QueryAllValidatorSecondaryKeysRequest{} }
+func (m *QueryAllValidatorSecondaryKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllValidatorSecondaryKeysRequest) ProtoMessage()    {}
+func (*QueryAllValidatorSecondaryKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{10}
+}
+func (m *QueryAllValidatorSecondaryKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllValidatorSecondaryKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllValidatorSecondaryKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllValidatorSecondaryKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllValidatorSecondaryKeysRequest.Merge(m, src)
+}
+func (m *QueryAllValidatorSecondaryKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllValidatorSecondaryKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllValidatorSecondaryKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllValidatorSecondaryKeysRequest proto.InternalMessageInfo
+
+func (m *QueryAllValidatorSecondaryKeysRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllValidatorSecondaryKeysResponse is response type for the
+// Query/AllValidatorSecondaryKeys RPC method.
+type QueryAllValidatorSecondaryKeysResponse struct {
+	// secondary_keys are the registered validator keys.
+	SecondaryKeys []ValidatorKey `protobuf:"bytes,1,rep,name=secondary_keys,json=secondaryKeys,proto3" json:"secondary_keys"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllValidatorSecondaryKeysResponse) Reset() {
+	*m = QueryAllValidatorSecondaryKeysResponse{}
+}
+func (m *QueryAllValidatorSecondaryKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllValidatorSecondaryKeysResponse) ProtoMessage()    {}
+func (*QueryAllValidatorSecondaryKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{11}
+}
+func (m *QueryAllValidatorSecondaryKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllValidatorSecondaryKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllValidatorSecondaryKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllValidatorSecondaryKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllValidatorSecondaryKeysResponse.Merge(m, src)
+}
+func (m *QueryAllValidatorSecondaryKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllValidatorSecondaryKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllValidatorSecondaryKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllValidatorSecondaryKeysResponse proto.InternalMessageInfo
+
+func (m *QueryAllValidatorSecondaryKeysResponse) GetSecondaryKeys() []ValidatorKey {
+	if m != nil {
+		return m.SecondaryKeys
+	}
+	return nil
+}
+
+func (m *QueryAllValidatorSecondaryKeysResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttestationRequest is request type for the Query/Attestation RPC
+// method.
+type QueryAttestationRequest struct {
+	// height is the height of the attested block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *QueryAttestationRequest) Reset()         { *m = QueryAttestationRequest{} }
+func (m *QueryAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationRequest) ProtoMessage()    {}
+func (*QueryAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{12}
+}
+func (m *QueryAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationRequest.Merge(m, src)
+}
+func (m *QueryAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// QueryAttestationResponse is response type for the Query/Attestation RPC
+// method.
+type QueryAttestationResponse struct {
+	// attestation holds the validator signatures over the block.
+	Attestation Attestation `protobuf:"bytes,1,opt,name=attestation,proto3" json:"attestation"`
+}
+
+func (m *QueryAttestationResponse) Reset()         { *m = QueryAttestationResponse{} }
+func (m *QueryAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationResponse) ProtoMessage()    {}
+func (*QueryAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{13}
+}
+func (m *QueryAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationResponse.Merge(m, src)
+}
+func (m *QueryAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationResponse) GetAttestation() Attestation {
+	if m != nil {
+		return m.Attestation
+	}
+	return Attestation{}
+}
+
+// QueryExtensionSigningInfoRequest is request type for the
+// Query/ExtensionSigningInfo RPC method.
+type QueryExtensionSigningInfoRequest struct {
+	// consensus_address is the validator's consensus address.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+}
+
+func (m *QueryExtensionSigningInfoRequest) Reset()         { *m = QueryExtensionSigningInfoRequest{} }
+func (m *QueryExtensionSigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExtensionSigningInfoRequest) ProtoMessage()    {}
+func (*QueryExtensionSigningInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{14}
+}
+func (m *QueryExtensionSigningInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExtensionSigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExtensionSigningInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExtensionSigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExtensionSigningInfoRequest.Merge(m, src)
+}
+func (m *QueryExtensionSigningInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExtensionSigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExtensionSigningInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExtensionSigningInfoRequest proto.InternalMessageInfo
+
+func (m *QueryExtensionSigningInfoRequest) GetConsensusAddress() string {
+	if m != nil {
+		return m.ConsensusAddress
+	}
+	return ""
+}
+
+// QueryExtensionSigningInfoResponse is response type for the
+// Query/ExtensionSigningInfo RPC method.
+type QueryExtensionSigningInfoResponse struct {
+	// signing_info is the validator's signing info.
+	SigningInfo ExtensionSigningInfo `protobuf:"bytes,1,opt,name=signing_info,json=signingInfo,proto3" json:"signing_info"`
+}
+
+func (m *QueryExtensionSigningInfoResponse) Reset()         { *m = QueryExtensionSigningInfoResponse{} }
+func (m *QueryExtensionSigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExtensionSigningInfoResponse) ProtoMessage()    {}
+func (*QueryExtensionSigningInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{15}
+}
+func (m *QueryExtensionSigningInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExtensionSigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExtensionSigningInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryExtensionSigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExtensionSigningInfoResponse.Merge(m, src)
+}
+func (m *QueryExtensionSigningInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExtensionSigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExtensionSigningInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryExtensionSigningInfoResponse proto.InternalMessageInfo
+
+func (m *QueryExtensionSigningInfoResponse) GetSigningInfo() ExtensionSigningInfo {
+	if m != nil {
+		return m.SigningInfo
+	}
+	return ExtensionSigningInfo{}
+}
+
+// QueryPendingRecoveryRequest is request type for the Query/PendingRecovery
+// RPC method.
+type QueryPendingRecoveryRequest struct {
+	// address is the recovered account.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryPendingRecoveryRequest) Reset()         { *m = QueryPendingRecoveryRequest{} }
+func (m *QueryPendingRecoveryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRecoveryRequest) ProtoMessage()    {}
+func (*QueryPendingRecoveryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{16}
+}
+func (m *QueryPendingRecoveryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRecoveryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRecoveryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRecoveryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRecoveryRequest.Merge(m, src)
+}
+func (m *QueryPendingRecoveryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRecoveryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRecoveryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRecoveryRequest proto.InternalMessageInfo
+
+func (m *QueryPendingRecoveryRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryPendingRecoveryResponse is response type for the Query/PendingRecovery
+// RPC method.
+type QueryPendingRecoveryResponse struct {
+	// recovery is the account's pending recovery.
+	Recovery PendingRecovery `protobuf:"bytes,1,opt,name=recovery,proto3" json:"recovery"`
+}
+
+func (m *QueryPendingRecoveryResponse) Reset()         { *m = QueryPendingRecoveryResponse{} }
+func (m *QueryPendingRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRecoveryResponse) ProtoMessage()    {}
+func (*QueryPendingRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{17}
+}
+func (m *QueryPendingRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRecoveryResponse.Merge(m, src)
+}
+func (m *QueryPendingRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRecoveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRecoveryResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRecoveryResponse) GetRecovery() PendingRecovery {
+	if m != nil {
+		return m.Recovery
+	}
+	return PendingRecovery{}
+}
+
+// QuerySessionKeysRequest is request type for the Query/SessionKeys RPC
+// method.
+type QuerySessionKeysRequest struct {
+	// address is the account the session keys sign for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QuerySessionKeysRequest) Reset()         { *m = QuerySessionKeysRequest{} }
+func (m *QuerySessionKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionKeysRequest) ProtoMessage()    {}
+func (*QuerySessionKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{18}
+}
+func (m *QuerySessionKeysRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySessionKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySessionKeysRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySessionKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionKeysRequest.Merge(m, src)
+}
+func (m *QuerySessionKeysRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySessionKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionKeysRequest proto.InternalMessageInfo
+
+func (m *QuerySessionKeysRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QuerySessionKeysResponse is response type for the Query/SessionKeys RPC
+// method.
+type QuerySessionKeysResponse struct {
+	// session_keys are the account's session keys, expired ones included.
+	SessionKeys []SessionKey `protobuf:"bytes,1,rep,name=session_keys,json=sessionKeys,proto3" json:"session_keys"`
+	// allowances are the remaining allowances of session_keys, in the same
+	// order.
+	Allowances []SessionKeyAllowance `protobuf:"bytes,2,rep,name=allowances,proto3" json:"allowances"`
+}
+
+func (m *QuerySessionKeysResponse) Reset()         { *m = QuerySessionKeysResponse{} }
+func (m *QuerySessionKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySessionKeysResponse) ProtoMessage()    {}
+func (*QuerySessionKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2f661ee777dc844, []int{19}
+}
+func (m *QuerySessionKeysResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySessionKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySessionKeysResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySessionKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySessionKeysResponse.Merge(m, src)
+}
+func (m *QuerySessionKeysResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySessionKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySessionKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySessionKeysResponse proto.InternalMessageInfo
+
+func (m *QuerySessionKeysResponse) GetSessionKeys() []SessionKey {
+	if m != nil {
+		return m.SessionKeys
+	}
+	return nil
+}
+
+func (m *QuerySessionKeysResponse) GetAllowances() []SessionKeyAllowance {
+	if m != nil {
+		return m.Allowances
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "example.secondarykeys.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "example.secondarykeys.v1.QueryParamsResponse")
+	proto.RegisterType((*QuerySecondaryKeyRequest)(nil), "example.secondarykeys.v1.QuerySecondaryKeyRequest")
+	proto.RegisterType((*QuerySecondaryKeyResponse)(nil), "example.secondarykeys.v1.QuerySecondaryKeyResponse")
+	proto.RegisterType((*QueryAllSecondaryKeysRequest)(nil), "example.secondarykeys.v1.QueryAllSecondaryKeysRequest")
+	proto.RegisterType((*QueryAllSecondaryKeysResponse)(nil), "example.secondarykeys.v1.QueryAllSecondaryKeysResponse")
+	proto.RegisterType((*QuerySecondaryKeyOwnerRequest)(nil), "example.secondarykeys.v1.QuerySecondaryKeyOwnerRequest")
+	proto.RegisterType((*QuerySecondaryKeyOwnerResponse)(nil), "example.secondarykeys.v1.QuerySecondaryKeyOwnerResponse")
+	proto.RegisterType((*QueryValidatorSecondaryKeyRequest)(nil), "example.secondarykeys.v1.QueryValidatorSecondaryKeyRequest")
+	proto.RegisterType((*QueryValidatorSecondaryKeyResponse)(nil), "example.secondarykeys.v1.QueryValidatorSecondaryKeyResponse")
+	proto.RegisterType((*QueryAllValidatorSecondaryKeysRequest)(nil), "example.secondarykeys.v1.QueryAllValidatorSecondaryKeysRequest")
+	proto.RegisterType((*QueryAllValidatorSecondaryKeysResponse)(nil), "example.secondarykeys.v1.QueryAllValidatorSecondaryKeysResponse")
+	proto.RegisterType((*QueryAttestationRequest)(nil), "example.secondarykeys.v1.QueryAttestationRequest")
+	proto.RegisterType((*QueryAttestationResponse)(nil), "example.secondarykeys.v1.QueryAttestationResponse")
+	proto.RegisterType((*QueryExtensionSigningInfoRequest)(nil), "example.secondarykeys.v1.QueryExtensionSigningInfoRequest")
+	proto.RegisterType((*QueryExtensionSigningInfoResponse)(nil), "example.secondarykeys.v1.QueryExtensionSigningInfoResponse")
+	proto.RegisterType((*QueryPendingRecoveryRequest)(nil), "example.secondarykeys.v1.QueryPendingRecoveryRequest")
+	proto.RegisterType((*QueryPendingRecoveryResponse)(nil), "example.secondarykeys.v1.QueryPendingRecoveryResponse")
+	proto.RegisterType((*QuerySessionKeysRequest)(nil), "example.secondarykeys.v1.QuerySessionKeysRequest")
+	proto.RegisterType((*QuerySessionKeysResponse)(nil), "example.secondarykeys.v1.QuerySessionKeysResponse")
+}
+
+func init() {
+	proto.RegisterFile("example/secondarykeys/v1/query.proto", fileDescriptor_e2f661ee777dc844)
+}
+
+var fileDescriptor_e2f661ee777dc844 = []byte{
+	// 1239 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x41, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0xa6, 0xe0, 0x36, 0x2f, 0x29, 0x6d, 0x86, 0x50, 0xdc, 0x25, 0x31, 0xe9, 0xaa, 0x49,
+	0x43, 0x68, 0xbc, 0xb1, 0x4b, 0x93, 0xa8, 0x45, 0xa5, 0x49, 0xd5, 0x96, 0xaa, 0x6a, 0x48, 0x1d,
+	0xa9, 0xaa, 0x2a, 0x24, 0x6b, 0x63, 0x4f, 0xdc, 0x55, 0x9c, 0x1d, 0x67, 0x67, 0xed, 0xc6, 0x2a,
+	0x39, 0xc0, 0x0f, 0x00, 0x24, 0xfe, 0x04, 0x17, 0x10, 0x12, 0xe5, 0x88, 0xd4, 0x63, 0x24, 0x2e,
+	0x55, 0xb9, 0x20, 0x0e, 0x15, 0x4a, 0x90, 0xf8, 0x1b, 0x68, 0x67, 0xde, 0xda, 0xb3, 0xb6, 0xd7,
+	0x6b, 0x07, 0x7a, 0xa9, 0xba, 0xb3, 0xef, 0x7d, 0xf3, 0x7d, 0x6f, 0xde, 0xec, 0xfb, 0x62, 0x38,
+	0x4f, 0x77, 0xad, 0xed, 0x4a, 0x99, 0x9a, 0x9c, 0x16, 0x98, 0x53, 0xb4, 0xdc, 0xfa, 0x16, 0xad,
+	0x73, 0xb3, 0x96, 0x31, 0x77, 0xaa, 0xd4, 0xad, 0xa7, 0x2b, 0x2e, 0xf3, 0x18, 0x49, 0x62, 0x54,
+	0x3a, 0x14, 0x95, 0xae, 0x65, 0xf4, 0x51, 0x6b, 0xdb, 0x76, 0x98, 0x29, 0xfe, 0x95, 0xc1, 0xfa,
+	0x6c, 0x81, 0xf1, 0x6d, 0xc6, 0xcd, 0x0d, 0x8b, 0x53, 0x89, 0x62, 0xd6, 0x32, 0x1b, 0xd4, 0xb3,
+	0x32, 0x66, 0xc5, 0x2a, 0xd9, 0x8e, 0xe5, 0xd9, 0xcc, 0xc1, 0xd8, 0xb3, 0x32, 0x36, 0x2f, 0x9e,
+	0x4c, 0xf9, 0x10, 0xc0, 0x44, 0x32, 0xb3, 0x3c, 0x8f, 0x72, 0x4f, 0x85, 0x99, 0x8a, 0x8c, 0xad,
+	0x58, 0xae, 0xb5, 0x1d, 0x40, 0x5e, 0x88, 0x0c, 0x73, 0x69, 0x81, 0xd5, 0x1a, 0x7a, 0xf5, 0x8b,
+	0x91, 0x81, 0x8d, 0x85, 0xfc, 0x16, 0xad, 0xc7, 0x32, 0xe5, 0x94, 0x73, 0x9b, 0x39, 0x4a, 0xec,
+	0x87, 0xd1, 0xb1, 0x76, 0xc9, 0xb1, 0x9d, 0x52, 0xde, 0x76, 0x36, 0x83, 0x4a, 0x8e, 0x95, 0x58,
+	0x89, 0xc9, 0xd2, 0xf8, 0xff, 0xc3, 0xd5, 0xf1, 0x12, 0x63, 0xa5, 0x32, 0x35, 0xad, 0x8a, 0x6d,
+	0x5a, 0x8e, 0xc3, 0x64, 0x25, 0x50, 0xa3, 0x31, 0x06, 0xe4, 0xbe, 0x5f, 0xf3, 0x35, 0x21, 0x3c,
+	0x47, 0x77, 0xaa, 0x94, 0x7b, 0xc6, 0x23, 0x78, 0x3b, 0xb4, 0xca, 0x2b, 0xcc, 0xe1, 0x94, 0xdc,
+	0x80, 0x84, 0x2c, 0x50, 0x52, 0x9b, 0xd4, 0x66, 0x86, 0xb3, 0x93, 0xe9, 0xa8, 0x83, 0x4e, 0xcb,
+	0xcc, 0x95, 0xa1, 0xfd, 0x57, 0xef, 0x0f, 0x7c, 0xff, 0xcf, 0x4f, 0xb3, 0x5a, 0x0e, 0x53, 0x8d,
+	0x55, 0x48, 0x0a, 0xec, 0xf5, 0x20, 0xe5, 0x2e, 0xad, 0xe3, 0xbe, 0x24, 0x0b, 0xc7, 0xad, 0x62,
+	0xd1, 0xa5, 0x5c, 0xee, 0x30, 0xb4, 0x92, 0x7c, 0xf9, 0x6c, 0x6e, 0x0c, 0xcf, 0x79, 0x59, 0xbe,
+	0x59, 0xf7, 0x5c, 0xdb, 0x29, 0xe5, 0x82, 0x40, 0xe3, 0x4f, 0x0d, 0xce, 0x76, 0x00, 0x44, 0xca,
+	0x77, 0xe0, 0x64, 0xe8, 0x0c, 0x90, 0xf9, 0xf9, 0x68, 0xe6, 0xcb, 0x85, 0x02, 0xab, 0x3a, 0x9e,
+	0x0f, 0x32, 0xc2, 0x15, 0x48, 0x92, 0x84, 0xe3, 0x2e, 0xad, 0xb1, 0x2d, 0x5a, 0x4c, 0x0e, 0x4e,
+	0x6a, 0x33, 0x27, 0x72, 0xc1, 0x23, 0x39, 0x03, 0x89, 0x32, 0x2b, 0xf8, 0x2f, 0x8e, 0x89, 0x17,
+	0xf8, 0x44, 0xae, 0xc3, 0x78, 0x73, 0x73, 0xff, 0xc0, 0x2c, 0xaf, 0xea, 0xd2, 0xbc, 0x4b, 0x77,
+	0xaa, 0xb6, 0x4b, 0x8b, 0xc9, 0x37, 0x44, 0xb4, 0xde, 0x88, 0x59, 0x0f, 0x42, 0x72, 0x18, 0x61,
+	0x6c, 0xc2, 0xb8, 0xd0, 0xb6, 0x5c, 0x2e, 0xab, 0xf2, 0x82, 0x83, 0x22, 0xb7, 0x00, 0x9a, 0x97,
+	0x04, 0xb5, 0x4d, 0xa7, 0xb1, 0x60, 0xfe, 0x8d, 0x4a, 0xcb, 0x7b, 0x89, 0x37, 0x2a, 0xbd, 0x66,
+	0x95, 0x28, 0xe6, 0xe6, 0x94, 0x4c, 0xe3, 0xb9, 0x06, 0x13, 0x11, 0x1b, 0x61, 0x21, 0x1f, 0xc0,
+	0x5b, 0xa1, 0x42, 0xfa, 0x27, 0x74, 0xac, 0xd7, 0x4a, 0xaa, 0x7d, 0x70, 0x52, 0x2d, 0x2a, 0x27,
+	0xb7, 0x43, 0x0a, 0x06, 0x85, 0x82, 0x0b, 0xb1, 0x0a, 0x24, 0xa9, 0x90, 0x84, 0x6b, 0xa8, 0x40,
+	0xa5, 0xff, 0xd9, 0x13, 0x87, 0xba, 0x41, 0xad, 0x26, 0x00, 0x2a, 0xd5, 0x8d, 0xb2, 0x5d, 0x68,
+	0xf4, 0xc1, 0x48, 0x6e, 0x48, 0xae, 0xdc, 0xa5, 0x75, 0x23, 0x07, 0xa9, 0xa8, 0x7c, 0x2c, 0xc1,
+	0x3c, 0x24, 0x98, 0xbf, 0x20, 0xa5, 0x77, 0x6b, 0x4e, 0x8c, 0x33, 0x38, 0x9c, 0x13, 0x98, 0x0f,
+	0xac, 0xb2, 0x5d, 0xb4, 0x3c, 0xe6, 0x76, 0x6a, 0xfa, 0x55, 0x18, 0x2d, 0xf8, 0xf8, 0x0e, 0xaf,
+	0xf2, 0x7c, 0xb8, 0xfd, 0xcf, 0xbd, 0x7c, 0x36, 0x37, 0x81, 0x3b, 0xdc, 0x08, 0x62, 0xc2, 0x5b,
+	0x9d, 0x2e, 0xb4, 0xac, 0x1b, 0x5f, 0x80, 0xd1, 0x6d, 0xd3, 0xc6, 0x79, 0x76, 0xbc, 0x18, 0xd3,
+	0xd1, 0xc7, 0xd9, 0xc0, 0x6b, 0x39, 0xd0, 0xd0, 0x2d, 0x31, 0x18, 0x4c, 0x05, 0x8d, 0xd4, 0x91,
+	0xc0, 0xff, 0xde, 0xba, 0xbf, 0x69, 0x30, 0x1d, 0xb7, 0x23, 0x6a, 0x7e, 0x18, 0xd1, 0xc3, 0x47,
+	0x10, 0xfd, 0xba, 0xba, 0x38, 0x03, 0xef, 0x4a, 0x31, 0xcd, 0xa1, 0x15, 0x14, 0xec, 0x0c, 0x24,
+	0x1e, 0x53, 0xbb, 0xf4, 0xd8, 0x13, 0xc5, 0x3a, 0x96, 0xc3, 0x27, 0xc3, 0xc1, 0x0f, 0x6a, 0x28,
+	0x05, 0x15, 0xe7, 0x60, 0x58, 0x19, 0x7f, 0x58, 0xe5, 0xa9, 0x2e, 0x57, 0xb6, 0x19, 0xac, 0xaa,
+	0x55, 0x41, 0x0c, 0x17, 0x26, 0xc5, 0x7e, 0x37, 0x77, 0x3d, 0xea, 0xf8, 0xf3, 0x6a, 0x5d, 0x8e,
+	0xa2, 0x3b, 0xce, 0x26, 0x7b, 0x5d, 0x3d, 0xfd, 0xa5, 0x86, 0x37, 0xa9, 0xf3, 0xa6, 0xa8, 0xf6,
+	0x73, 0x18, 0x51, 0xc7, 0x22, 0xca, 0x4d, 0x47, 0xcb, 0xed, 0x84, 0x16, 0xd2, 0xcd, 0x9b, 0xeb,
+	0xc6, 0x7d, 0x78, 0x4f, 0x0e, 0x45, 0xea, 0x14, 0x7d, 0x96, 0xe8, 0x01, 0xfe, 0xcb, 0xec, 0xaa,
+	0xe0, 0xe7, 0xbd, 0x0d, 0x12, 0x05, 0xad, 0xc1, 0x89, 0xc0, 0x6a, 0xa0, 0x98, 0x0f, 0xba, 0x8c,
+	0xdc, 0x30, 0x88, 0xaa, 0xa3, 0x81, 0x62, 0xdc, 0xc3, 0xfe, 0x5a, 0x97, 0x56, 0x43, 0xbd, 0x90,
+	0x47, 0x11, 0xb0, 0xaf, 0x35, 0xa6, 0xb9, 0x82, 0xd7, 0x68, 0xbe, 0x11, 0xc5, 0xd1, 0xf4, 0x30,
+	0x30, 0x9a, 0x20, 0xe1, 0x43, 0x68, 0x62, 0x93, 0x87, 0x00, 0x56, 0xb9, 0xcc, 0x9e, 0x58, 0x4e,
+	0x81, 0xf2, 0xe4, 0xa0, 0x40, 0x9c, 0xeb, 0x05, 0x71, 0x39, 0xc8, 0x52, 0xa1, 0x15, 0xac, 0xec,
+	0xd7, 0xa7, 0xe0, 0x4d, 0x21, 0x85, 0x7c, 0xa3, 0x41, 0x42, 0xfa, 0x17, 0x72, 0x31, 0x1a, 0xba,
+	0xdd, 0x36, 0xe9, 0x73, 0x3d, 0x46, 0xcb, 0xfa, 0x18, 0x33, 0x5f, 0xfd, 0xfe, 0xf7, 0x77, 0x83,
+	0x06, 0x99, 0x34, 0x63, 0xfc, 0x28, 0xf9, 0x59, 0x83, 0x11, 0xf5, 0x93, 0x46, 0xb2, 0x31, 0x3b,
+	0x75, 0x98, 0x33, 0xfa, 0xa5, 0xbe, 0x72, 0x90, 0xe3, 0x15, 0xc1, 0xf1, 0x23, 0x92, 0x35, 0x7b,
+	0xf3, 0xb8, 0xdc, 0x7c, 0x8a, 0xbd, 0xb1, 0x47, 0x7e, 0xd1, 0xe0, 0x74, 0xab, 0x9f, 0x20, 0x0b,
+	0x31, 0x2c, 0x22, 0x9c, 0x8e, 0xbe, 0xd8, 0x77, 0x1e, 0x2a, 0x98, 0x17, 0x0a, 0x66, 0xc9, 0x4c,
+	0xaf, 0x0a, 0xc8, 0xaf, 0x1a, 0x8c, 0xb6, 0xb9, 0x00, 0xb2, 0xd8, 0x47, 0xf9, 0x54, 0xdf, 0xa1,
+	0x2f, 0xf5, 0x9f, 0x88, 0xd4, 0x2f, 0x0b, 0xea, 0x26, 0x99, 0xeb, 0x91, 0x7a, 0x5e, 0xd8, 0x0e,
+	0x72, 0xa0, 0xc1, 0x3b, 0x1d, 0x27, 0x21, 0xb9, 0x1a, 0x43, 0xa5, 0x9b, 0x4f, 0xd1, 0x3f, 0x3e,
+	0x5a, 0x32, 0x6a, 0x59, 0x15, 0x5a, 0x3e, 0x25, 0xb7, 0xa2, 0xb5, 0xd4, 0x02, 0x80, 0x7c, 0x6b,
+	0x4b, 0xb5, 0xcd, 0x92, 0x3d, 0xe2, 0xdb, 0xfe, 0xc8, 0x89, 0x4f, 0x3e, 0x89, 0xef, 0x96, 0xae,
+	0xee, 0x44, 0xbf, 0x7e, 0x74, 0x80, 0xde, 0x6f, 0x4e, 0x94, 0x60, 0xf2, 0x83, 0x06, 0xc3, 0xca,
+	0x28, 0x26, 0x99, 0x38, 0x36, 0x6d, 0x6e, 0x41, 0xcf, 0xf6, 0x93, 0x82, 0x94, 0x17, 0x05, 0xe5,
+	0x0c, 0x31, 0xcd, 0x5e, 0xfe, 0x98, 0xe6, 0xe6, 0x53, 0xe9, 0x40, 0xf6, 0xc8, 0x2b, 0x0d, 0xc6,
+	0x3a, 0xcd, 0x52, 0x72, 0x25, 0x86, 0x45, 0x17, 0x0f, 0xa1, 0x5f, 0x3d, 0x52, 0x2e, 0x4a, 0xb9,
+	0x27, 0xa4, 0xdc, 0x26, 0x37, 0xa3, 0xa5, 0xd0, 0x20, 0x3f, 0xaf, 0x9a, 0x86, 0xce, 0xdd, 0xf6,
+	0x5c, 0x83, 0x53, 0x2d, 0xf3, 0x95, 0x5c, 0x8e, 0xfb, 0xda, 0x77, 0xf4, 0x09, 0xfa, 0x42, 0xbf,
+	0x69, 0xa8, 0xe8, 0x9a, 0x50, 0xb4, 0x44, 0x16, 0xba, 0x4c, 0x0b, 0x99, 0x9a, 0xc7, 0x69, 0x6f,
+	0x53, 0xf5, 0x6b, 0xfc, 0xa3, 0x06, 0xc3, 0xca, 0x94, 0x8e, 0xed, 0xa9, 0x76, 0x87, 0xa0, 0x67,
+	0xfb, 0x49, 0x41, 0xda, 0x4b, 0x82, 0x76, 0x96, 0xcc, 0x9b, 0xbd, 0xfc, 0xec, 0xa1, 0x10, 0x5e,
+	0x59, 0xdc, 0x3f, 0x48, 0x69, 0x2f, 0x0e, 0x52, 0xda, 0x5f, 0x07, 0x29, 0xed, 0xdb, 0xc3, 0xd4,
+	0xc0, 0x8b, 0xc3, 0xd4, 0xc0, 0x1f, 0x87, 0xa9, 0x81, 0x47, 0x13, 0x01, 0xd4, 0x6e, 0x0b, 0x98,
+	0x57, 0xaf, 0x50, 0xbe, 0x91, 0x10, 0x3f, 0x6d, 0x5c, 0xfa, 0x37, 0x00, 0x00, 0xff, 0xff, 0x04,
+	0x50, 0xe8, 0x57, 0xad, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// SecondaryKey queries the secondary key registered for an account.
+	SecondaryKey(ctx context.Context, in *QuerySecondaryKeyRequest, opts ...grpc.CallOption) (*QuerySecondaryKeyResponse, error)
+	// AllSecondaryKeys queries the secondary keys of all accounts.
+	AllSecondaryKeys(ctx context.Context, in *QueryAllSecondaryKeysRequest, opts ...grpc.CallOption) (*QueryAllSecondaryKeysResponse, error)
+	// SecondaryKeyOwner queries the accounts a secondary public key is
+	// registered for.
+	SecondaryKeyOwner(ctx context.Context, in *QuerySecondaryKeyOwnerRequest, opts ...grpc.CallOption) (*QuerySecondaryKeyOwnerResponse, error)
+	// ValidatorSecondaryKey queries the secondary key of a validator.
+	ValidatorSecondaryKey(ctx context.Context, in *QueryValidatorSecondaryKeyRequest, opts ...grpc.CallOption) (*QueryValidatorSecondaryKeyResponse, error)
+	// AllValidatorSecondaryKeys queries the secondary keys of all validators.
+	AllValidatorSecondaryKeys(ctx context.Context, in *QueryAllValidatorSecondaryKeysRequest, opts ...grpc.CallOption) (*QueryAllValidatorSecondaryKeysResponse, error)
+	// Attestation queries the validator signatures over the block at a height.
+	// Attestations are kept for the attestation_retention_window param and
+	// pruned after, so older heights return NotFound.
+	Attestation(ctx context.Context, in *QueryAttestationRequest, opts ...grpc.CallOption) (*QueryAttestationResponse, error)
+	// ExtensionSigningInfo queries how many vote extensions a validator missed
+	// in the signed blocks window.
+	ExtensionSigningInfo(ctx context.Context, in *QueryExtensionSigningInfoRequest, opts ...grpc.CallOption) (*QueryExtensionSigningInfoResponse, error)
+	// PendingRecovery queries the pending recovery of an account.
+	PendingRecovery(ctx context.Context, in *QueryPendingRecoveryRequest, opts ...grpc.CallOption) (*QueryPendingRecoveryResponse, error)
+	// SessionKeys queries the session keys of an account with their remaining
+	// allowances.
+	SessionKeys(ctx context.Context, in *QuerySessionKeysRequest, opts ...grpc.CallOption) (*QuerySessionKeysResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SecondaryKey(ctx context.Context, in *QuerySecondaryKeyRequest, opts ...grpc.CallOption) (*QuerySecondaryKeyResponse, error) {
+	out := new(QuerySecondaryKeyResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/SecondaryKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllSecondaryKeys(ctx context.Context, in *QueryAllSecondaryKeysRequest, opts ...grpc.CallOption) (*QueryAllSecondaryKeysResponse, error) {
+	out := new(QueryAllSecondaryKeysResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/AllSecondaryKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SecondaryKeyOwner(ctx context.Context, in *QuerySecondaryKeyOwnerRequest, opts ...grpc.CallOption) (*QuerySecondaryKeyOwnerResponse, error) {
+	out := new(QuerySecondaryKeyOwnerResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/SecondaryKeyOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidatorSecondaryKey(ctx context.Context, in *QueryValidatorSecondaryKeyRequest, opts ...grpc.CallOption) (*QueryValidatorSecondaryKeyResponse, error) {
+	out := new(QueryValidatorSecondaryKeyResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/ValidatorSecondaryKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllValidatorSecondaryKeys(ctx context.Context, in *QueryAllValidatorSecondaryKeysRequest, opts ...grpc.CallOption) (*QueryAllValidatorSecondaryKeysResponse, error) {
+	out := new(QueryAllValidatorSecondaryKeysResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/AllValidatorSecondaryKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Attestation(ctx context.Context, in *QueryAttestationRequest, opts ...grpc.CallOption) (*QueryAttestationResponse, error) {
+	out := new(QueryAttestationResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/Attestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExtensionSigningInfo(ctx context.Context, in *QueryExtensionSigningInfoRequest, opts ...grpc.CallOption) (*QueryExtensionSigningInfoResponse, error) {
+	out := new(QueryExtensionSigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/ExtensionSigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingRecovery(ctx context.Context, in *QueryPendingRecoveryRequest, opts ...grpc.CallOption) (*QueryPendingRecoveryResponse, error) {
+	out := new(QueryPendingRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/PendingRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SessionKeys(ctx context.Context, in *QuerySessionKeysRequest, opts ...grpc.CallOption) (*QuerySessionKeysResponse, error) {
+	out := new(QuerySessionKeysResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Query/SessionKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// SecondaryKey queries the secondary key registered for an account.
+	SecondaryKey(context.Context, *QuerySecondaryKeyRequest) (*QuerySecondaryKeyResponse, error)
+	// AllSecondaryKeys queries the secondary keys of all accounts.
+	AllSecondaryKeys(context.Context, *QueryAllSecondaryKeysRequest) (*QueryAllSecondaryKeysResponse, error)
+	// SecondaryKeyOwner queries the accounts a secondary public key is
+	// registered for.
+	SecondaryKeyOwner(context.Context, *QuerySecondaryKeyOwnerRequest) (*QuerySecondaryKeyOwnerResponse, error)
+	// ValidatorSecondaryKey queries the secondary key of a validator.
+	ValidatorSecondaryKey(context.Context, *QueryValidatorSecondaryKeyRequest) (*QueryValidatorSecondaryKeyResponse, error)
+	// AllValidatorSecondaryKeys queries the secondary keys of all validators.
+	AllValidatorSecondaryKeys(context.Context, *QueryAllValidatorSecondaryKeysRequest) (*QueryAllValidatorSecondaryKeysResponse, error)
+	// Attestation queries the validator signatures over the block at a height.
+	// Attestations are kept for the attestation_retention_window param and
+	// pruned after, so older heights return NotFound.
+	Attestation(context.Context, *QueryAttestationRequest) (*QueryAttestationResponse, error)
+	// ExtensionSigningInfo queries how many vote extensions a validator missed
+	// in the signed blocks window.
+	ExtensionSigningInfo(context.Context, *QueryExtensionSigningInfoRequest) (*QueryExtensionSigningInfoResponse, error)
+	// PendingRecovery queries the pending recovery of an account.
+	PendingRecovery(context.Context, *QueryPendingRecoveryRequest) (*QueryPendingRecoveryResponse, error)
+	// SessionKeys queries the session keys of an account with their remaining
+	// allowances.
+	SessionKeys(context.Context, *QuerySessionKeysRequest) (*QuerySessionKeysResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) SecondaryKey(ctx context.Context, req *QuerySecondaryKeyRequest) (*QuerySecondaryKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecondaryKey not implemented")
+}
+func (*UnimplementedQueryServer) AllSecondaryKeys(ctx context.Context, req *QueryAllSecondaryKeysRequest) (*QueryAllSecondaryKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllSecondaryKeys not implemented")
+}
+func (*UnimplementedQueryServer) SecondaryKeyOwner(ctx context.Context, req *QuerySecondaryKeyOwnerRequest) (*QuerySecondaryKeyOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SecondaryKeyOwner not implemented")
+}
+func (*UnimplementedQueryServer) ValidatorSecondaryKey(ctx context.Context, req *QueryValidatorSecondaryKeyRequest) (*QueryValidatorSecondaryKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidatorSecondaryKey not implemented")
+}
+func (*UnimplementedQueryServer) AllValidatorSecondaryKeys(ctx context.Context, req *QueryAllValidatorSecondaryKeysRequest) (*QueryAllValidatorSecondaryKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllValidatorSecondaryKeys not implemented")
+}
+func (*UnimplementedQueryServer) Attestation(ctx context.Context, req *QueryAttestationRequest) (*QueryAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Attestation not implemented")
+}
+func (*UnimplementedQueryServer) ExtensionSigningInfo(ctx context.Context, req *QueryExtensionSigningInfoRequest) (*QueryExtensionSigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtensionSigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) PendingRecovery(ctx context.Context, req *QueryPendingRecoveryRequest) (*QueryPendingRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRecovery not implemented")
+}
+func (*UnimplementedQueryServer) SessionKeys(ctx context.Context, req *QuerySessionKeysRequest) (*QuerySessionKeysResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SessionKeys not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SecondaryKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySecondaryKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SecondaryKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Query/SecondaryKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SecondaryKey(ctx, req.(*QuerySecondaryKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllSecondaryKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllSecondaryKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllSecondaryKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Query/AllSecondaryKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllSecondaryKeys(ctx, req.(*QueryAllSecondaryKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SecondaryKeyOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySecondaryKeyOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SecondaryKeyOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Query/SecondaryKeyOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SecondaryKeyOwner(ctx, req.(*QuerySecondaryKeyOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidatorSecondaryKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidatorSecondaryKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidatorSecondaryKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Query/ValidatorSecondaryKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidatorSecondaryKey(ctx, req.(*QueryValidatorSecondaryKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllValidatorSecondaryKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllValidatorSecondaryKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllValidatorSecondaryKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Query/AllValidatorSecondaryKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllValidatorSecondaryKeys(ctx, req.(*QueryAllValidatorSecondaryKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Attestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Query/Attestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attestation(ctx, req.(*QueryAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExtensionSigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExtensionSigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExtensionSigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Query/ExtensionSigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExtensionSigningInfo(ctx, req.(*QueryExtensionSigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRecoveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Query/PendingRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRecovery(ctx, req.(*QueryPendingRecoveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SessionKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySessionKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SessionKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Query/SessionKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SessionKeys(ctx, req.(*QuerySessionKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "example.secondarykeys.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "SecondaryKey",
+			Handler:    _Query_SecondaryKey_Handler,
+		},
+		{
+			MethodName: "AllSecondaryKeys",
+			Handler:    _Query_AllSecondaryKeys_Handler,
+		},
+		{
+			MethodName: "SecondaryKeyOwner",
+			Handler:    _Query_SecondaryKeyOwner_Handler,
+		},
+		{
+			MethodName: "ValidatorSecondaryKey",
+			Handler:    _Query_ValidatorSecondaryKey_Handler,
+		},
+		{
+			MethodName: "AllValidatorSecondaryKeys",
+			Handler:    _Query_AllValidatorSecondaryKeys_Handler,
+		},
+		{
+			MethodName: "Attestation",
+			Handler:    _Query_Attestation_Handler,
+		},
+		{
+			MethodName: "ExtensionSigningInfo",
+			Handler:    _Query_ExtensionSigningInfo_Handler,
+		},
+		{
+			MethodName: "PendingRecovery",
+			Handler:    _Query_PendingRecovery_Handler,
+		},
+		{
+			MethodName: "SessionKeys",
+			Handler:    _Query_SessionKeys_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "example/secondarykeys/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySecondaryKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecondaryKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecondaryKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySecondaryKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecondaryKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecondaryKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SecondarySignatureRequired {
+		i--
+		if m.SecondarySignatureRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Locked {
+		i--
+		if m.Locked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SecondaryKey != nil {
+		{
+			size, err := m.SecondaryKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSecondaryKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSecondaryKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSecondaryKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllSecondaryKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllSecondaryKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllSecondaryKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SecondaryKeys) > 0 {
+		for iNdEx := len(m.SecondaryKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SecondaryKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySecondaryKeyOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecondaryKeyOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecondaryKeyOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySecondaryKeyOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySecondaryKeyOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySecondaryKeyOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owners) > 0 {
+		for iNdEx := len(m.Owners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Owners[iNdEx])
+			copy(dAtA[i:], m.Owners[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Owners[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSecondaryKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSecondaryKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSecondaryKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidatorSecondaryKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidatorSecondaryKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidatorSecondaryKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SecondaryKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllValidatorSecondaryKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllValidatorSecondaryKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllValidatorSecondaryKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllValidatorSecondaryKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllValidatorSecondaryKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllValidatorSecondaryKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SecondaryKeys) > 0 {
+		for iNdEx := len(m.SecondaryKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SecondaryKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExtensionSigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExtensionSigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExtensionSigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExtensionSigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExtensionSigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExtensionSigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SigningInfo.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRecoveryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRecoveryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRecoveryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Recovery.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySessionKeysRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySessionKeysRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySessionKeysRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySessionKeysResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySessionKeysResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySessionKeysResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowances) > 0 {
+		for iNdEx := len(m.Allowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.SessionKeys) > 0 {
+		for iNdEx := len(m.SessionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SessionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySecondaryKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySecondaryKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SecondaryKey != nil {
+		l = m.SecondaryKey.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Revoked {
+		n += 2
+	}
+	if m.Locked {
+		n += 2
+	}
+	if m.SecondarySignatureRequired {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryAllSecondaryKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllSecondaryKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SecondaryKeys) > 0 {
+		for _, e := range m.SecondaryKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySecondaryKeyOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySecondaryKeyOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Owners) > 0 {
+		for _, s := range m.Owners {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryValidatorSecondaryKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidatorSecondaryKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SecondaryKey.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllValidatorSecondaryKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllValidatorSecondaryKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SecondaryKeys) > 0 {
+		for _, e := range m.SecondaryKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Attestation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExtensionSigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExtensionSigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SigningInfo.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingRecoveryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Recovery.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySessionKeysRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySessionKeysResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SessionKeys) > 0 {
+		for _, e := range m.SessionKeys {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Allowances) > 0 {
+		for _, e := range m.Allowances {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySecondaryKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecondaryKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecondaryKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySecondaryKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecondaryKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecondaryKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SecondaryKey == nil {
+				m.SecondaryKey = &AccountKey{}
+			}
+			if err := m.SecondaryKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Locked = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondarySignatureRequired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SecondarySignatureRequired = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSecondaryKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSecondaryKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSecondaryKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllSecondaryKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllSecondaryKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllSecondaryKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondaryKeys = append(m.SecondaryKeys, AccountKey{})
+			if err := m.SecondaryKeys[len(m.SecondaryKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySecondaryKeyOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecondaryKeyOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecondaryKeyOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySecondaryKeyOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySecondaryKeyOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySecondaryKeyOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owners = append(m.Owners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSecondaryKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSecondaryKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSecondaryKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidatorSecondaryKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidatorSecondaryKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidatorSecondaryKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SecondaryKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllValidatorSecondaryKeysRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllValidatorSecondaryKeysRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllValidatorSecondaryKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllValidatorSecondaryKeysResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllValidatorSecondaryKeysResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllValidatorSecondaryKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondaryKeys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondaryKeys = append(m.SecondaryKeys, ValidatorKey{})
+			if err := m.SecondaryKeys[len(m.SecondaryKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExtensionSigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExtensionSigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExtensionSigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExtensionSigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExtensionSigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExtensionSigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SigningInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPendingRecoveryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRecoveryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRecoveryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
+func (m *QueryPendingRecoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recovery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Recovery.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySessionKeysRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -347,12 +4181,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySessionKeysRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySessionKeysRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -374,7 +4240,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySessionKeysResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -397,15 +4263,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySessionKeysResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySessionKeysResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -432,7 +4298,42 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SessionKeys = append(m.SessionKeys, SessionKey{})
+			if err := m.SessionKeys[len(m.SessionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowances = append(m.Allowances, SessionKeyAllowance{})
+			if err := m.Allowances[len(m.Allowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"encoding/binary"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// recoverAccountDomain separates account recovery digests from the other
+// digests signed by secondary keys.
+const recoverAccountDomain = "recover_account"
+
+var (
+	_ codectypes.UnpackInterfacesMessage = PendingRecovery{}
+	_ codectypes.UnpackInterfacesMessage = MsgRecoverAccount{}
+	_ codectypes.UnpackInterfacesMessage = GenesisState{}
+)
+
+// RecoverAccountBytes returns the digest the secondary key set of account
+// signs to recover it to newPubKey. It commits to the account number, the
+// sequence and the current primary public key of the account, nil if it has
+// none, so the signatures are void once the account sends a transaction,
+// such as MsgCancelRecovery, or is recovered.
+func RecoverAccountBytes(account sdk.AccAddress, accountNumber, sequence uint64, currentPubKey, newPubKey cryptotypes.PubKey) ([]byte, error) {
+	current, err := marshalPubKey(currentPubKey)
+	if err != nil {
+		return nil, err
+	}
+	next, err := marshalPubKey(newPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := make([]byte, 0, len(ModuleName)+len(recoverAccountDomain)+len(account)+8+8+8+len(current)+8+len(next))
+	msg = append(msg, ModuleName...)
+	msg = append(msg, recoverAccountDomain...)
+	msg = append(msg, account...)
+	msg = binary.BigEndian.AppendUint64(msg, accountNumber)
+	msg = binary.BigEndian.AppendUint64(msg, sequence)
+	msg = binary.BigEndian.AppendUint64(msg, uint64(len(current)))
+	msg = append(msg, current...)
+	msg = binary.BigEndian.AppendUint64(msg, uint64(len(next)))
+	msg = append(msg, next...)
+	return crypto.Keccak256(msg), nil
+}
+
+// marshalPubKey encodes pubKey as an Any, so the digest commits to the type of
+// the key along with its bytes.
+func marshalPubKey(pubKey cryptotypes.PubKey) ([]byte, error) {
+	if pubKey == nil {
+		return nil, nil
+	}
+	pubKeyAny, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return nil, err
+	}
+	return pubKeyAny.Marshal()
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (r PendingRecovery) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(r.NewPublicKey, &pubKey)
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (msg MsgRecoverAccount) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPublicKey, &pubKey)
+}
+
+// UnpackInterfaces implements codectypes.UnpackInterfacesMessage.
+func (gs GenesisState) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	for _, recovery := range gs.PendingRecoveries {
+		if err := recovery.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+	return nil
+}
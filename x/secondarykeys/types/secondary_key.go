@@ -0,0 +1,248 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"encoding/binary"
+	"math/big"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// Secp256k1PubKeySize is the length of an uncompressed secp256k1 public
+	// key.
+	Secp256k1PubKeySize = 65
+	// Ed25519PubKeySize is the length of an ed25519 public key.
+	Ed25519PubKeySize = ed25519.PublicKeySize
+	// Secp256r1PubKeySize is the length of a compressed P-256 public key.
+	Secp256r1PubKeySize = 33
+	// SchnorrPubKeySize is the length of an x-only BIP-340 public key.
+	SchnorrPubKeySize = schnorr.PubKeyBytesLen
+)
+
+// secp256r1HalfOrder is half the order of P-256. Signatures with a larger s
+// are rejected, so that a signature cannot be altered into another valid one.
+var secp256r1HalfOrder = new(big.Int).Rsh(elliptic.P256().Params().N, 1)
+
+// rotationDomain separates rotation digests from proofs of possession.
+const rotationDomain = "rotate"
+
+// validatorDomain separates validator proofs of possession from account
+// proofs of possession, since an operator address has the same bytes as the
+// operator's account address.
+const validatorDomain = "validator"
+
+// ProofOfPossessionBytes returns the digest a secondary key has to sign to
+// prove possession when it is registered for sender. Binding the sender
+// prevents a registration from being replayed for another account.
+func ProofOfPossessionBytes(sender sdk.AccAddress, pubKey []byte) []byte {
+	msg := make([]byte, 0, len(ModuleName)+len(sender)+len(pubKey))
+	msg = append(msg, ModuleName...)
+	msg = append(msg, sender...)
+	msg = append(msg, pubKey...)
+	return crypto.Keccak256(msg)
+}
+
+// ValidatorProofOfPossessionBytes returns the digest a secondary key has to
+// sign to prove possession when it is registered for the validator operator.
+func ValidatorProofOfPossessionBytes(operator sdk.ValAddress, pubKey []byte) []byte {
+	msg := make([]byte, 0, len(ModuleName)+len(validatorDomain)+len(operator)+len(pubKey))
+	msg = append(msg, ModuleName...)
+	msg = append(msg, validatorDomain...)
+	msg = append(msg, operator...)
+	msg = append(msg, pubKey...)
+	return crypto.Keccak256(msg)
+}
+
+// RotationBytes returns the digest both the current and the new secondary key
+// sign to rotate sender's key. The rotation sequence makes every rotation
+// signature single use.
+func RotationBytes(sender sdk.AccAddress, sequence uint64, currentPubKey, newPubKey []byte) []byte {
+	msg := make([]byte, 0, len(ModuleName)+len(rotationDomain)+len(sender)+8+len(currentPubKey)+len(newPubKey))
+	msg = append(msg, ModuleName...)
+	msg = append(msg, rotationDomain...)
+	msg = append(msg, sender...)
+	msg = binary.BigEndian.AppendUint64(msg, sequence)
+	msg = append(msg, currentPubKey...)
+	msg = append(msg, newPubKey...)
+	return crypto.Keccak256(msg)
+}
+
+// ParseKeyType parses a key type given by its enum name or, as on the CLI,
+// by its lower case name without the KEY_TYPE_ prefix, such as "secp256k1".
+func ParseKeyType(s string) (KeyType, error) {
+	name := strings.ToUpper(s)
+	if !strings.HasPrefix(name, "KEY_TYPE_") {
+		name = "KEY_TYPE_" + name
+	}
+	keyType, ok := KeyType_value[name]
+	if !ok || keyType == int32(KeyType_KEY_TYPE_UNSPECIFIED) {
+		return KeyType_KEY_TYPE_UNSPECIFIED, errorsmod.Wrapf(ErrInvalidKeyType, "unknown key type %q", s)
+	}
+	return KeyType(keyType), nil
+}
+
+// NewSecondaryPubKey returns the SecondaryPubKey of the given type.
+func NewSecondaryPubKey(keyType KeyType, key []byte) SecondaryPubKey {
+	return SecondaryPubKey{KeyType: keyType, Key: key}
+}
+
+// ValidateAccountKey checks that pk can be registered for an account, see
+// ValidateAccountPublicKey.
+func (pk SecondaryPubKey) ValidateAccountKey() error {
+	return ValidateAccountPublicKey(pk.KeyType, pk.Key)
+}
+
+// VerifySignature verifies sig over the 32 byte digest hash with the key of
+// pk, by its algorithm. WebAuthn assertions must be made for one of the
+// relying party IDs rpIDs, see VerifyWebAuthnAssertion.
+func (pk SecondaryPubKey) VerifySignature(hash, sig []byte, rpIDs []string) error {
+	if pk.KeyType == KeyType_KEY_TYPE_WEBAUTHN {
+		return VerifyWebAuthnAssertion(pk.Key, hash, sig, rpIDs)
+	}
+	if !VerifySignature(pk.KeyType, pk.Key, hash, sig) {
+		return errorsmod.Wrapf(ErrInvalidSecondarySignature, "%s signature verification failed", pk.KeyType)
+	}
+	return nil
+}
+
+// ValidatorKeyType returns the type of the validator key pubKey. Validator
+// keys are stored without their type and told apart by their length.
+func ValidatorKeyType(pubKey []byte) KeyType {
+	switch len(pubKey) {
+	case Secp256k1PubKeySize:
+		return KeyType_KEY_TYPE_SECP256K1
+	case BLS12381PubKeySize:
+		return KeyType_KEY_TYPE_BLS12_381
+	default:
+		return KeyType_KEY_TYPE_UNSPECIFIED
+	}
+}
+
+// ValidateAccountPublicKey checks that pubKey is a well formed key of the
+// given type that can be registered for an account. Accounts sign
+// transactions with any key type but BLS12-381, which only validators use.
+func ValidateAccountPublicKey(keyType KeyType, pubKey []byte) error {
+	if keyType == KeyType_KEY_TYPE_BLS12_381 {
+		return errorsmod.Wrapf(ErrInvalidKeyType, "account keys cannot be %s", keyType)
+	}
+	return ValidatePublicKey(keyType, pubKey)
+}
+
+// ValidatePublicKey checks that pubKey is a well formed key of the given type.
+func ValidatePublicKey(keyType KeyType, pubKey []byte) error {
+	switch keyType {
+	case KeyType_KEY_TYPE_SECP256K1:
+		if len(pubKey) != Secp256k1PubKeySize {
+			return errorsmod.Wrapf(ErrInvalidPublicKey, "expected %d bytes, got %d", Secp256k1PubKeySize, len(pubKey))
+		}
+		if _, err := crypto.UnmarshalPubkey(pubKey); err != nil {
+			return errorsmod.Wrap(ErrInvalidPublicKey, err.Error())
+		}
+		return nil
+	case KeyType_KEY_TYPE_BLS12_381:
+		_, err := bls12381PubKey(pubKey)
+		return err
+	case KeyType_KEY_TYPE_ED25519:
+		if len(pubKey) != Ed25519PubKeySize {
+			return errorsmod.Wrapf(ErrInvalidPublicKey, "expected %d bytes, got %d", Ed25519PubKeySize, len(pubKey))
+		}
+		return nil
+	case KeyType_KEY_TYPE_SECP256R1, KeyType_KEY_TYPE_WEBAUTHN:
+		if len(pubKey) != Secp256r1PubKeySize {
+			return errorsmod.Wrapf(ErrInvalidPublicKey, "expected %d bytes, got %d", Secp256r1PubKeySize, len(pubKey))
+		}
+		if _, ok := secp256r1PubKey(pubKey); !ok {
+			return errorsmod.Wrap(ErrInvalidPublicKey, "invalid P-256 point")
+		}
+		return nil
+	case KeyType_KEY_TYPE_SCHNORR:
+		if len(pubKey) != SchnorrPubKeySize {
+			return errorsmod.Wrapf(ErrInvalidPublicKey, "expected %d bytes, got %d", SchnorrPubKeySize, len(pubKey))
+		}
+		if _, err := schnorr.ParsePubKey(pubKey); err != nil {
+			return errorsmod.Wrap(ErrInvalidPublicKey, err.Error())
+		}
+		return nil
+	default:
+		return errorsmod.Wrapf(ErrInvalidKeyType, "unsupported key type %s", keyType)
+	}
+}
+
+// VerifySignature verifies sig over the 32 byte digest hash with pubKey. The
+// recovery byte of a 65 byte secp256k1 signature is ignored. WebAuthn
+// assertions depend on the allowed relying party IDs and are verified by
+// SecondaryPubKey.VerifySignature instead.
+func VerifySignature(keyType KeyType, pubKey, hash, sig []byte) bool {
+	switch keyType {
+	case KeyType_KEY_TYPE_SECP256K1:
+		if len(sig) == 65 {
+			sig = sig[:64]
+		}
+		return len(sig) == 64 && crypto.VerifySignature(pubKey, hash, sig)
+	case KeyType_KEY_TYPE_BLS12_381:
+		return verifyBLS12381(pubKey, hash, sig, bls12381SignatureDST)
+	case KeyType_KEY_TYPE_ED25519:
+		return len(pubKey) == Ed25519PubKeySize && ed25519.Verify(pubKey, hash, sig)
+	case KeyType_KEY_TYPE_SECP256R1:
+		return verifySecp256r1(pubKey, hash, sig)
+	case KeyType_KEY_TYPE_SCHNORR:
+		return verifySchnorr(pubKey, hash, sig)
+	default:
+		return false
+	}
+}
+
+func secp256r1PubKey(pubKey []byte) (*ecdsa.PublicKey, bool) {
+	x, y := elliptic.UnmarshalCompressed(elliptic.P256(), pubKey)
+	if x == nil {
+		return nil, false
+	}
+	return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, true
+}
+
+// verifySecp256r1 verifies the r || s signature sig, rejecting signatures
+// whose s is not in the lower half of the order.
+func verifySecp256r1(pubKey, hash, sig []byte) bool {
+	if len(sig) != 64 {
+		return false
+	}
+	key, ok := secp256r1PubKey(pubKey)
+	if !ok {
+		return false
+	}
+	r, s := new(big.Int).SetBytes(sig[:32]), new(big.Int).SetBytes(sig[32:])
+	if s.Cmp(secp256r1HalfOrder) > 0 {
+		return false
+	}
+	return ecdsa.Verify(key, hash, r, s)
+}
+
+func verifySchnorr(pubKey, hash, sig []byte) bool {
+	key, err := schnorr.ParsePubKey(pubKey)
+	if err != nil {
+		return false
+	}
+	signature, err := schnorr.ParseSignature(sig)
+	if err != nil {
+		return false
+	}
+	return signature.Verify(hash, key)
+}
+
+// VerifyProofOfPossession verifies the proof of possession sig over the 32
+// byte digest hash with pubKey. A secp256k1 proof is a regular signature; a
+// BLS12-381 proof is signed with its own tag, see
+// SignBLS12381ProofOfPossession.
+func VerifyProofOfPossession(keyType KeyType, pubKey, hash, sig []byte) bool {
+	if keyType == KeyType_KEY_TYPE_BLS12_381 {
+		return verifyBLS12381(pubKey, hash, sig, bls12381ProofOfPossessionDST)
+	}
+	return VerifySignature(keyType, pubKey, hash, sig)
+}
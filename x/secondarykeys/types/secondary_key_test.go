@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"crypto/elliptic"
+	"math/big"
+	"testing"
+
+	"example/common"
+	"example/x/secondarykeys/types"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSecondaryPubKeySignatures(t *testing.T) {
+	hash := crypto.Keccak256([]byte("message"))
+	otherHash := crypto.Keccak256([]byte("other"))
+
+	tests := []struct {
+		keyType    types.KeyType
+		pubKeySize int
+	}{
+		{keyType: types.KeyType_KEY_TYPE_SECP256K1, pubKeySize: types.Secp256k1PubKeySize},
+		{keyType: types.KeyType_KEY_TYPE_ED25519, pubKeySize: types.Ed25519PubKeySize},
+		{keyType: types.KeyType_KEY_TYPE_SECP256R1, pubKeySize: types.Secp256r1PubKeySize},
+		{keyType: types.KeyType_KEY_TYPE_SCHNORR, pubKeySize: types.SchnorrPubKeySize},
+	}
+	for _, tc := range tests {
+		t.Run(tc.keyType.String(), func(t *testing.T) {
+			priv, err := common.GenSecondaryPrivKey(tc.keyType)
+			require.NoError(t, err)
+			pubKey := types.NewSecondaryPubKey(tc.keyType, priv.PubKey())
+			require.Len(t, pubKey.Key, tc.pubKeySize)
+			require.NoError(t, pubKey.ValidateAccountKey())
+
+			sig, err := priv.Sign(hash)
+			require.NoError(t, err)
+			require.NoError(t, pubKey.VerifySignature(hash, sig, nil))
+			require.ErrorIs(t, pubKey.VerifySignature(otherHash, sig, nil), types.ErrInvalidSecondarySignature)
+			require.ErrorIs(t, pubKey.VerifySignature(hash, sig[1:], nil), types.ErrInvalidSecondarySignature)
+
+			// verification dispatches by the algorithm of the key
+			for _, other := range tests {
+				if other.keyType != tc.keyType {
+					require.False(t, types.VerifySignature(other.keyType, pubKey.Key, hash, sig))
+				}
+			}
+
+			// a key of another account does not verify the signature
+			otherPriv, err := common.GenSecondaryPrivKey(tc.keyType)
+			require.NoError(t, err)
+			require.False(t, types.VerifySignature(tc.keyType, otherPriv.PubKey(), hash, sig))
+
+			require.ErrorIs(t, types.ValidatePublicKey(tc.keyType, pubKey.Key[1:]), types.ErrInvalidPublicKey)
+		})
+	}
+}
+
+func TestSecp256r1Signature(t *testing.T) {
+	priv, err := common.GenSecondaryPrivKey(types.KeyType_KEY_TYPE_SECP256R1)
+	require.NoError(t, err)
+	hash := crypto.Keccak256([]byte("message"))
+	sig, err := priv.Sign(hash)
+	require.NoError(t, err)
+	require.True(t, types.VerifySignature(types.KeyType_KEY_TYPE_SECP256R1, priv.PubKey(), hash, sig))
+
+	// the high-S twin of a signature is valid ECDSA but rejected, so that a
+	// signature cannot be altered
+	n := elliptic.P256().Params().N
+	s := new(big.Int).SetBytes(sig[32:])
+	highS := append([]byte{}, sig[:32]...)
+	highS = append(highS, new(big.Int).Sub(n, s).FillBytes(make([]byte, 32))...)
+	require.False(t, types.VerifySignature(types.KeyType_KEY_TYPE_SECP256R1, priv.PubKey(), hash, highS))
+
+	// a compressed key whose x coordinate exceeds the field size
+	offCurve := make([]byte, types.Secp256r1PubKeySize)
+	for i := range offCurve {
+		offCurve[i] = 0xff
+	}
+	offCurve[0] = 0x02
+	require.ErrorIs(t, types.ValidatePublicKey(types.KeyType_KEY_TYPE_SECP256R1, offCurve), types.ErrInvalidPublicKey)
+}
+
+func TestSchnorrPublicKey(t *testing.T) {
+	// the x coordinate of a BIP-340 key must be below the field size
+	invalid := make([]byte, types.SchnorrPubKeySize)
+	for i := range invalid {
+		invalid[i] = 0xff
+	}
+	require.ErrorIs(t, types.ValidatePublicKey(types.KeyType_KEY_TYPE_SCHNORR, invalid), types.ErrInvalidPublicKey)
+	require.ErrorIs(t, types.ValidatePublicKey(types.KeyType_KEY_TYPE_SCHNORR, make([]byte, types.SchnorrPubKeySize-1)), types.ErrInvalidPublicKey)
+}
+
+func TestSecondaryPrivKeyFromSeed(t *testing.T) {
+	seed := crypto.Keccak256([]byte("seed"))
+	for _, keyType := range []types.KeyType{
+		types.KeyType_KEY_TYPE_SECP256K1,
+		types.KeyType_KEY_TYPE_ED25519,
+		types.KeyType_KEY_TYPE_SECP256R1,
+		types.KeyType_KEY_TYPE_SCHNORR,
+	} {
+		first, err := common.SecondaryPrivKeyFromSeed(keyType, seed)
+		require.NoError(t, err)
+		second, err := common.SecondaryPrivKeyFromSeed(keyType, seed)
+		require.NoError(t, err)
+		require.Equal(t, first.PubKey(), second.PubKey())
+		require.NoError(t, types.ValidateAccountPublicKey(keyType, first.PubKey()))
+	}
+
+	_, err := common.SecondaryPrivKeyFromSeed(types.KeyType_KEY_TYPE_BLS12_381, seed)
+	require.ErrorContains(t, err, "unsupported")
+	_, err = common.SecondaryPrivKeyFromSeed(types.KeyType_KEY_TYPE_SECP256R1, make([]byte, 32))
+	require.Error(t, err)
+}
@@ -0,0 +1,293 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"slices"
+	"strings"
+	"time"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// registerSessionKeyDomain separates session key registration digests from
+// the other digests signed by secondary keys.
+const registerSessionKeyDomain = "register_session_key"
+
+// moduleMsgTypeURLPrefix prefixes the type URLs of the Msgs of this module,
+// which session keys never sign.
+const moduleMsgTypeURLPrefix = "/example.secondarykeys."
+
+// SessionKey returns the session key msg registers.
+func (msg *MsgRegisterSessionKey) SessionKey() SessionKey {
+	return SessionKey{
+		Account:      msg.Sender,
+		KeyType:      msg.KeyType,
+		PublicKey:    msg.PublicKey,
+		MsgTypeUrls:  msg.MsgTypeUrls,
+		SpendLimit:   msg.SpendLimit,
+		ExpiryHeight: msg.ExpiryHeight,
+		ExpiryTime:   msg.ExpiryTime,
+	}
+}
+
+// PubKey returns the public key of the session key.
+func (k SessionKey) PubKey() SecondaryPubKey {
+	return NewSecondaryPubKey(k.KeyType, k.PublicKey)
+}
+
+// Validate checks the key, scope, spend limit and expiry of the session key.
+// It does not check that the session key has not expired yet.
+func (k SessionKey) Validate() error {
+	if err := ValidateAccountPublicKey(k.KeyType, k.PublicKey); err != nil {
+		return err
+	}
+
+	if len(k.MsgTypeUrls) == 0 {
+		return errorsmod.Wrap(ErrInvalidSessionKey, "empty scope")
+	}
+	seen := make(map[string]struct{}, len(k.MsgTypeUrls))
+	for _, typeURL := range k.MsgTypeUrls {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return errorsmod.Wrapf(ErrInvalidSessionKey, "invalid msg type url %q", typeURL)
+		}
+		if strings.HasPrefix(typeURL, moduleMsgTypeURLPrefix) {
+			return errorsmod.Wrapf(ErrInvalidSessionKey, "%s cannot be signed by a session key", typeURL)
+		}
+		if _, ok := msgSpends[typeURL]; !ok && typeURL != msgExecTypeURL {
+			return errorsmod.Wrapf(ErrInvalidSessionKey, "%s cannot be signed by a session key, its spend is not measured", typeURL)
+		}
+		if _, ok := seen[typeURL]; ok {
+			return errorsmod.Wrapf(ErrInvalidSessionKey, "duplicate msg type url %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	if err := k.SpendLimit.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSessionKey, "invalid spend limit: %s", err)
+	}
+
+	if k.ExpiryHeight < 0 {
+		return errorsmod.Wrapf(ErrInvalidSessionKey, "negative expiry height %d", k.ExpiryHeight)
+	}
+	if k.ExpiryHeight == 0 && k.ExpiryTime == nil {
+		return errorsmod.Wrap(ErrInvalidSessionKey, "neither an expiry height nor an expiry time")
+	}
+	return nil
+}
+
+// Expired reports whether the session key can no longer sign at the given
+// block height and time.
+func (k SessionKey) Expired(height int64, blockTime time.Time) bool {
+	if k.ExpiryHeight > 0 && height >= k.ExpiryHeight {
+		return true
+	}
+	return k.ExpiryTime != nil && !blockTime.Before(*k.ExpiryTime)
+}
+
+// InScope reports whether the session key can sign for every one of msgs,
+// including the msgs nested in them, such as the msgs an authz.MsgExec
+// executes or a gov proposal carries.
+func (k SessionKey) InScope(msgs []sdk.Msg) bool {
+	return len(msgs) > 0 && k.inScope(msgs)
+}
+
+func (k SessionKey) inScope(msgs []sdk.Msg) bool {
+	for _, msg := range msgs {
+		if !slices.Contains(k.MsgTypeUrls, sdk.MsgTypeURL(msg)) {
+			return false
+		}
+		nested, err := NestedMsgs(msg)
+		if err != nil || !k.inScope(nested) {
+			return false
+		}
+	}
+	return true
+}
+
+// RegisterSessionKeyBytes returns the digest the members of sender's key set
+// and the session key sign to register key. Like a key set change it is
+// signed over the rotation sequence.
+func RegisterSessionKeyBytes(sender sdk.AccAddress, sequence uint64, key SessionKey) []byte {
+	spendLimit := key.SpendLimit.String()
+	var expiryTime int64
+	if key.ExpiryTime != nil {
+		expiryTime = key.ExpiryTime.UnixNano()
+	}
+
+	msg := make([]byte, 0, len(ModuleName)+len(registerSessionKeyDomain)+len(sender)+8+4+8+len(key.PublicKey)+8+8+len(spendLimit)+8+8)
+	msg = append(msg, ModuleName...)
+	msg = append(msg, registerSessionKeyDomain...)
+	msg = append(msg, sender...)
+	msg = binary.BigEndian.AppendUint64(msg, sequence)
+	msg = binary.BigEndian.AppendUint32(msg, uint32(key.KeyType))
+	msg = appendLengthPrefixed(msg, key.PublicKey)
+	msg = binary.BigEndian.AppendUint64(msg, uint64(len(key.MsgTypeUrls)))
+	for _, typeURL := range key.MsgTypeUrls {
+		msg = appendLengthPrefixed(msg, []byte(typeURL))
+	}
+	msg = appendLengthPrefixed(msg, []byte(spendLimit))
+	msg = binary.BigEndian.AppendUint64(msg, uint64(key.ExpiryHeight))
+	msg = binary.BigEndian.AppendUint64(msg, uint64(expiryTime))
+	return crypto.Keccak256(msg)
+}
+
+func appendLengthPrefixed(msg, bz []byte) []byte {
+	msg = binary.BigEndian.AppendUint64(msg, uint64(len(bz)))
+	return append(msg, bz...)
+}
+
+// msgExecTypeURL is the type URL of authz.MsgExec, which a session key can be
+// scoped to as it spends nothing but what the msgs it executes spend.
+var msgExecTypeURL = sdk.MsgTypeURL(&authz.MsgExec{})
+
+// spender is the account whose spend a tx is measured for.
+type spender struct {
+	addr         sdk.AccAddress
+	addressCodec address.Codec
+}
+
+// is reports whether the account address, which the address codec accepts in
+// either case, is the spender's.
+func (s spender) is(account string) bool {
+	addr, err := s.addressCodec.StringToBytes(account)
+	return err == nil && bytes.Equal(addr, s.addr)
+}
+
+// isValidator reports whether the validator operator address is the
+// spender's.
+func (s spender) isValidator(operator string) bool {
+	valAddr, err := sdk.ValAddressFromBech32(operator)
+	return err == nil && bytes.Equal(valAddr, s.addr)
+}
+
+// msgSpends holds, for each Msg type a session key can be scoped to other than
+// authz.MsgExec, the coins a Msg of the type spends from the spender. The
+// spend of other Msgs, such as authz grants, cannot be measured, so they could
+// spend beyond the spend limit and are never in the scope of a session key.
+var msgSpends = map[string]func(msg sdk.Msg, from spender) sdk.Coins{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}): func(m sdk.Msg, from spender) sdk.Coins {
+		msg := m.(*banktypes.MsgSend)
+		if from.is(msg.FromAddress) {
+			return msg.Amount
+		}
+		return nil
+	},
+	sdk.MsgTypeURL(&banktypes.MsgMultiSend{}): func(m sdk.Msg, from spender) sdk.Coins {
+		var spend sdk.Coins
+		for _, input := range m.(*banktypes.MsgMultiSend).Inputs {
+			if from.is(input.Address) {
+				spend = spend.Add(input.Coins...)
+			}
+		}
+		return spend
+	},
+	sdk.MsgTypeURL(&stakingtypes.MsgDelegate{}): func(m sdk.Msg, from spender) sdk.Coins {
+		msg := m.(*stakingtypes.MsgDelegate)
+		if from.is(msg.DelegatorAddress) {
+			return sdk.NewCoins(msg.Amount)
+		}
+		return nil
+	},
+	sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{}): func(m sdk.Msg, from spender) sdk.Coins {
+		msg := m.(*stakingtypes.MsgCreateValidator)
+		if from.isValidator(msg.ValidatorAddress) {
+			return sdk.NewCoins(msg.Value)
+		}
+		return nil
+	},
+	sdk.MsgTypeURL(&govv1.MsgSubmitProposal{}): func(m sdk.Msg, from spender) sdk.Coins {
+		msg := m.(*govv1.MsgSubmitProposal)
+		if from.is(msg.Proposer) {
+			return msg.InitialDeposit
+		}
+		return nil
+	},
+	sdk.MsgTypeURL(&govv1.MsgDeposit{}): func(m sdk.Msg, from spender) sdk.Coins {
+		msg := m.(*govv1.MsgDeposit)
+		if from.is(msg.Depositor) {
+			return msg.Amount
+		}
+		return nil
+	},
+	sdk.MsgTypeURL(&distrtypes.MsgFundCommunityPool{}): func(m sdk.Msg, from spender) sdk.Coins {
+		msg := m.(*distrtypes.MsgFundCommunityPool)
+		if from.is(msg.Depositor) {
+			return msg.Amount
+		}
+		return nil
+	},
+	sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}): func(m sdk.Msg, from spender) sdk.Coins {
+		msg := m.(*ibctransfertypes.MsgTransfer)
+		if from.is(msg.Sender) {
+			return sdk.NewCoins(msg.Token)
+		}
+		return nil
+	},
+
+	// These move no coins out of the account.
+	sdk.MsgTypeURL(&govv1.MsgVote{}):                         spendsNothing,
+	sdk.MsgTypeURL(&govv1.MsgVoteWeighted{}):                 spendsNothing,
+	sdk.MsgTypeURL(&stakingtypes.MsgUndelegate{}):            spendsNothing,
+	sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{}):       spendsNothing,
+	sdk.MsgTypeURL(&distrtypes.MsgWithdrawDelegatorReward{}): spendsNothing,
+}
+
+func spendsNothing(sdk.Msg, spender) sdk.Coins {
+	return nil
+}
+
+// NestedMsgs returns the msgs nested in msg: the msgs an authz.MsgExec
+// executes or a gov or group proposal carries.
+func NestedMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch msg := msg.(type) {
+	case interface{ GetMessages() ([]sdk.Msg, error) }:
+		return msg.GetMessages()
+	case interface{ GetMsgs() ([]sdk.Msg, error) }:
+		return msg.GetMsgs()
+	}
+	return nil, nil
+}
+
+// TxSpend returns the coins tx spends from addr: the fee if addr pays it and
+// the coins the msgs of tx, and the msgs nested in them, send, delegate,
+// deposit or transfer over IBC from addr. Only the Msgs a session key can be
+// scoped to are measured. The addresses in the msgs are decoded with
+// addressCodec, so that addr matches them in any case.
+func TxSpend(tx sdk.Tx, addr sdk.AccAddress, addressCodec address.Codec) sdk.Coins {
+	spend := sdk.NewCoins()
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		payer := sdk.AccAddress(feeTx.FeePayer())
+		if granter := feeTx.FeeGranter(); len(granter) > 0 {
+			payer = granter
+		}
+		if payer.Equals(addr) {
+			spend = spend.Add(feeTx.GetFee()...)
+		}
+	}
+	return spend.Add(msgsSpend(tx.GetMsgs(), spender{addr: addr, addressCodec: addressCodec})...)
+}
+
+// msgsSpend returns the coins msgs, and the msgs nested in them, spend from
+// the spender.
+func msgsSpend(msgs []sdk.Msg, from spender) sdk.Coins {
+	spend := sdk.NewCoins()
+	for _, msg := range msgs {
+		if msgSpend, ok := msgSpends[sdk.MsgTypeURL(msg)]; ok {
+			spend = spend.Add(msgSpend(msg, from)...)
+		}
+		if nested, err := NestedMsgs(msg); err == nil {
+			spend = spend.Add(msgsSpend(nested, from)...)
+		}
+	}
+	return spend
+}
@@ -0,0 +1,204 @@
+package types_test
+
+import (
+	"crypto/ed25519"
+	"strings"
+	"testing"
+	"time"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibctransfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	"example/testutil/sample"
+	"example/x/secondarykeys/types"
+)
+
+func TestSessionKey_Validate(t *testing.T) {
+	pubKey, _, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+	expiryTime := time.Unix(1_700_000_000, 0)
+	valid := func() types.SessionKey {
+		return types.SessionKey{
+			Account:      sample.AccAddress(),
+			KeyType:      types.KeyType_KEY_TYPE_ED25519,
+			PublicKey:    pubKey,
+			MsgTypeUrls:  []string{"/cosmos.bank.v1beta1.MsgSend"},
+			SpendLimit:   sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			ExpiryHeight: 100,
+		}
+	}
+
+	tests := []struct {
+		desc   string
+		modify func(*types.SessionKey)
+		errMsg string
+	}{
+		{
+			desc:   "expiry height",
+			modify: func(*types.SessionKey) {},
+		},
+		{
+			desc:   "expiry time",
+			modify: func(k *types.SessionKey) { k.ExpiryHeight, k.ExpiryTime = 0, &expiryTime },
+		},
+		{
+			desc:   "no spend limit",
+			modify: func(k *types.SessionKey) { k.SpendLimit = nil },
+		},
+		{
+			desc:   "malformed public key",
+			modify: func(k *types.SessionKey) { k.PublicKey = pubKey[1:] },
+			errMsg: "invalid secondary public key",
+		},
+		{
+			desc:   "empty scope",
+			modify: func(k *types.SessionKey) { k.MsgTypeUrls = nil },
+			errMsg: "empty scope",
+		},
+		{
+			desc:   "invalid msg type url",
+			modify: func(k *types.SessionKey) { k.MsgTypeUrls = []string{"cosmos.bank.v1beta1.MsgSend"} },
+			errMsg: "invalid msg type url",
+		},
+		{
+			desc:   "duplicate msg type url",
+			modify: func(k *types.SessionKey) { k.MsgTypeUrls = append(k.MsgTypeUrls, k.MsgTypeUrls[0]) },
+			errMsg: "duplicate msg type url",
+		},
+		{
+			desc:   "module msg type url",
+			modify: func(k *types.SessionKey) { k.MsgTypeUrls = []string{sdk.MsgTypeURL(&types.MsgRegisterSessionKey{})} },
+			errMsg: "cannot be signed by a session key",
+		},
+		{
+			desc: "measured msg type urls",
+			modify: func(k *types.SessionKey) {
+				k.MsgTypeUrls = []string{sdk.MsgTypeURL(&authz.MsgExec{}), sdk.MsgTypeURL(&ibctransfertypes.MsgTransfer{}), sdk.MsgTypeURL(&govv1.MsgVote{})}
+			},
+		},
+		{
+			desc:   "unmeasured msg type url",
+			modify: func(k *types.SessionKey) { k.MsgTypeUrls = []string{sdk.MsgTypeURL(&authz.MsgGrant{})} },
+			errMsg: "its spend is not measured",
+		},
+		{
+			desc:   "unknown msg type url",
+			modify: func(k *types.SessionKey) { k.MsgTypeUrls = []string{"/cosmos.bank.v1beta1.MsgBurn"} },
+			errMsg: "its spend is not measured",
+		},
+		{
+			desc:   "invalid spend limit",
+			modify: func(k *types.SessionKey) { k.SpendLimit = sdk.Coins{sdk.NewInt64Coin("stake", 0)} },
+			errMsg: "invalid spend limit",
+		},
+		{
+			desc:   "negative expiry height",
+			modify: func(k *types.SessionKey) { k.ExpiryHeight = -1 },
+			errMsg: "negative expiry height",
+		},
+		{
+			desc:   "no expiry",
+			modify: func(k *types.SessionKey) { k.ExpiryHeight = 0 },
+			errMsg: "neither an expiry height nor an expiry time",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			sessionKey := valid()
+			tc.modify(&sessionKey)
+			err := sessionKey.Validate()
+			if tc.errMsg == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.errMsg)
+		})
+	}
+}
+
+func TestSessionKey_Expired(t *testing.T) {
+	expiryTime := time.Unix(1_700_000_000, 0)
+	before := expiryTime.Add(-time.Second)
+
+	byHeight := types.SessionKey{ExpiryHeight: 100}
+	require.False(t, byHeight.Expired(99, expiryTime))
+	require.True(t, byHeight.Expired(100, before))
+
+	byTime := types.SessionKey{ExpiryTime: &expiryTime}
+	require.False(t, byTime.Expired(1_000_000, before))
+	require.True(t, byTime.Expired(1, expiryTime))
+
+	// whichever comes first
+	both := types.SessionKey{ExpiryHeight: 100, ExpiryTime: &expiryTime}
+	require.False(t, both.Expired(99, before))
+	require.True(t, both.Expired(100, before))
+	require.True(t, both.Expired(99, expiryTime))
+}
+
+func TestSessionKey_InScope(t *testing.T) {
+	sessionKey := types.SessionKey{MsgTypeUrls: []string{"/cosmos.bank.v1beta1.MsgSend"}}
+	send := &banktypes.MsgSend{}
+	delegate := &stakingtypes.MsgDelegate{}
+
+	require.True(t, sessionKey.InScope([]sdk.Msg{send, send}))
+	require.False(t, sessionKey.InScope([]sdk.Msg{send, delegate}))
+	require.False(t, sessionKey.InScope(nil))
+
+	// nested msgs must be in the scope as well as the msgs nesting them
+	exec := authz.NewMsgExec(sdk.MustAccAddressFromBech32(sample.AccAddress()), []sdk.Msg{send, delegate})
+	proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{delegate}, nil, sample.AccAddress(), "", "title", "summary", false)
+	require.NoError(t, err)
+
+	require.False(t, sessionKey.InScope([]sdk.Msg{&exec}))
+	sessionKey.MsgTypeUrls = append(sessionKey.MsgTypeUrls, sdk.MsgTypeURL(&exec))
+	require.False(t, sessionKey.InScope([]sdk.Msg{&exec}))
+	sessionKey.MsgTypeUrls = append(sessionKey.MsgTypeUrls, sdk.MsgTypeURL(delegate))
+	require.True(t, sessionKey.InScope([]sdk.Msg{&exec}))
+
+	require.False(t, sessionKey.InScope([]sdk.Msg{proposal}))
+	sessionKey.MsgTypeUrls = append(sessionKey.MsgTypeUrls, sdk.MsgTypeURL(proposal))
+	require.True(t, sessionKey.InScope([]sdk.Msg{proposal}))
+}
+
+// msgsTx is a tx of msgs alone.
+type msgsTx []sdk.Msg
+
+func (tx msgsTx) GetMsgs() []sdk.Msg { return tx }
+
+func (tx msgsTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+
+func TestTxSpend(t *testing.T) {
+	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	addr := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	other := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("stake", amount)) }
+
+	send := banktypes.NewMsgSend(addr, other, coins(1))
+	transfer := ibctransfertypes.NewMsgTransfer("transfer", "channel-0", sdk.NewInt64Coin("stake", 10), addr.String(), "receiver", clienttypes.NewHeight(0, 100), 0, "")
+	otherSend := banktypes.NewMsgSend(other, addr, coins(1000))
+	exec := authz.NewMsgExec(other, []sdk.Msg{send, otherSend})
+	proposal, err := govv1.NewMsgSubmitProposal([]sdk.Msg{send}, coins(200), addr.String(), "", "title", "summary", false)
+	require.NoError(t, err)
+	upperSend := &banktypes.MsgSend{FromAddress: strings.ToUpper(addr.String()), ToAddress: other.String(), Amount: coins(5)}
+
+	require.Equal(t, coins(1), types.TxSpend(msgsTx{send, otherSend}, addr, addressCodec))
+	require.Equal(t, coins(10), types.TxSpend(msgsTx{transfer}, addr, addressCodec))
+
+	// msgs executed through authz spend from the account they are for
+	require.Equal(t, coins(1), types.TxSpend(msgsTx{&exec}, addr, addressCodec))
+	require.Equal(t, coins(1000), types.TxSpend(msgsTx{&exec}, other, addressCodec))
+
+	// a proposal spends its deposit, and the msgs it carries
+	require.Equal(t, coins(201), types.TxSpend(msgsTx{proposal}, addr, addressCodec))
+
+	// addresses match in any case
+	require.Equal(t, coins(5), types.TxSpend(msgsTx{upperSend}, addr, addressCodec))
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/binary"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// txDomain separates transaction digests from the other digests signed by
+// secondary keys.
+const txDomain = "tx"
+
+// voteExtensionDomain separates vote extension digests from the other digests
+// signed by secondary keys.
+const voteExtensionDomain = "vote_extension"
+
+// protoTx is implemented by the transactions of the x/auth tx config.
+type protoTx interface {
+	GetProtoTx() *txtypes.Tx
+}
+
+// IsSecondarySignatureExtension reports whether the extension option any
+// carries a secondary signature.
+func IsSecondarySignatureExtension(any *codectypes.Any) bool {
+	return any.TypeUrl == "/"+proto.MessageName(&SecondarySignatureExtension{})
+}
+
+// StripSecondarySignature returns a copy of body without the secondary
+// signature it carries, either as an extension option or in the legacy memo.
+func StripSecondarySignature(body *txtypes.TxBody) *txtypes.TxBody {
+	stripped := *body
+	stripped.ExtensionOptions = nil
+	for _, opt := range body.ExtensionOptions {
+		if !IsSecondarySignatureExtension(opt) {
+			stripped.ExtensionOptions = append(stripped.ExtensionOptions, opt)
+		}
+	}
+	if strings.HasPrefix(stripped.Memo, AnteHandlerPrefix) {
+		stripped.Memo = ""
+	}
+	return &stripped
+}
+
+// SecondarySignBytes returns the digest a secondary key signs to authorise a
+// transaction. It hashes the SIGN_MODE_DIRECT sign doc of the transaction with
+// the secondary signature stripped from the body, so the signature commits to
+// the messages, fee, signer sequences, chain-id and account number without
+// covering itself.
+func SecondarySignBytes(body *txtypes.TxBody, authInfoBytes []byte, chainID string, accountNumber uint64) ([]byte, error) {
+	bodyBytes, err := proto.Marshal(StripSecondarySignature(body))
+	if err != nil {
+		return nil, err
+	}
+	signDoc, err := proto.Marshal(&txtypes.SignDoc{
+		BodyBytes:     bodyBytes,
+		AuthInfoBytes: authInfoBytes,
+		ChainId:       chainID,
+		AccountNumber: accountNumber,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	msg := make([]byte, 0, len(ModuleName)+len(txDomain)+len(signDoc))
+	msg = append(msg, ModuleName...)
+	msg = append(msg, txDomain...)
+	msg = append(msg, signDoc...)
+	return crypto.Keccak256(msg), nil
+}
+
+// SecondarySignBytesFromTx returns SecondarySignBytes for a decoded tx.
+func SecondarySignBytesFromTx(tx sdk.Tx, chainID string, accountNumber uint64) ([]byte, error) {
+	ptx, ok := tx.(protoTx)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "unsupported tx type %T", tx)
+	}
+	adaptable, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrTxDecode, "unsupported tx type %T", tx)
+	}
+
+	return SecondarySignBytes(ptx.GetProtoTx().Body, adaptable.GetSigningTxData().AuthInfoBytes, chainID, accountNumber)
+}
+
+// VoteExtensionSignBytes returns the digest a validator signs with its
+// secondary key to extend its vote on the block with the given hash at height
+// on chainID. ABCI does not pass the round to ExtendVote and
+// VerifyVoteExtension, so the sign bytes do not cover it: a validator extends
+// its vote for one block per height only.
+func VoteExtensionSignBytes(chainID string, height int64, hash []byte) []byte {
+	msg := make([]byte, 0, len(ModuleName)+len(voteExtensionDomain)+8+len(chainID)+8+len(hash))
+	msg = append(msg, ModuleName...)
+	msg = append(msg, voteExtensionDomain...)
+	msg = binary.BigEndian.AppendUint64(msg, uint64(len(chainID)))
+	msg = append(msg, chainID...)
+	msg = binary.BigEndian.AppendUint64(msg, uint64(height))
+	msg = append(msg, hash...)
+	return crypto.Keccak256(msg)
+}
@@ -9,22 +9,29 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	any "github.com/cosmos/gogoproto/types/any"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -127,24 +134,31 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-// MsgBroadcastData defines the MsgBroadcastData message.
-type MsgBroadcastData struct {
+// MsgRegisterSecondaryKey defines the Msg/RegisterSecondaryKey request type.
+type MsgRegisterSecondaryKey struct {
+	// sender is the account the secondary key is registered for.
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
-	Data   string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	// key_type is the algorithm of public_key.
+	KeyType KeyType `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=example.secondarykeys.v1.KeyType" json:"key_type,omitempty"`
+	// public_key is the encoded secondary public key.
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// signature is the proof of possession of public_key, produced by signing
+	// the bytes returned by ProofOfPossessionBytes for the sender.
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *MsgBroadcastData) Reset()         { *m = MsgBroadcastData{} }
-func (m *MsgBroadcastData) String() string { return proto.CompactTextString(m) }
-func (*MsgBroadcastData) ProtoMessage()    {}
-func (*MsgBroadcastData) Descriptor() ([]byte, []int) {
+func (m *MsgRegisterSecondaryKey) Reset()         { *m = MsgRegisterSecondaryKey{} }
+func (m *MsgRegisterSecondaryKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSecondaryKey) ProtoMessage()    {}
+func (*MsgRegisterSecondaryKey) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd58f66499323b9e, []int{2}
 }
-func (m *MsgBroadcastData) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterSecondaryKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBroadcastData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterSecondaryKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBroadcastData.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterSecondaryKey.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -154,48 +168,63 @@ func (m *MsgBroadcastData) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgBroadcastData) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBroadcastData.Merge(m, src)
+func (m *MsgRegisterSecondaryKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSecondaryKey.Merge(m, src)
 }
-func (m *MsgBroadcastData) XXX_Size() int {
+func (m *MsgRegisterSecondaryKey) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBroadcastData) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBroadcastData.DiscardUnknown(m)
+func (m *MsgRegisterSecondaryKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSecondaryKey.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBroadcastData proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterSecondaryKey proto.InternalMessageInfo
 
-func (m *MsgBroadcastData) GetSender() string {
+func (m *MsgRegisterSecondaryKey) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-func (m *MsgBroadcastData) GetData() string {
+func (m *MsgRegisterSecondaryKey) GetKeyType() KeyType {
 	if m != nil {
-		return m.Data
+		return m.KeyType
 	}
-	return ""
+	return KeyType_KEY_TYPE_UNSPECIFIED
 }
 
-// MsgBroadcastDataResponse defines the MsgBroadcastDataResponse message.
-type MsgBroadcastDataResponse struct {
+func (m *MsgRegisterSecondaryKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
 }
 
-func (m *MsgBroadcastDataResponse) Reset()         { *m = MsgBroadcastDataResponse{} }
-func (m *MsgBroadcastDataResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgBroadcastDataResponse) ProtoMessage()    {}
-func (*MsgBroadcastDataResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRegisterSecondaryKey) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgRegisterSecondaryKeyResponse defines the Msg/RegisterSecondaryKey
+// response type.
+type MsgRegisterSecondaryKeyResponse struct {
+}
+
+func (m *MsgRegisterSecondaryKeyResponse) Reset()         { *m = MsgRegisterSecondaryKeyResponse{} }
+func (m *MsgRegisterSecondaryKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSecondaryKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterSecondaryKeyResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_dd58f66499323b9e, []int{3}
 }
-func (m *MsgBroadcastDataResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRegisterSecondaryKeyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBroadcastDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRegisterSecondaryKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBroadcastDataResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRegisterSecondaryKeyResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -205,371 +234,6236 @@ func (m *MsgBroadcastDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgBroadcastDataResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBroadcastDataResponse.Merge(m, src)
+func (m *MsgRegisterSecondaryKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSecondaryKeyResponse.Merge(m, src)
 }
-func (m *MsgBroadcastDataResponse) XXX_Size() int {
+func (m *MsgRegisterSecondaryKeyResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBroadcastDataResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBroadcastDataResponse.DiscardUnknown(m)
+func (m *MsgRegisterSecondaryKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSecondaryKeyResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBroadcastDataResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRegisterSecondaryKeyResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "example.secondarykeys.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "example.secondarykeys.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgBroadcastData)(nil), "example.secondarykeys.v1.MsgBroadcastData")
-	proto.RegisterType((*MsgBroadcastDataResponse)(nil), "example.secondarykeys.v1.MsgBroadcastDataResponse")
+// MsgRotateSecondaryKey defines the Msg/RotateSecondaryKey request type.
+type MsgRotateSecondaryKey struct {
+	// sender is the account whose secondary key is rotated.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// key_type is the algorithm of new_public_key.
+	KeyType KeyType `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=example.secondarykeys.v1.KeyType" json:"key_type,omitempty"`
+	// new_public_key is the secondary public key replacing the current one.
+	NewPublicKey []byte `protobuf:"bytes,3,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
+	// current_key_signature is the signature of the current secondary key over
+	// the bytes returned by RotationBytes.
+	CurrentKeySignature []byte `protobuf:"bytes,4,opt,name=current_key_signature,json=currentKeySignature,proto3" json:"current_key_signature,omitempty"`
+	// new_key_signature is the signature of the new secondary key over the
+	// bytes returned by RotationBytes.
+	NewKeySignature []byte `protobuf:"bytes,5,opt,name=new_key_signature,json=newKeySignature,proto3" json:"new_key_signature,omitempty"`
 }
 
-func init() { proto.RegisterFile("example/secondarykeys/v1/tx.proto", fileDescriptor_dd58f66499323b9e) }
-
-var fileDescriptor_dd58f66499323b9e = []byte{
-	// 416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x3f, 0xeb, 0xd3, 0x40,
-	0x18, 0xc7, 0x73, 0xfe, 0x29, 0xe4, 0xaa, 0xa8, 0x47, 0xa1, 0x69, 0xc0, 0x58, 0x03, 0x62, 0x0d,
-	0x98, 0xd8, 0x0a, 0x0a, 0xdd, 0x8c, 0xae, 0x05, 0x89, 0xb8, 0xb8, 0xc8, 0xd9, 0x1c, 0x67, 0xb1,
-	0xc9, 0x85, 0x7b, 0xce, 0xd2, 0x6c, 0xe2, 0xe8, 0xe4, 0xcb, 0x70, 0xec, 0xe0, 0x8b, 0x28, 0x4e,
-	0xc5, 0xc9, 0x49, 0xa4, 0x1d, 0x3a, 0xf8, 0x26, 0x24, 0xff, 0x28, 0x09, 0xc4, 0x5f, 0x97, 0x70,
-	0x77, 0xcf, 0xe7, 0x9e, 0xef, 0xf7, 0x7b, 0x4f, 0xf0, 0x5d, 0xb6, 0xa6, 0x51, 0xb2, 0x64, 0x1e,
-	0xb0, 0xb9, 0x88, 0x43, 0x2a, 0xd3, 0x0f, 0x2c, 0x05, 0x6f, 0x35, 0xf6, 0xd4, 0xda, 0x4d, 0xa4,
-	0x50, 0x82, 0x18, 0x25, 0xe2, 0xd6, 0x10, 0x77, 0x35, 0x36, 0x6f, 0xd1, 0x68, 0x11, 0x0b, 0x2f,
-	0xff, 0x16, 0xb0, 0xd9, 0x9f, 0x0b, 0x88, 0x04, 0x78, 0x11, 0xf0, 0xac, 0x49, 0x04, 0xbc, 0x2c,
-	0x0c, 0x8a, 0xc2, 0xdb, 0x7c, 0xe7, 0x15, 0x9b, 0xb2, 0x74, 0xaf, 0xd5, 0x43, 0x42, 0x25, 0x8d,
-	0x2a, 0xac, 0xc7, 0x05, 0x17, 0xc5, 0xf5, 0x6c, 0x55, 0x9c, 0xda, 0x3f, 0x10, 0xbe, 0x31, 0x03,
-	0xfe, 0x3a, 0x09, 0xa9, 0x62, 0x2f, 0x73, 0x9e, 0x3c, 0xc1, 0x3a, 0xfd, 0xa8, 0xde, 0x0b, 0xb9,
-	0x50, 0xa9, 0x81, 0x86, 0x68, 0xa4, 0xfb, 0xc6, 0xcf, 0xef, 0x0f, 0x7b, 0xa5, 0xea, 0xb3, 0x30,
-	0x94, 0x0c, 0xe0, 0x95, 0x92, 0x8b, 0x98, 0x07, 0x27, 0x94, 0x3c, 0xc7, 0x9d, 0x42, 0xd1, 0xb8,
-	0x34, 0x44, 0xa3, 0xee, 0x64, 0xe8, 0xb6, 0x45, 0x77, 0x0b, 0x25, 0x5f, 0xdf, 0xfe, 0xbe, 0xa3,
-	0x7d, 0x3b, 0x6e, 0x1c, 0x14, 0x94, 0x57, 0xa7, 0xd3, 0xcf, 0xc7, 0x8d, 0x73, 0x6a, 0xfa, 0xe5,
-	0xb8, 0x71, 0xee, 0x57, 0x01, 0xd7, 0x8d, 0x88, 0x0d, 0xe3, 0xf6, 0x00, 0xf7, 0x1b, 0x47, 0x01,
-	0x83, 0x44, 0xc4, 0xc0, 0x6c, 0x86, 0x6f, 0xce, 0x80, 0xfb, 0x52, 0xd0, 0x70, 0x4e, 0x41, 0xbd,
-	0xa0, 0x8a, 0x92, 0x47, 0xb8, 0x03, 0x2c, 0x0e, 0x99, 0xbc, 0x30, 0x64, 0xc9, 0x11, 0x82, 0xaf,
-	0x84, 0x54, 0xd1, 0x3c, 0x9f, 0x1e, 0xe4, 0xeb, 0x69, 0x37, 0x33, 0x5c, 0x02, 0xb6, 0x89, 0x8d,
-	0xa6, 0x4c, 0x65, 0x61, 0xf2, 0x17, 0xe1, 0xcb, 0x33, 0xe0, 0x64, 0x89, 0xaf, 0xd5, 0x9e, 0xfb,
-	0x41, 0xfb, 0x33, 0x35, 0xd2, 0x98, 0xe3, 0xb3, 0xd1, 0x4a, 0x95, 0x08, 0x7c, 0xbd, 0x9e, 0xda,
-	0xf9, 0x6f, 0x8f, 0x1a, 0x6b, 0x4e, 0xce, 0x67, 0x2b, 0x41, 0xf3, 0xea, 0xa7, 0x6c, 0x9e, 0xfe,
-	0xd3, 0xed, 0xde, 0x42, 0xbb, 0xbd, 0x85, 0xfe, 0xec, 0x2d, 0xf4, 0xf5, 0x60, 0x69, 0xbb, 0x83,
-	0xa5, 0xfd, 0x3a, 0x58, 0xda, 0x9b, 0xdb, 0x6d, 0xe3, 0x54, 0x69, 0xc2, 0xe0, 0x5d, 0x27, 0xff,
-	0x31, 0x1f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x42, 0x49, 0xa2, 0x90, 0x5b, 0x03, 0x00, 0x00,
+func (m *MsgRotateSecondaryKey) Reset()         { *m = MsgRotateSecondaryKey{} }
+func (m *MsgRotateSecondaryKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateSecondaryKey) ProtoMessage()    {}
+func (*MsgRotateSecondaryKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{4}
+}
+func (m *MsgRotateSecondaryKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateSecondaryKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateSecondaryKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRotateSecondaryKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateSecondaryKey.Merge(m, src)
+}
+func (m *MsgRotateSecondaryKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateSecondaryKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateSecondaryKey.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgRotateSecondaryKey proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// BroadcastData defines the BroadcastData RPC.
-	BroadcastData(ctx context.Context, in *MsgBroadcastData, opts ...grpc.CallOption) (*MsgBroadcastDataResponse, error)
+func (m *MsgRotateSecondaryKey) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgRotateSecondaryKey) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyType_KEY_TYPE_UNSPECIFIED
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgRotateSecondaryKey) GetNewPublicKey() []byte {
+	if m != nil {
+		return m.NewPublicKey
+	}
+	return nil
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRotateSecondaryKey) GetCurrentKeySignature() []byte {
+	if m != nil {
+		return m.CurrentKeySignature
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) BroadcastData(ctx context.Context, in *MsgBroadcastData, opts ...grpc.CallOption) (*MsgBroadcastDataResponse, error) {
-	out := new(MsgBroadcastDataResponse)
-	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/BroadcastData", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRotateSecondaryKey) GetNewKeySignature() []byte {
+	if m != nil {
+		return m.NewKeySignature
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// BroadcastData defines the BroadcastData RPC.
-	BroadcastData(context.Context, *MsgBroadcastData) (*MsgBroadcastDataResponse, error)
+// MsgRotateSecondaryKeyResponse defines the Msg/RotateSecondaryKey response
+// type.
+type MsgRotateSecondaryKeyResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgRotateSecondaryKeyResponse) Reset()         { *m = MsgRotateSecondaryKeyResponse{} }
+func (m *MsgRotateSecondaryKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateSecondaryKeyResponse) ProtoMessage()    {}
+func (*MsgRotateSecondaryKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{5}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRotateSecondaryKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) BroadcastData(ctx context.Context, req *MsgBroadcastData) (*MsgBroadcastDataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BroadcastData not implemented")
+func (m *MsgRotateSecondaryKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateSecondaryKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRotateSecondaryKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateSecondaryKeyResponse.Merge(m, src)
 }
-
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/example.secondarykeys.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRotateSecondaryKeyResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func _Msg_BroadcastData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBroadcastData)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).BroadcastData(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/example.secondarykeys.v1.Msg/BroadcastData",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BroadcastData(ctx, req.(*MsgBroadcastData))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgRotateSecondaryKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateSecondaryKeyResponse.DiscardUnknown(m)
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "example.secondarykeys.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "BroadcastData",
-			Handler:    _Msg_BroadcastData_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "example/secondarykeys/v1/tx.proto",
-}
+var xxx_messageInfo_MsgRotateSecondaryKeyResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// MsgRevokeSecondaryKey defines the Msg/RevokeSecondaryKey request type.
+type MsgRevokeSecondaryKey struct {
+	// sender is the account whose secondary key is revoked.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// lockdown makes the account reject every transaction other than a new
+	// secondary key registration until a new key is registered.
+	Lockdown bool `protobuf:"varint,2,opt,name=lockdown,proto3" json:"lockdown,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRevokeSecondaryKey) Reset()         { *m = MsgRevokeSecondaryKey{} }
+func (m *MsgRevokeSecondaryKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSecondaryKey) ProtoMessage()    {}
+func (*MsgRevokeSecondaryKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{6}
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRevokeSecondaryKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSecondaryKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSecondaryKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRevokeSecondaryKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSecondaryKey.Merge(m, src)
+}
+func (m *MsgRevokeSecondaryKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSecondaryKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSecondaryKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSecondaryKey proto.InternalMessageInfo
+
+func (m *MsgRevokeSecondaryKey) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeSecondaryKey) GetLockdown() bool {
+	if m != nil {
+		return m.Lockdown
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevokeSecondaryKeyResponse defines the Msg/RevokeSecondaryKey response
+// type.
+type MsgRevokeSecondaryKeyResponse struct {
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgRevokeSecondaryKeyResponse) Reset()         { *m = MsgRevokeSecondaryKeyResponse{} }
+func (m *MsgRevokeSecondaryKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSecondaryKeyResponse) ProtoMessage()    {}
+func (*MsgRevokeSecondaryKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{7}
+}
+func (m *MsgRevokeSecondaryKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSecondaryKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSecondaryKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSecondaryKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSecondaryKeyResponse.Merge(m, src)
+}
+func (m *MsgRevokeSecondaryKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSecondaryKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSecondaryKeyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBroadcastData) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRevokeSecondaryKeyResponse proto.InternalMessageInfo
+
+// MsgSetSecondarySignatureRequired defines the
+// Msg/SetSecondarySignatureRequired request type.
+type MsgSetSecondarySignatureRequired struct {
+	// sender is the account the requirement applies to.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// required makes every transaction of the sender require a valid secondary
+	// signature while it has a registered secondary key.
+	Required bool `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (m *MsgSetSecondarySignatureRequired) Reset()         { *m = MsgSetSecondarySignatureRequired{} }
+func (m *MsgSetSecondarySignatureRequired) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSecondarySignatureRequired) ProtoMessage()    {}
+func (*MsgSetSecondarySignatureRequired) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{8}
+}
+func (m *MsgSetSecondarySignatureRequired) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSecondarySignatureRequired) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSecondarySignatureRequired.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSetSecondarySignatureRequired) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSecondarySignatureRequired.Merge(m, src)
+}
+func (m *MsgSetSecondarySignatureRequired) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSecondarySignatureRequired) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSecondarySignatureRequired.DiscardUnknown(m)
 }
 
-func (m *MsgBroadcastData) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSetSecondarySignatureRequired proto.InternalMessageInfo
+
+func (m *MsgSetSecondarySignatureRequired) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgBroadcastData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSetSecondarySignatureRequired) GetRequired() bool {
+	if m != nil {
+		return m.Required
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return false
+}
+
+// MsgSetSecondarySignatureRequiredResponse defines the
+// Msg/SetSecondarySignatureRequired response type.
+type MsgSetSecondarySignatureRequiredResponse struct {
+}
+
+func (m *MsgSetSecondarySignatureRequiredResponse) Reset() {
+	*m = MsgSetSecondarySignatureRequiredResponse{}
+}
+func (m *MsgSetSecondarySignatureRequiredResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSecondarySignatureRequiredResponse) ProtoMessage()    {}
+func (*MsgSetSecondarySignatureRequiredResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{9}
+}
+func (m *MsgSetSecondarySignatureRequiredResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSecondarySignatureRequiredResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSecondarySignatureRequiredResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetSecondarySignatureRequiredResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSecondarySignatureRequiredResponse.Merge(m, src)
+}
+func (m *MsgSetSecondarySignatureRequiredResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSecondarySignatureRequiredResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSecondarySignatureRequiredResponse.DiscardUnknown(m)
 }
 
-func (m *MsgBroadcastDataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetSecondarySignatureRequiredResponse proto.InternalMessageInfo
+
+// MsgAddSecondaryKeySetMember defines the Msg/AddSecondaryKeySetMember request
+// type.
+type MsgAddSecondaryKeySetMember struct {
+	// sender is the account whose key set gains the key.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// key_type is the algorithm of public_key.
+	KeyType KeyType `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=example.secondarykeys.v1.KeyType" json:"key_type,omitempty"`
+	// public_key is the encoded secondary public key to add.
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// weight is what a signature of the new key counts towards the threshold.
+	Weight uint32 `protobuf:"varint,4,opt,name=weight,proto3" json:"weight,omitempty"`
+	// threshold is the threshold of the key set once the key is added.
+	Threshold uint32 `protobuf:"varint,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// new_key_signature is the signature of the new key over the bytes
+	// returned by AddKeySetMemberBytes.
+	NewKeySignature []byte `protobuf:"bytes,6,opt,name=new_key_signature,json=newKeySignature,proto3" json:"new_key_signature,omitempty"`
+	// signatures are the signatures of current members over the bytes returned
+	// by AddKeySetMemberBytes, whose weights must reach the current threshold.
+	Signatures []SecondaryKeySignature `protobuf:"bytes,7,rep,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *MsgAddSecondaryKeySetMember) Reset()         { *m = MsgAddSecondaryKeySetMember{} }
+func (m *MsgAddSecondaryKeySetMember) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSecondaryKeySetMember) ProtoMessage()    {}
+func (*MsgAddSecondaryKeySetMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{10}
+}
+func (m *MsgAddSecondaryKeySetMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSecondaryKeySetMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSecondaryKeySetMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgAddSecondaryKeySetMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSecondaryKeySetMember.Merge(m, src)
+}
+func (m *MsgAddSecondaryKeySetMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSecondaryKeySetMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSecondaryKeySetMember.DiscardUnknown(m)
 }
 
-func (m *MsgBroadcastDataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAddSecondaryKeySetMember proto.InternalMessageInfo
+
+func (m *MsgAddSecondaryKeySetMember) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgBroadcastDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAddSecondaryKeySetMember) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyType_KEY_TYPE_UNSPECIFIED
+}
+
+func (m *MsgAddSecondaryKeySetMember) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *MsgAddSecondaryKeySetMember) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *MsgAddSecondaryKeySetMember) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MsgAddSecondaryKeySetMember) GetNewKeySignature() []byte {
+	if m != nil {
+		return m.NewKeySignature
+	}
+	return nil
+}
+
+func (m *MsgAddSecondaryKeySetMember) GetSignatures() []SecondaryKeySignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// MsgAddSecondaryKeySetMemberResponse defines the
+// Msg/AddSecondaryKeySetMember response type.
+type MsgAddSecondaryKeySetMemberResponse struct {
+}
+
+func (m *MsgAddSecondaryKeySetMemberResponse) Reset()         { *m = MsgAddSecondaryKeySetMemberResponse{} }
+func (m *MsgAddSecondaryKeySetMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddSecondaryKeySetMemberResponse) ProtoMessage()    {}
+func (*MsgAddSecondaryKeySetMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{11}
+}
+func (m *MsgAddSecondaryKeySetMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddSecondaryKeySetMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddSecondaryKeySetMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddSecondaryKeySetMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddSecondaryKeySetMemberResponse.Merge(m, src)
+}
+func (m *MsgAddSecondaryKeySetMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddSecondaryKeySetMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddSecondaryKeySetMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddSecondaryKeySetMemberResponse proto.InternalMessageInfo
+
+// MsgRemoveSecondaryKeySetMember defines the Msg/RemoveSecondaryKeySetMember
+// request type.
+type MsgRemoveSecondaryKeySetMember struct {
+	// sender is the account whose key set loses the key.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// public_key is the member to remove. The last member cannot be removed,
+	// use MsgRevokeSecondaryKey instead.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// threshold is the threshold of the key set once the key is removed.
+	Threshold uint32 `protobuf:"varint,3,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// signatures are the signatures of current members over the bytes returned
+	// by RemoveKeySetMemberBytes, whose weights must reach the current
+	// threshold.
+	Signatures []SecondaryKeySignature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *MsgRemoveSecondaryKeySetMember) Reset()         { *m = MsgRemoveSecondaryKeySetMember{} }
+func (m *MsgRemoveSecondaryKeySetMember) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSecondaryKeySetMember) ProtoMessage()    {}
+func (*MsgRemoveSecondaryKeySetMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{12}
+}
+func (m *MsgRemoveSecondaryKeySetMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSecondaryKeySetMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSecondaryKeySetMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSecondaryKeySetMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSecondaryKeySetMember.Merge(m, src)
+}
+func (m *MsgRemoveSecondaryKeySetMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSecondaryKeySetMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSecondaryKeySetMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSecondaryKeySetMember proto.InternalMessageInfo
+
+func (m *MsgRemoveSecondaryKeySetMember) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRemoveSecondaryKeySetMember) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *MsgRemoveSecondaryKeySetMember) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *MsgRemoveSecondaryKeySetMember) GetSignatures() []SecondaryKeySignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// MsgRemoveSecondaryKeySetMemberResponse defines the
+// Msg/RemoveSecondaryKeySetMember response type.
+type MsgRemoveSecondaryKeySetMemberResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRemoveSecondaryKeySetMemberResponse) Reset() {
+	*m = MsgRemoveSecondaryKeySetMemberResponse{}
+}
+func (m *MsgRemoveSecondaryKeySetMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveSecondaryKeySetMemberResponse) ProtoMessage()    {}
+func (*MsgRemoveSecondaryKeySetMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{13}
+}
+func (m *MsgRemoveSecondaryKeySetMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveSecondaryKeySetMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveSecondaryKeySetMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveSecondaryKeySetMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveSecondaryKeySetMemberResponse.Merge(m, src)
+}
+func (m *MsgRemoveSecondaryKeySetMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveSecondaryKeySetMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveSecondaryKeySetMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveSecondaryKeySetMemberResponse proto.InternalMessageInfo
+
+// MsgRecoverAccount defines the Msg/RecoverAccount request type.
+type MsgRecoverAccount struct {
+	// submitter is the account submitting, and paying for, the recovery. It
+	// need not be the recovered account.
+	Submitter string `protobuf:"bytes,1,opt,name=submitter,proto3" json:"submitter,omitempty"`
+	// account is the account to recover.
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// new_public_key is the primary public key to recover the account to.
+	NewPublicKey *any.Any `protobuf:"bytes,3,opt,name=new_public_key,json=newPublicKey,proto3" json:"new_public_key,omitempty"`
+	// signatures are the signatures of members of the account's secondary key
+	// set over the bytes returned by RecoverAccountBytes, whose weights must
+	// reach its threshold.
+	Signatures []SecondaryKeySignature `protobuf:"bytes,4,rep,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *MsgRecoverAccount) Reset()         { *m = MsgRecoverAccount{} }
+func (m *MsgRecoverAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverAccount) ProtoMessage()    {}
+func (*MsgRecoverAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{14}
+}
+func (m *MsgRecoverAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverAccount.Merge(m, src)
+}
+func (m *MsgRecoverAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverAccount proto.InternalMessageInfo
+
+func (m *MsgRecoverAccount) GetSubmitter() string {
+	if m != nil {
+		return m.Submitter
+	}
+	return ""
+}
+
+func (m *MsgRecoverAccount) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
+func (m *MsgRecoverAccount) GetNewPublicKey() *any.Any {
+	if m != nil {
+		return m.NewPublicKey
+	}
+	return nil
+}
+
+func (m *MsgRecoverAccount) GetSignatures() []SecondaryKeySignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// MsgRecoverAccountResponse defines the Msg/RecoverAccount response type.
+type MsgRecoverAccountResponse struct {
+	// execute_time is the block time from which the recovery is executed.
+	ExecuteTime time.Time `protobuf:"bytes,1,opt,name=execute_time,json=executeTime,proto3,stdtime" json:"execute_time"`
+}
+
+func (m *MsgRecoverAccountResponse) Reset()         { *m = MsgRecoverAccountResponse{} }
+func (m *MsgRecoverAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRecoverAccountResponse) ProtoMessage()    {}
+func (*MsgRecoverAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{15}
+}
+func (m *MsgRecoverAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRecoverAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRecoverAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRecoverAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRecoverAccountResponse.Merge(m, src)
+}
+func (m *MsgRecoverAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRecoverAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRecoverAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRecoverAccountResponse proto.InternalMessageInfo
+
+func (m *MsgRecoverAccountResponse) GetExecuteTime() time.Time {
+	if m != nil {
+		return m.ExecuteTime
+	}
+	return time.Time{}
+}
+
+// MsgCancelRecovery defines the Msg/CancelRecovery request type.
+type MsgCancelRecovery struct {
+	// sender is the account whose pending recovery is cancelled.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+}
+
+func (m *MsgCancelRecovery) Reset()         { *m = MsgCancelRecovery{} }
+func (m *MsgCancelRecovery) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecovery) ProtoMessage()    {}
+func (*MsgCancelRecovery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{16}
+}
+func (m *MsgCancelRecovery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecovery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecovery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecovery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecovery.Merge(m, src)
+}
+func (m *MsgCancelRecovery) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecovery) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecovery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecovery proto.InternalMessageInfo
+
+func (m *MsgCancelRecovery) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+// MsgCancelRecoveryResponse defines the Msg/CancelRecovery response type.
+type MsgCancelRecoveryResponse struct {
+}
+
+func (m *MsgCancelRecoveryResponse) Reset()         { *m = MsgCancelRecoveryResponse{} }
+func (m *MsgCancelRecoveryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRecoveryResponse) ProtoMessage()    {}
+func (*MsgCancelRecoveryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{17}
+}
+func (m *MsgCancelRecoveryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRecoveryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRecoveryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRecoveryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRecoveryResponse.Merge(m, src)
+}
+func (m *MsgCancelRecoveryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRecoveryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRecoveryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRecoveryResponse proto.InternalMessageInfo
+
+// MsgRegisterSessionKey defines the Msg/RegisterSessionKey request type.
+type MsgRegisterSessionKey struct {
+	// sender is the account the session key signs for.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// key_type is the algorithm of public_key.
+	KeyType KeyType `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=example.secondarykeys.v1.KeyType" json:"key_type,omitempty"`
+	// public_key is the encoded session public key.
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// msg_type_urls are the Msg type URLs the session key can sign for.
+	MsgTypeUrls []string `protobuf:"bytes,4,rep,name=msg_type_urls,json=msgTypeUrls,proto3" json:"msg_type_urls,omitempty"`
+	// spend_limit is the most the transactions signed by the session key may
+	// spend of each denom, fees included.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// expiry_height is the first block height at which the session key can no
+	// longer sign, zero for none.
+	ExpiryHeight int64 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// expiry_time is the block time from which the session key can no longer
+	// sign, unset for none. At least one of expiry_height and expiry_time must
+	// be set.
+	ExpiryTime *time.Time `protobuf:"bytes,7,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time,omitempty"`
+	// session_key_signature is the signature of the session key over the bytes
+	// returned by RegisterSessionKeyBytes.
+	SessionKeySignature []byte `protobuf:"bytes,8,opt,name=session_key_signature,json=sessionKeySignature,proto3" json:"session_key_signature,omitempty"`
+	// signatures are the signatures of members of the sender's key set over
+	// the bytes returned by RegisterSessionKeyBytes, whose weights must reach
+	// its threshold.
+	Signatures []SecondaryKeySignature `protobuf:"bytes,9,rep,name=signatures,proto3" json:"signatures"`
+}
+
+func (m *MsgRegisterSessionKey) Reset()         { *m = MsgRegisterSessionKey{} }
+func (m *MsgRegisterSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSessionKey) ProtoMessage()    {}
+func (*MsgRegisterSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{18}
+}
+func (m *MsgRegisterSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSessionKey.Merge(m, src)
+}
+func (m *MsgRegisterSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSessionKey proto.InternalMessageInfo
+
+func (m *MsgRegisterSessionKey) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRegisterSessionKey) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyType_KEY_TYPE_UNSPECIFIED
+}
+
+func (m *MsgRegisterSessionKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *MsgRegisterSessionKey) GetMsgTypeUrls() []string {
+	if m != nil {
+		return m.MsgTypeUrls
+	}
+	return nil
+}
+
+func (m *MsgRegisterSessionKey) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *MsgRegisterSessionKey) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgRegisterSessionKey) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
+func (m *MsgRegisterSessionKey) GetSessionKeySignature() []byte {
+	if m != nil {
+		return m.SessionKeySignature
+	}
+	return nil
+}
+
+func (m *MsgRegisterSessionKey) GetSignatures() []SecondaryKeySignature {
+	if m != nil {
+		return m.Signatures
+	}
+	return nil
+}
+
+// MsgRegisterSessionKeyResponse defines the Msg/RegisterSessionKey response
+// type.
+type MsgRegisterSessionKeyResponse struct {
+}
+
+func (m *MsgRegisterSessionKeyResponse) Reset()         { *m = MsgRegisterSessionKeyResponse{} }
+func (m *MsgRegisterSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSessionKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{19}
+}
+func (m *MsgRegisterSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSessionKeyResponse.Merge(m, src)
+}
+func (m *MsgRegisterSessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSessionKeyResponse proto.InternalMessageInfo
+
+// MsgRevokeSessionKey defines the Msg/RevokeSessionKey request type.
+type MsgRevokeSessionKey struct {
+	// sender is the account the session key signs for.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// public_key is the session public key to revoke.
+	PublicKey []byte `protobuf:"bytes,2,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+}
+
+func (m *MsgRevokeSessionKey) Reset()         { *m = MsgRevokeSessionKey{} }
+func (m *MsgRevokeSessionKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSessionKey) ProtoMessage()    {}
+func (*MsgRevokeSessionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{20}
+}
+func (m *MsgRevokeSessionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSessionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSessionKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSessionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSessionKey.Merge(m, src)
+}
+func (m *MsgRevokeSessionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSessionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSessionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSessionKey proto.InternalMessageInfo
+
+func (m *MsgRevokeSessionKey) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgRevokeSessionKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+// MsgRevokeSessionKeyResponse defines the Msg/RevokeSessionKey response type.
+type MsgRevokeSessionKeyResponse struct {
+}
+
+func (m *MsgRevokeSessionKeyResponse) Reset()         { *m = MsgRevokeSessionKeyResponse{} }
+func (m *MsgRevokeSessionKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeSessionKeyResponse) ProtoMessage()    {}
+func (*MsgRevokeSessionKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{21}
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeSessionKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeSessionKeyResponse.Merge(m, src)
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeSessionKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeSessionKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeSessionKeyResponse proto.InternalMessageInfo
+
+// MsgRegisterValidatorSecondaryKey defines the
+// Msg/RegisterValidatorSecondaryKey request type.
+type MsgRegisterValidatorSecondaryKey struct {
+	// validator_address is the operator address of the validator.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// key_type is the algorithm of public_key.
+	KeyType KeyType `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=example.secondarykeys.v1.KeyType" json:"key_type,omitempty"`
+	// public_key is the encoded secondary public key.
+	PublicKey []byte `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	// signature is the proof of possession of public_key, produced by signing
+	// the bytes returned by ValidatorProofOfPossessionBytes for the validator.
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgRegisterValidatorSecondaryKey) Reset()         { *m = MsgRegisterValidatorSecondaryKey{} }
+func (m *MsgRegisterValidatorSecondaryKey) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterValidatorSecondaryKey) ProtoMessage()    {}
+func (*MsgRegisterValidatorSecondaryKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{22}
+}
+func (m *MsgRegisterValidatorSecondaryKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterValidatorSecondaryKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterValidatorSecondaryKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterValidatorSecondaryKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterValidatorSecondaryKey.Merge(m, src)
+}
+func (m *MsgRegisterValidatorSecondaryKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterValidatorSecondaryKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterValidatorSecondaryKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterValidatorSecondaryKey proto.InternalMessageInfo
+
+func (m *MsgRegisterValidatorSecondaryKey) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *MsgRegisterValidatorSecondaryKey) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyType_KEY_TYPE_UNSPECIFIED
+}
+
+func (m *MsgRegisterValidatorSecondaryKey) GetPublicKey() []byte {
+	if m != nil {
+		return m.PublicKey
+	}
+	return nil
+}
+
+func (m *MsgRegisterValidatorSecondaryKey) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+// MsgRegisterValidatorSecondaryKeyResponse defines the
+// Msg/RegisterValidatorSecondaryKey response type.
+type MsgRegisterValidatorSecondaryKeyResponse struct {
+}
+
+func (m *MsgRegisterValidatorSecondaryKeyResponse) Reset() {
+	*m = MsgRegisterValidatorSecondaryKeyResponse{}
+}
+func (m *MsgRegisterValidatorSecondaryKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterValidatorSecondaryKeyResponse) ProtoMessage()    {}
+func (*MsgRegisterValidatorSecondaryKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{23}
+}
+func (m *MsgRegisterValidatorSecondaryKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterValidatorSecondaryKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterValidatorSecondaryKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterValidatorSecondaryKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterValidatorSecondaryKeyResponse.Merge(m, src)
+}
+func (m *MsgRegisterValidatorSecondaryKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterValidatorSecondaryKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterValidatorSecondaryKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterValidatorSecondaryKeyResponse proto.InternalMessageInfo
+
+// MsgInjectAttestations defines the Msg/InjectAttestations request type.
+type MsgInjectAttestations struct {
+	// authority is the module account address. No one holds its key, so the
+	// message can only be included by the proposer as the injected transaction.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// injected_tx is the decoded injected transaction. The module's pre-blocker
+	// stores the attestation it carries before the transaction runs.
+	InjectedTx InjectedVoteExtensionTx `protobuf:"bytes,2,opt,name=injected_tx,json=injectedTx,proto3" json:"injected_tx"`
+}
+
+func (m *MsgInjectAttestations) Reset()         { *m = MsgInjectAttestations{} }
+func (m *MsgInjectAttestations) String() string { return proto.CompactTextString(m) }
+func (*MsgInjectAttestations) ProtoMessage()    {}
+func (*MsgInjectAttestations) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{24}
+}
+func (m *MsgInjectAttestations) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInjectAttestations) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInjectAttestations.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInjectAttestations) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInjectAttestations.Merge(m, src)
+}
+func (m *MsgInjectAttestations) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInjectAttestations) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInjectAttestations.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInjectAttestations proto.InternalMessageInfo
+
+func (m *MsgInjectAttestations) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgInjectAttestations) GetInjectedTx() InjectedVoteExtensionTx {
+	if m != nil {
+		return m.InjectedTx
+	}
+	return InjectedVoteExtensionTx{}
+}
+
+// MsgInjectAttestationsResponse defines the Msg/InjectAttestations response
+// type.
+type MsgInjectAttestationsResponse struct {
+}
+
+func (m *MsgInjectAttestationsResponse) Reset()         { *m = MsgInjectAttestationsResponse{} }
+func (m *MsgInjectAttestationsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgInjectAttestationsResponse) ProtoMessage()    {}
+func (*MsgInjectAttestationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{25}
+}
+func (m *MsgInjectAttestationsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgInjectAttestationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgInjectAttestationsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgInjectAttestationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgInjectAttestationsResponse.Merge(m, src)
+}
+func (m *MsgInjectAttestationsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgInjectAttestationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgInjectAttestationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgInjectAttestationsResponse proto.InternalMessageInfo
+
+// MsgBroadcastData defines the MsgBroadcastData message.
+//
+// Deprecated: use MsgRegisterSecondaryKey instead.
+//
+// Deprecated: Do not use.
+type MsgBroadcastData struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Data   string `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgBroadcastData) Reset()         { *m = MsgBroadcastData{} }
+func (m *MsgBroadcastData) String() string { return proto.CompactTextString(m) }
+func (*MsgBroadcastData) ProtoMessage()    {}
+func (*MsgBroadcastData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{26}
+}
+func (m *MsgBroadcastData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBroadcastData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBroadcastData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBroadcastData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBroadcastData.Merge(m, src)
+}
+func (m *MsgBroadcastData) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBroadcastData) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBroadcastData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBroadcastData proto.InternalMessageInfo
+
+func (m *MsgBroadcastData) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgBroadcastData) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+// MsgBroadcastDataResponse defines the MsgBroadcastDataResponse message.
+type MsgBroadcastDataResponse struct {
+}
+
+func (m *MsgBroadcastDataResponse) Reset()         { *m = MsgBroadcastDataResponse{} }
+func (m *MsgBroadcastDataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBroadcastDataResponse) ProtoMessage()    {}
+func (*MsgBroadcastDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd58f66499323b9e, []int{27}
+}
+func (m *MsgBroadcastDataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBroadcastDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBroadcastDataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBroadcastDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBroadcastDataResponse.Merge(m, src)
+}
+func (m *MsgBroadcastDataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBroadcastDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBroadcastDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBroadcastDataResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "example.secondarykeys.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "example.secondarykeys.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterSecondaryKey)(nil), "example.secondarykeys.v1.MsgRegisterSecondaryKey")
+	proto.RegisterType((*MsgRegisterSecondaryKeyResponse)(nil), "example.secondarykeys.v1.MsgRegisterSecondaryKeyResponse")
+	proto.RegisterType((*MsgRotateSecondaryKey)(nil), "example.secondarykeys.v1.MsgRotateSecondaryKey")
+	proto.RegisterType((*MsgRotateSecondaryKeyResponse)(nil), "example.secondarykeys.v1.MsgRotateSecondaryKeyResponse")
+	proto.RegisterType((*MsgRevokeSecondaryKey)(nil), "example.secondarykeys.v1.MsgRevokeSecondaryKey")
+	proto.RegisterType((*MsgRevokeSecondaryKeyResponse)(nil), "example.secondarykeys.v1.MsgRevokeSecondaryKeyResponse")
+	proto.RegisterType((*MsgSetSecondarySignatureRequired)(nil), "example.secondarykeys.v1.MsgSetSecondarySignatureRequired")
+	proto.RegisterType((*MsgSetSecondarySignatureRequiredResponse)(nil), "example.secondarykeys.v1.MsgSetSecondarySignatureRequiredResponse")
+	proto.RegisterType((*MsgAddSecondaryKeySetMember)(nil), "example.secondarykeys.v1.MsgAddSecondaryKeySetMember")
+	proto.RegisterType((*MsgAddSecondaryKeySetMemberResponse)(nil), "example.secondarykeys.v1.MsgAddSecondaryKeySetMemberResponse")
+	proto.RegisterType((*MsgRemoveSecondaryKeySetMember)(nil), "example.secondarykeys.v1.MsgRemoveSecondaryKeySetMember")
+	proto.RegisterType((*MsgRemoveSecondaryKeySetMemberResponse)(nil), "example.secondarykeys.v1.MsgRemoveSecondaryKeySetMemberResponse")
+	proto.RegisterType((*MsgRecoverAccount)(nil), "example.secondarykeys.v1.MsgRecoverAccount")
+	proto.RegisterType((*MsgRecoverAccountResponse)(nil), "example.secondarykeys.v1.MsgRecoverAccountResponse")
+	proto.RegisterType((*MsgCancelRecovery)(nil), "example.secondarykeys.v1.MsgCancelRecovery")
+	proto.RegisterType((*MsgCancelRecoveryResponse)(nil), "example.secondarykeys.v1.MsgCancelRecoveryResponse")
+	proto.RegisterType((*MsgRegisterSessionKey)(nil), "example.secondarykeys.v1.MsgRegisterSessionKey")
+	proto.RegisterType((*MsgRegisterSessionKeyResponse)(nil), "example.secondarykeys.v1.MsgRegisterSessionKeyResponse")
+	proto.RegisterType((*MsgRevokeSessionKey)(nil), "example.secondarykeys.v1.MsgRevokeSessionKey")
+	proto.RegisterType((*MsgRevokeSessionKeyResponse)(nil), "example.secondarykeys.v1.MsgRevokeSessionKeyResponse")
+	proto.RegisterType((*MsgRegisterValidatorSecondaryKey)(nil), "example.secondarykeys.v1.MsgRegisterValidatorSecondaryKey")
+	proto.RegisterType((*MsgRegisterValidatorSecondaryKeyResponse)(nil), "example.secondarykeys.v1.MsgRegisterValidatorSecondaryKeyResponse")
+	proto.RegisterType((*MsgInjectAttestations)(nil), "example.secondarykeys.v1.MsgInjectAttestations")
+	proto.RegisterType((*MsgInjectAttestationsResponse)(nil), "example.secondarykeys.v1.MsgInjectAttestationsResponse")
+	proto.RegisterType((*MsgBroadcastData)(nil), "example.secondarykeys.v1.MsgBroadcastData")
+	proto.RegisterType((*MsgBroadcastDataResponse)(nil), "example.secondarykeys.v1.MsgBroadcastDataResponse")
+}
+
+func init() { proto.RegisterFile("example/secondarykeys/v1/tx.proto", fileDescriptor_dd58f66499323b9e) }
+
+var fileDescriptor_dd58f66499323b9e = []byte{
+	// 1641 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x37, 0xdf, 0xb3, 0x49, 0xda, 0xb8, 0x29, 0xdd, 0xb8, 0x64, 0x93, 0x6c, 0x29, 0xa4,
+	0x29, 0x59, 0x93, 0xad, 0xfa, 0xb5, 0xb4, 0xa2, 0xd9, 0x40, 0xd5, 0xaa, 0x0d, 0xaa, 0x9c, 0xb4,
+	0x87, 0x4a, 0x68, 0xe5, 0xb5, 0x07, 0xc7, 0x64, 0xed, 0x59, 0x3c, 0xb3, 0x9b, 0xf5, 0x05, 0x55,
+	0x15, 0x02, 0xc4, 0xa9, 0x57, 0xb8, 0x70, 0x80, 0x03, 0xe2, 0x42, 0x24, 0x7a, 0xe2, 0xc8, 0xa9,
+	0xaa, 0x7a, 0xa8, 0x7a, 0xe2, 0x44, 0x51, 0x7b, 0xc8, 0x11, 0xfe, 0x04, 0x34, 0xfe, 0x98, 0xac,
+	0xed, 0xb5, 0x77, 0xb3, 0x2d, 0x6a, 0x2f, 0xc9, 0x7a, 0xde, 0xef, 0xcd, 0x7b, 0xef, 0xf7, 0x66,
+	0xfc, 0xde, 0x33, 0x98, 0x87, 0x4d, 0xd9, 0xa8, 0x55, 0xa1, 0x88, 0xa1, 0x82, 0x4c, 0x55, 0xb6,
+	0xec, 0x2d, 0x68, 0x63, 0xb1, 0xb1, 0x2c, 0x92, 0x66, 0xbe, 0x66, 0x21, 0x82, 0xf8, 0x8c, 0x07,
+	0xc9, 0x07, 0x20, 0xf9, 0xc6, 0xb2, 0x30, 0x29, 0x1b, 0xba, 0x89, 0x44, 0xe7, 0xaf, 0x0b, 0x16,
+	0xb2, 0x0a, 0xc2, 0x06, 0xc2, 0x62, 0x45, 0xc6, 0x50, 0x6c, 0x2c, 0x57, 0x20, 0x91, 0x97, 0x45,
+	0x05, 0xe9, 0xa6, 0x27, 0x3f, 0xe2, 0xc9, 0x0d, 0xac, 0x51, 0x23, 0x06, 0xd6, 0x3c, 0xc1, 0xb4,
+	0x2b, 0x28, 0x3b, 0x4f, 0xa2, 0xfb, 0xe0, 0x89, 0x8e, 0xc7, 0xfa, 0x58, 0x93, 0x2d, 0xd9, 0xf0,
+	0x61, 0xef, 0xc6, 0xc2, 0xd8, 0x42, 0x79, 0x0b, 0xda, 0x1e, 0x7a, 0x29, 0x16, 0xdd, 0x40, 0x04,
+	0x96, 0x61, 0x93, 0x40, 0x13, 0xeb, 0xc8, 0xf7, 0x7b, 0x4a, 0x43, 0x1a, 0x72, 0x7d, 0xa3, 0xbf,
+	0x7c, 0xa7, 0x35, 0x84, 0xb4, 0x2a, 0x14, 0x9d, 0xa7, 0x4a, 0xfd, 0x53, 0x51, 0x36, 0xfd, 0xfd,
+	0x67, 0xc3, 0x22, 0xa2, 0x1b, 0x10, 0x13, 0xd9, 0xa8, 0xb9, 0x80, 0xdc, 0x43, 0x0e, 0x1c, 0x58,
+	0xc3, 0xda, 0xcd, 0x9a, 0x2a, 0x13, 0x78, 0xc3, 0x09, 0x84, 0x3f, 0x03, 0x46, 0xe5, 0x3a, 0xd9,
+	0x44, 0x96, 0x4e, 0xec, 0x0c, 0x37, 0xc7, 0x2d, 0x8c, 0x96, 0x32, 0x4f, 0xee, 0x2f, 0x4d, 0x79,
+	0x74, 0xac, 0xa8, 0xaa, 0x05, 0x31, 0x5e, 0x27, 0x96, 0x6e, 0x6a, 0xd2, 0x1e, 0x94, 0x5f, 0x05,
+	0x43, 0x2e, 0x15, 0x99, 0xd4, 0x1c, 0xb7, 0x90, 0x2e, 0xcc, 0xe5, 0xe3, 0x72, 0x96, 0x77, 0x2d,
+	0x95, 0x46, 0x1f, 0xfc, 0x35, 0xdb, 0xf7, 0xf3, 0xee, 0xce, 0x22, 0x27, 0x79, 0xaa, 0xc5, 0xe2,
+	0xdd, 0xdd, 0x9d, 0xc5, 0xbd, 0x4d, 0xbf, 0xdd, 0xdd, 0x59, 0x7c, 0xc7, 0x27, 0xa9, 0x19, 0xa2,
+	0x29, 0xe4, 0x78, 0x6e, 0x1a, 0x1c, 0x09, 0x2d, 0x49, 0x10, 0xd7, 0x90, 0x89, 0x61, 0xee, 0xeb,
+	0x94, 0x23, 0x93, 0xa0, 0xa6, 0x63, 0x02, 0xad, 0x75, 0x7f, 0x9f, 0x6b, 0xd0, 0xe6, 0xdf, 0x03,
+	0x43, 0x18, 0x9a, 0x2a, 0xb4, 0x3a, 0x06, 0xeb, 0xe1, 0xf8, 0x0b, 0x60, 0x64, 0x0b, 0xda, 0x65,
+	0x62, 0xd7, 0xa0, 0x13, 0xeb, 0x44, 0x61, 0x3e, 0x3e, 0xd6, 0x6b, 0xd0, 0xde, 0xb0, 0x6b, 0x50,
+	0x1a, 0xde, 0x72, 0x7f, 0xf0, 0x33, 0x00, 0xd4, 0xea, 0x95, 0xaa, 0xae, 0xd0, 0x83, 0x90, 0xe9,
+	0x9f, 0xe3, 0x16, 0xc6, 0xa4, 0x51, 0x77, 0x85, 0xba, 0xf3, 0x26, 0x18, 0xc5, 0xba, 0x66, 0xca,
+	0xa4, 0x6e, 0xc1, 0xcc, 0x80, 0x2b, 0x65, 0x0b, 0xc5, 0x0f, 0x28, 0x3f, 0x9e, 0x1f, 0x94, 0x1c,
+	0x31, 0x81, 0x9c, 0x76, 0xd1, 0xe6, 0xe6, 0xc1, 0x6c, 0x8c, 0x88, 0x91, 0xf5, 0x28, 0x05, 0x0e,
+	0x53, 0x0c, 0x22, 0x32, 0x81, 0xaf, 0x94, 0xaa, 0xb7, 0xc0, 0x84, 0x09, 0xb7, 0xcb, 0x11, 0xba,
+	0xc6, 0x4c, 0xb8, 0x7d, 0x83, 0x31, 0x56, 0x00, 0x87, 0x95, 0xba, 0x65, 0x41, 0x93, 0x50, 0x48,
+	0x39, 0xcc, 0xde, 0x21, 0x4f, 0x78, 0x0d, 0xda, 0xeb, 0xbe, 0x88, 0x5f, 0x04, 0x93, 0x74, 0xe7,
+	0x20, 0x7e, 0xd0, 0xc1, 0x1f, 0x30, 0xe1, 0x76, 0x2b, 0xb6, 0x78, 0x31, 0xc4, 0xf9, 0x52, 0x12,
+	0xe7, 0x11, 0xd2, 0x72, 0xb3, 0x60, 0xa6, 0xad, 0x80, 0xf1, 0xfd, 0x13, 0xe7, 0xf2, 0x0d, 0x1b,
+	0x68, 0xeb, 0x45, 0xf9, 0x16, 0xc0, 0x48, 0x15, 0x29, 0x5b, 0x2a, 0xda, 0x36, 0x1d, 0xbe, 0x47,
+	0x24, 0xf6, 0xbc, 0xbf, 0x38, 0x22, 0xce, 0xf8, 0x71, 0x44, 0x04, 0x2c, 0x8e, 0xdf, 0x39, 0x30,
+	0xb7, 0x86, 0xb5, 0x75, 0x48, 0x98, 0x98, 0x91, 0x28, 0xc1, 0xcf, 0xeb, 0xba, 0x05, 0xd5, 0xde,
+	0x42, 0xb2, 0x3c, 0x6d, 0x3f, 0x24, 0xff, 0xb9, 0x78, 0x25, 0x14, 0xd2, 0xb9, 0x84, 0x90, 0x12,
+	0xfd, 0xca, 0x2d, 0x82, 0x85, 0x4e, 0x18, 0x16, 0xe8, 0xaf, 0xfd, 0xe0, 0xe8, 0x1a, 0xd6, 0x56,
+	0x54, 0xb5, 0x95, 0x87, 0x75, 0x48, 0xd6, 0xa0, 0x51, 0x81, 0xd6, 0xeb, 0xf6, 0x46, 0x79, 0x03,
+	0x0c, 0x6d, 0x43, 0x5d, 0xdb, 0x24, 0xce, 0x85, 0x18, 0x97, 0xbc, 0x27, 0xfa, 0xa6, 0x21, 0x9b,
+	0x16, 0xc4, 0x9b, 0xa8, 0xaa, 0x3a, 0x67, 0x7f, 0x5c, 0xda, 0x5b, 0x68, 0x7f, 0x43, 0x86, 0xda,
+	0xde, 0x10, 0xfe, 0x36, 0x00, 0x0c, 0x83, 0x33, 0xc3, 0x73, 0xfd, 0x0b, 0xe9, 0x82, 0x18, 0x1f,
+	0x40, 0x80, 0x35, 0x5f, 0xaf, 0xb5, 0x1a, 0xb4, 0xec, 0x56, 0x5c, 0x0d, 0xa5, 0xf8, 0x54, 0x42,
+	0x8a, 0xe3, 0x32, 0x92, 0x3b, 0x0e, 0x8e, 0x25, 0x88, 0x59, 0x62, 0x7f, 0x4b, 0x81, 0xac, 0x73,
+	0xc6, 0x0d, 0xd4, 0x80, 0x2f, 0x2b, 0xb7, 0xc1, 0xec, 0xa4, 0xda, 0xbc, 0xef, 0xf7, 0xb2, 0xd0,
+	0x1f, 0xce, 0x42, 0x90, 0xd9, 0x81, 0x97, 0xca, 0xec, 0xe5, 0x10, 0xb3, 0x67, 0x12, 0xdf, 0x07,
+	0xb1, 0x94, 0xe4, 0x16, 0xc0, 0xdb, 0xc9, 0x08, 0xc6, 0xef, 0x3f, 0x29, 0x30, 0xe9, 0x40, 0x15,
+	0xd4, 0x80, 0xd6, 0x8a, 0xa2, 0xa0, 0xba, 0x49, 0x68, 0xc3, 0x81, 0xeb, 0x15, 0x43, 0x27, 0xa4,
+	0x0b, 0x56, 0xf7, 0xa0, 0x7c, 0x01, 0x0c, 0xcb, 0xee, 0x16, 0x0e, 0xab, 0x49, 0x5a, 0x3e, 0x90,
+	0xdf, 0x68, 0x5b, 0x51, 0xd2, 0x85, 0xa9, 0xbc, 0xdb, 0x2a, 0xe5, 0xfd, 0x56, 0x29, 0xbf, 0x62,
+	0xda, 0xa5, 0xcc, 0xc3, 0xbd, 0x0d, 0x15, 0xcb, 0xae, 0x11, 0x94, 0xbf, 0x51, 0xaf, 0xd0, 0x77,
+	0x5e, 0xb0, 0x02, 0xfd, 0x9f, 0x59, 0xba, 0xe0, 0x74, 0x44, 0x2c, 0x6a, 0x9a, 0xa8, 0x13, 0x89,
+	0x89, 0x6a, 0xe5, 0x36, 0xa7, 0x83, 0xe9, 0xc8, 0xa2, 0x9f, 0x0e, 0xfe, 0x3a, 0x18, 0x83, 0x4d,
+	0xa8, 0xd4, 0x09, 0x2c, 0xd3, 0xc6, 0xd0, 0xe1, 0x3e, 0x5d, 0x10, 0x22, 0x54, 0x6c, 0xf8, 0x5d,
+	0x63, 0x69, 0x9c, 0xfa, 0x78, 0xef, 0xe9, 0x2c, 0xe7, 0xfa, 0x99, 0xf6, 0xd4, 0x29, 0x20, 0x77,
+	0x87, 0x73, 0x92, 0xbb, 0x2a, 0x9b, 0x0a, 0xac, 0x7a, 0x16, 0x7b, 0x28, 0x61, 0xc5, 0xf3, 0xa1,
+	0x63, 0x99, 0x14, 0x6d, 0xd0, 0x58, 0xee, 0xa8, 0x13, 0x6d, 0x70, 0x91, 0x1d, 0xbe, 0xaf, 0x06,
+	0xbd, 0x32, 0xeb, 0xb7, 0x3e, 0x98, 0xb6, 0xd6, 0xaf, 0x61, 0x07, 0x98, 0x03, 0xe3, 0x06, 0xd6,
+	0x9c, 0xcd, 0xcb, 0x75, 0xab, 0xea, 0x1e, 0xa8, 0x51, 0x29, 0x6d, 0x60, 0x8d, 0xaa, 0xdf, 0xb4,
+	0xaa, 0x98, 0xbf, 0xcb, 0x81, 0x34, 0xae, 0x41, 0x53, 0x2d, 0x57, 0x75, 0x43, 0x27, 0x99, 0x41,
+	0xe7, 0xcc, 0x4d, 0xe7, 0x3d, 0xaf, 0xe9, 0xe4, 0x93, 0xf7, 0x26, 0x9f, 0xfc, 0x2a, 0xd2, 0xcd,
+	0xd2, 0x65, 0x9a, 0xb9, 0x5f, 0x9e, 0xce, 0x2e, 0x68, 0x3a, 0xd9, 0xac, 0x57, 0xf2, 0x0a, 0x32,
+	0xbc, 0x01, 0xc7, 0xfb, 0xb7, 0x84, 0xd5, 0x2d, 0x91, 0xda, 0xc4, 0x8e, 0x02, 0xfe, 0x7e, 0x77,
+	0x67, 0x71, 0xac, 0x0a, 0x35, 0x59, 0xb1, 0xcb, 0x74, 0x76, 0xc2, 0xfe, 0xd1, 0xa4, 0x56, 0xaf,
+	0x53, 0xa3, 0xfc, 0x31, 0x30, 0x0e, 0x9b, 0x35, 0xdd, 0xb2, 0xcb, 0x9b, 0x6e, 0x7d, 0xa1, 0xe5,
+	0xa1, 0x5f, 0x1a, 0x73, 0x17, 0xaf, 0xb8, 0x55, 0x66, 0x05, 0xa4, 0x3d, 0x90, 0x73, 0xc6, 0x86,
+	0x3b, 0x9e, 0xb1, 0x01, 0x7a, 0xbe, 0x24, 0xe0, 0x2a, 0xd1, 0x65, 0xda, 0xe0, 0x61, 0x37, 0x5b,
+	0xa1, 0x72, 0x34, 0xe2, 0x36, 0x78, 0x98, 0xa5, 0x32, 0xae, 0x24, 0x8d, 0xbe, 0xd4, 0x2b, 0xb9,
+	0xbf, 0x46, 0x2a, 0x7c, 0xdc, 0x58, 0x23, 0x15, 0x16, 0xb0, 0x93, 0xfa, 0x23, 0x07, 0x0e, 0xb5,
+	0xb4, 0x5a, 0x2f, 0x70, 0x4e, 0x93, 0x6b, 0x4f, 0xf1, 0xfd, 0x50, 0x20, 0x27, 0xbb, 0xe8, 0x08,
+	0x59, 0x18, 0x33, 0x4e, 0x13, 0x14, 0x5e, 0x66, 0x41, 0xfc, 0x91, 0x72, 0xba, 0x41, 0x3f, 0xcc,
+	0x5b, 0x72, 0x55, 0x57, 0x65, 0x82, 0x82, 0xb3, 0xd7, 0xc7, 0x60, 0xb2, 0xe1, 0x0b, 0xca, 0xb2,
+	0x1b, 0x82, 0x17, 0xdc, 0xfc, 0x93, 0xfb, 0x4b, 0x33, 0x5e, 0x70, 0x4c, 0x39, 0x18, 0xe5, 0xc1,
+	0x46, 0x68, 0xfd, 0x55, 0x4e, 0x66, 0xeb, 0x94, 0xcb, 0x68, 0x34, 0x9d, 0xba, 0xd2, 0x44, 0x7e,
+	0xbc, 0xae, 0x34, 0x11, 0xc3, 0x08, 0xff, 0xd7, 0x1d, 0x23, 0xae, 0x9a, 0x9f, 0x41, 0x85, 0xac,
+	0x10, 0x42, 0xaf, 0x13, 0xd1, 0x91, 0xd9, 0xfb, 0x44, 0xff, 0x09, 0x48, 0xeb, 0xce, 0x6e, 0x50,
+	0x2d, 0x93, 0xa6, 0x37, 0xd6, 0x2f, 0xc7, 0x13, 0x7a, 0xd5, 0x03, 0xdf, 0x42, 0x04, 0x7e, 0xe4,
+	0x7f, 0xbb, 0xd8, 0x68, 0x06, 0xae, 0x91, 0xbf, 0xe1, 0x46, 0xb3, 0x78, 0x29, 0x3a, 0xeb, 0x27,
+	0xdd, 0xa4, 0x68, 0x60, 0xde, 0x4d, 0x8a, 0x0a, 0x18, 0x27, 0x1a, 0x38, 0xb8, 0x86, 0xb5, 0x92,
+	0x85, 0x64, 0x55, 0x91, 0x31, 0xf9, 0x50, 0x26, 0x72, 0x0f, 0xb7, 0x88, 0x07, 0x03, 0xaa, 0x4c,
+	0x64, 0xb7, 0xcb, 0x90, 0x9c, 0xdf, 0xc5, 0xf1, 0x96, 0xab, 0x93, 0xe1, 0x72, 0x02, 0xc8, 0x84,
+	0x0d, 0xf9, 0x4e, 0x14, 0x1e, 0x4d, 0x80, 0xfe, 0x35, 0xac, 0xf1, 0x55, 0x30, 0x16, 0xf8, 0xd0,
+	0x72, 0x22, 0x9e, 0xc9, 0xd0, 0x77, 0x0c, 0x61, 0xb9, 0x6b, 0x28, 0x2b, 0xee, 0x5f, 0x72, 0x60,
+	0xaa, 0xed, 0xf7, 0x8e, 0xe4, 0xbd, 0xda, 0xa9, 0x08, 0xe7, 0xf7, 0xad, 0xc2, 0xdc, 0xf8, 0x02,
+	0xf0, 0x6d, 0x3e, 0x24, 0x88, 0xc9, 0x1b, 0x46, 0x14, 0x84, 0xb3, 0xfb, 0x54, 0x08, 0xd8, 0x8f,
+	0x0e, 0xd6, 0x1d, 0xec, 0x47, 0x14, 0x3a, 0xd9, 0x8f, 0x1d, 0x8a, 0xf9, 0x1f, 0x38, 0x30, 0x93,
+	0x3c, 0x11, 0x17, 0x13, 0xb7, 0x4e, 0xd4, 0x15, 0x4a, 0xbd, 0xeb, 0x32, 0x0f, 0xef, 0x71, 0x20,
+	0x13, 0x3b, 0xca, 0x9e, 0x4e, 0x34, 0x10, 0xa7, 0x26, 0x5c, 0xec, 0x49, 0x8d, 0xb9, 0xf4, 0x1d,
+	0x07, 0x8e, 0x26, 0x0d, 0x61, 0xe7, 0x3a, 0x64, 0x23, 0x56, 0x53, 0xb8, 0xd4, 0xab, 0x26, 0xf3,
+	0xcd, 0x02, 0x13, 0xa1, 0xf9, 0xe5, 0x64, 0x87, 0x3d, 0x5b, 0xc1, 0xc2, 0xa9, 0x7d, 0x80, 0x5b,
+	0x6d, 0x86, 0xda, 0xea, 0x64, 0x9b, 0x41, 0x70, 0x07, 0x9b, 0xed, 0xdb, 0x65, 0xf7, 0xe2, 0x44,
+	0x5a, 0x65, 0xb1, 0xcb, 0x37, 0x81, 0xaf, 0xd0, 0xf1, 0xe2, 0xc4, 0x35, 0x41, 0x7c, 0x13, 0x1c,
+	0x8c, 0x34, 0x40, 0x4b, 0x5d, 0xdd, 0x42, 0x66, 0xfb, 0xf4, 0xbe, 0xe0, 0x81, 0x2b, 0x9b, 0xdc,
+	0xb6, 0x14, 0xbb, 0x0a, 0xaa, 0xad, 0x6e, 0x87, 0x2b, 0xdb, 0x55, 0xa9, 0xa7, 0xb9, 0x69, 0x53,
+	0xe6, 0x93, 0x73, 0x13, 0x55, 0xe8, 0x90, 0x9b, 0xf8, 0xb2, 0xca, 0x13, 0x30, 0x1e, 0xac, 0xa9,
+	0x8b, 0x89, 0x3b, 0x05, 0xb0, 0x42, 0xa1, 0x7b, 0x2c, 0xab, 0xe3, 0xfd, 0xdf, 0xa4, 0x38, 0x61,
+	0xf0, 0x0e, 0x6d, 0x21, 0x4a, 0x67, 0x1f, 0x3c, 0xcb, 0x72, 0x8f, 0x9f, 0x65, 0xb9, 0xbf, 0x9f,
+	0x65, 0xb9, 0x7b, 0xcf, 0xb3, 0x7d, 0x8f, 0x9f, 0x67, 0xfb, 0xfe, 0x7c, 0x9e, 0xed, 0xbb, 0x3d,
+	0x13, 0xd7, 0x3d, 0x38, 0x63, 0x4d, 0x65, 0xc8, 0x19, 0x36, 0x4e, 0xfd, 0x17, 0x00, 0x00, 0xff,
+	0xff, 0x4f, 0x40, 0x38, 0x15, 0x6f, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterSecondaryKey registers the sender's secondary public key.
+	RegisterSecondaryKey(ctx context.Context, in *MsgRegisterSecondaryKey, opts ...grpc.CallOption) (*MsgRegisterSecondaryKeyResponse, error)
+	// RotateSecondaryKey replaces the sender's secondary public key. It must be
+	// authorised by both the current and the new secondary key. Key sets of
+	// several keys change through AddSecondaryKeySetMember and
+	// RemoveSecondaryKeySetMember instead.
+	RotateSecondaryKey(ctx context.Context, in *MsgRotateSecondaryKey, opts ...grpc.CallOption) (*MsgRotateSecondaryKeyResponse, error)
+	// RevokeSecondaryKey removes the sender's secondary key set and tombstones
+	// its keys so they can never be registered again.
+	RevokeSecondaryKey(ctx context.Context, in *MsgRevokeSecondaryKey, opts ...grpc.CallOption) (*MsgRevokeSecondaryKeyResponse, error)
+	// SetSecondarySignatureRequired makes every transaction of the sender
+	// require a valid secondary signature, or lifts that requirement.
+	SetSecondarySignatureRequired(ctx context.Context, in *MsgSetSecondarySignatureRequired, opts ...grpc.CallOption) (*MsgSetSecondarySignatureRequiredResponse, error)
+	// AddSecondaryKeySetMember adds a key to the sender's secondary key set. It
+	// must be authorised by members reaching the current threshold and by the
+	// new key.
+	AddSecondaryKeySetMember(ctx context.Context, in *MsgAddSecondaryKeySetMember, opts ...grpc.CallOption) (*MsgAddSecondaryKeySetMemberResponse, error)
+	// RemoveSecondaryKeySetMember removes a key from the sender's secondary key
+	// set. It must be authorised by members reaching the current threshold.
+	RemoveSecondaryKeySetMember(ctx context.Context, in *MsgRemoveSecondaryKeySetMember, opts ...grpc.CallOption) (*MsgRemoveSecondaryKeySetMemberResponse, error)
+	// RecoverAccount starts replacing the primary public key of an account
+	// whose primary key is lost. It is authorised by the account's secondary
+	// key set alone, so any account can submit it, and takes effect once the
+	// recovery_delay param has passed.
+	RecoverAccount(ctx context.Context, in *MsgRecoverAccount, opts ...grpc.CallOption) (*MsgRecoverAccountResponse, error)
+	// CancelRecovery cancels the pending recovery of the sender. It is signed
+	// by the sender's current primary key.
+	CancelRecovery(ctx context.Context, in *MsgCancelRecovery, opts ...grpc.CallOption) (*MsgCancelRecoveryResponse, error)
+	// RegisterSessionKey registers a session key of the sender, scoped to some
+	// Msg types, with a spend limit and an expiry. It must be authorised by
+	// members of the sender's key set reaching its threshold and by the session
+	// key.
+	RegisterSessionKey(ctx context.Context, in *MsgRegisterSessionKey, opts ...grpc.CallOption) (*MsgRegisterSessionKeyResponse, error)
+	// RevokeSessionKey removes a session key of the sender before it expires
+	// and tombstones it.
+	RevokeSessionKey(ctx context.Context, in *MsgRevokeSessionKey, opts ...grpc.CallOption) (*MsgRevokeSessionKeyResponse, error)
+	// RegisterValidatorSecondaryKey registers the secondary public key a
+	// validator signs its vote extensions with. It is signed by the validator
+	// operator.
+	RegisterValidatorSecondaryKey(ctx context.Context, in *MsgRegisterValidatorSecondaryKey, opts ...grpc.CallOption) (*MsgRegisterValidatorSecondaryKeyResponse, error)
+	// InjectAttestations carries the vote extension signatures PrepareProposal
+	// injects into a proposal. It is never broadcast: the app's TxDecoder decodes
+	// the injected transaction into an unsigned transaction with this message.
+	InjectAttestations(ctx context.Context, in *MsgInjectAttestations, opts ...grpc.CallOption) (*MsgInjectAttestationsResponse, error)
+	// BroadcastData defines the BroadcastData RPC.
+	//
+	// Deprecated: use RegisterSecondaryKey instead.
+	BroadcastData(ctx context.Context, in *MsgBroadcastData, opts ...grpc.CallOption) (*MsgBroadcastDataResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterSecondaryKey(ctx context.Context, in *MsgRegisterSecondaryKey, opts ...grpc.CallOption) (*MsgRegisterSecondaryKeyResponse, error) {
+	out := new(MsgRegisterSecondaryKeyResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/RegisterSecondaryKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateSecondaryKey(ctx context.Context, in *MsgRotateSecondaryKey, opts ...grpc.CallOption) (*MsgRotateSecondaryKeyResponse, error) {
+	out := new(MsgRotateSecondaryKeyResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/RotateSecondaryKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSecondaryKey(ctx context.Context, in *MsgRevokeSecondaryKey, opts ...grpc.CallOption) (*MsgRevokeSecondaryKeyResponse, error) {
+	out := new(MsgRevokeSecondaryKeyResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/RevokeSecondaryKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSecondarySignatureRequired(ctx context.Context, in *MsgSetSecondarySignatureRequired, opts ...grpc.CallOption) (*MsgSetSecondarySignatureRequiredResponse, error) {
+	out := new(MsgSetSecondarySignatureRequiredResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/SetSecondarySignatureRequired", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddSecondaryKeySetMember(ctx context.Context, in *MsgAddSecondaryKeySetMember, opts ...grpc.CallOption) (*MsgAddSecondaryKeySetMemberResponse, error) {
+	out := new(MsgAddSecondaryKeySetMemberResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/AddSecondaryKeySetMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveSecondaryKeySetMember(ctx context.Context, in *MsgRemoveSecondaryKeySetMember, opts ...grpc.CallOption) (*MsgRemoveSecondaryKeySetMemberResponse, error) {
+	out := new(MsgRemoveSecondaryKeySetMemberResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/RemoveSecondaryKeySetMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RecoverAccount(ctx context.Context, in *MsgRecoverAccount, opts ...grpc.CallOption) (*MsgRecoverAccountResponse, error) {
+	out := new(MsgRecoverAccountResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/RecoverAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelRecovery(ctx context.Context, in *MsgCancelRecovery, opts ...grpc.CallOption) (*MsgCancelRecoveryResponse, error) {
+	out := new(MsgCancelRecoveryResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/CancelRecovery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterSessionKey(ctx context.Context, in *MsgRegisterSessionKey, opts ...grpc.CallOption) (*MsgRegisterSessionKeyResponse, error) {
+	out := new(MsgRegisterSessionKeyResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/RegisterSessionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeSessionKey(ctx context.Context, in *MsgRevokeSessionKey, opts ...grpc.CallOption) (*MsgRevokeSessionKeyResponse, error) {
+	out := new(MsgRevokeSessionKeyResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/RevokeSessionKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterValidatorSecondaryKey(ctx context.Context, in *MsgRegisterValidatorSecondaryKey, opts ...grpc.CallOption) (*MsgRegisterValidatorSecondaryKeyResponse, error) {
+	out := new(MsgRegisterValidatorSecondaryKeyResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/RegisterValidatorSecondaryKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) InjectAttestations(ctx context.Context, in *MsgInjectAttestations, opts ...grpc.CallOption) (*MsgInjectAttestationsResponse, error) {
+	out := new(MsgInjectAttestationsResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/InjectAttestations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// Deprecated: Do not use.
+func (c *msgClient) BroadcastData(ctx context.Context, in *MsgBroadcastData, opts ...grpc.CallOption) (*MsgBroadcastDataResponse, error) {
+	out := new(MsgBroadcastDataResponse)
+	err := c.cc.Invoke(ctx, "/example.secondarykeys.v1.Msg/BroadcastData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterSecondaryKey registers the sender's secondary public key.
+	RegisterSecondaryKey(context.Context, *MsgRegisterSecondaryKey) (*MsgRegisterSecondaryKeyResponse, error)
+	// RotateSecondaryKey replaces the sender's secondary public key. It must be
+	// authorised by both the current and the new secondary key. Key sets of
+	// several keys change through AddSecondaryKeySetMember and
+	// RemoveSecondaryKeySetMember instead.
+	RotateSecondaryKey(context.Context, *MsgRotateSecondaryKey) (*MsgRotateSecondaryKeyResponse, error)
+	// RevokeSecondaryKey removes the sender's secondary key set and tombstones
+	// its keys so they can never be registered again.
+	RevokeSecondaryKey(context.Context, *MsgRevokeSecondaryKey) (*MsgRevokeSecondaryKeyResponse, error)
+	// SetSecondarySignatureRequired makes every transaction of the sender
+	// require a valid secondary signature, or lifts that requirement.
+	SetSecondarySignatureRequired(context.Context, *MsgSetSecondarySignatureRequired) (*MsgSetSecondarySignatureRequiredResponse, error)
+	// AddSecondaryKeySetMember adds a key to the sender's secondary key set. It
+	// must be authorised by members reaching the current threshold and by the
+	// new key.
+	AddSecondaryKeySetMember(context.Context, *MsgAddSecondaryKeySetMember) (*MsgAddSecondaryKeySetMemberResponse, error)
+	// RemoveSecondaryKeySetMember removes a key from the sender's secondary key
+	// set. It must be authorised by members reaching the current threshold.
+	RemoveSecondaryKeySetMember(context.Context, *MsgRemoveSecondaryKeySetMember) (*MsgRemoveSecondaryKeySetMemberResponse, error)
+	// RecoverAccount starts replacing the primary public key of an account
+	// whose primary key is lost. It is authorised by the account's secondary
+	// key set alone, so any account can submit it, and takes effect once the
+	// recovery_delay param has passed.
+	RecoverAccount(context.Context, *MsgRecoverAccount) (*MsgRecoverAccountResponse, error)
+	// CancelRecovery cancels the pending recovery of the sender. It is signed
+	// by the sender's current primary key.
+	CancelRecovery(context.Context, *MsgCancelRecovery) (*MsgCancelRecoveryResponse, error)
+	// RegisterSessionKey registers a session key of the sender, scoped to some
+	// Msg types, with a spend limit and an expiry. It must be authorised by
+	// members of the sender's key set reaching its threshold and by the session
+	// key.
+	RegisterSessionKey(context.Context, *MsgRegisterSessionKey) (*MsgRegisterSessionKeyResponse, error)
+	// RevokeSessionKey removes a session key of the sender before it expires
+	// and tombstones it.
+	RevokeSessionKey(context.Context, *MsgRevokeSessionKey) (*MsgRevokeSessionKeyResponse, error)
+	// RegisterValidatorSecondaryKey registers the secondary public key a
+	// validator signs its vote extensions with. It is signed by the validator
+	// operator.
+	RegisterValidatorSecondaryKey(context.Context, *MsgRegisterValidatorSecondaryKey) (*MsgRegisterValidatorSecondaryKeyResponse, error)
+	// InjectAttestations carries the vote extension signatures PrepareProposal
+	// injects into a proposal. It is never broadcast: the app's TxDecoder decodes
+	// the injected transaction into an unsigned transaction with this message.
+	InjectAttestations(context.Context, *MsgInjectAttestations) (*MsgInjectAttestationsResponse, error)
+	// BroadcastData defines the BroadcastData RPC.
+	//
+	// Deprecated: use RegisterSecondaryKey instead.
+	BroadcastData(context.Context, *MsgBroadcastData) (*MsgBroadcastDataResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterSecondaryKey(ctx context.Context, req *MsgRegisterSecondaryKey) (*MsgRegisterSecondaryKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSecondaryKey not implemented")
+}
+func (*UnimplementedMsgServer) RotateSecondaryKey(ctx context.Context, req *MsgRotateSecondaryKey) (*MsgRotateSecondaryKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSecondaryKey not implemented")
+}
+func (*UnimplementedMsgServer) RevokeSecondaryKey(ctx context.Context, req *MsgRevokeSecondaryKey) (*MsgRevokeSecondaryKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSecondaryKey not implemented")
+}
+func (*UnimplementedMsgServer) SetSecondarySignatureRequired(ctx context.Context, req *MsgSetSecondarySignatureRequired) (*MsgSetSecondarySignatureRequiredResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSecondarySignatureRequired not implemented")
+}
+func (*UnimplementedMsgServer) AddSecondaryKeySetMember(ctx context.Context, req *MsgAddSecondaryKeySetMember) (*MsgAddSecondaryKeySetMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSecondaryKeySetMember not implemented")
+}
+func (*UnimplementedMsgServer) RemoveSecondaryKeySetMember(ctx context.Context, req *MsgRemoveSecondaryKeySetMember) (*MsgRemoveSecondaryKeySetMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSecondaryKeySetMember not implemented")
+}
+func (*UnimplementedMsgServer) RecoverAccount(ctx context.Context, req *MsgRecoverAccount) (*MsgRecoverAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverAccount not implemented")
+}
+func (*UnimplementedMsgServer) CancelRecovery(ctx context.Context, req *MsgCancelRecovery) (*MsgCancelRecoveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRecovery not implemented")
+}
+func (*UnimplementedMsgServer) RegisterSessionKey(ctx context.Context, req *MsgRegisterSessionKey) (*MsgRegisterSessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSessionKey not implemented")
+}
+func (*UnimplementedMsgServer) RevokeSessionKey(ctx context.Context, req *MsgRevokeSessionKey) (*MsgRevokeSessionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeSessionKey not implemented")
+}
+func (*UnimplementedMsgServer) RegisterValidatorSecondaryKey(ctx context.Context, req *MsgRegisterValidatorSecondaryKey) (*MsgRegisterValidatorSecondaryKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterValidatorSecondaryKey not implemented")
+}
+func (*UnimplementedMsgServer) InjectAttestations(ctx context.Context, req *MsgInjectAttestations) (*MsgInjectAttestationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InjectAttestations not implemented")
+}
+func (*UnimplementedMsgServer) BroadcastData(ctx context.Context, req *MsgBroadcastData) (*MsgBroadcastDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastData not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterSecondaryKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSecondaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSecondaryKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/RegisterSecondaryKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSecondaryKey(ctx, req.(*MsgRegisterSecondaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateSecondaryKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateSecondaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateSecondaryKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/RotateSecondaryKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateSecondaryKey(ctx, req.(*MsgRotateSecondaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSecondaryKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSecondaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSecondaryKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/RevokeSecondaryKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSecondaryKey(ctx, req.(*MsgRevokeSecondaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSecondarySignatureRequired_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSecondarySignatureRequired)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSecondarySignatureRequired(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/SetSecondarySignatureRequired",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSecondarySignatureRequired(ctx, req.(*MsgSetSecondarySignatureRequired))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddSecondaryKeySetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddSecondaryKeySetMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddSecondaryKeySetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/AddSecondaryKeySetMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddSecondaryKeySetMember(ctx, req.(*MsgAddSecondaryKeySetMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveSecondaryKeySetMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSecondaryKeySetMember)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveSecondaryKeySetMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/RemoveSecondaryKeySetMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSecondaryKeySetMember(ctx, req.(*MsgRemoveSecondaryKeySetMember))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RecoverAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRecoverAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RecoverAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/RecoverAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RecoverAccount(ctx, req.(*MsgRecoverAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelRecovery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRecovery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRecovery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/CancelRecovery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRecovery(ctx, req.(*MsgCancelRecovery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSessionKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterSessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/RegisterSessionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterSessionKey(ctx, req.(*MsgRegisterSessionKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeSessionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeSessionKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeSessionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/RevokeSessionKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeSessionKey(ctx, req.(*MsgRevokeSessionKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterValidatorSecondaryKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterValidatorSecondaryKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterValidatorSecondaryKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/RegisterValidatorSecondaryKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterValidatorSecondaryKey(ctx, req.(*MsgRegisterValidatorSecondaryKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_InjectAttestations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgInjectAttestations)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).InjectAttestations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/InjectAttestations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).InjectAttestations(ctx, req.(*MsgInjectAttestations))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BroadcastData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBroadcastData)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BroadcastData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/example.secondarykeys.v1.Msg/BroadcastData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BroadcastData(ctx, req.(*MsgBroadcastData))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "example.secondarykeys.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterSecondaryKey",
+			Handler:    _Msg_RegisterSecondaryKey_Handler,
+		},
+		{
+			MethodName: "RotateSecondaryKey",
+			Handler:    _Msg_RotateSecondaryKey_Handler,
+		},
+		{
+			MethodName: "RevokeSecondaryKey",
+			Handler:    _Msg_RevokeSecondaryKey_Handler,
+		},
+		{
+			MethodName: "SetSecondarySignatureRequired",
+			Handler:    _Msg_SetSecondarySignatureRequired_Handler,
+		},
+		{
+			MethodName: "AddSecondaryKeySetMember",
+			Handler:    _Msg_AddSecondaryKeySetMember_Handler,
+		},
+		{
+			MethodName: "RemoveSecondaryKeySetMember",
+			Handler:    _Msg_RemoveSecondaryKeySetMember_Handler,
+		},
+		{
+			MethodName: "RecoverAccount",
+			Handler:    _Msg_RecoverAccount_Handler,
+		},
+		{
+			MethodName: "CancelRecovery",
+			Handler:    _Msg_CancelRecovery_Handler,
+		},
+		{
+			MethodName: "RegisterSessionKey",
+			Handler:    _Msg_RegisterSessionKey_Handler,
+		},
+		{
+			MethodName: "RevokeSessionKey",
+			Handler:    _Msg_RevokeSessionKey_Handler,
+		},
+		{
+			MethodName: "RegisterValidatorSecondaryKey",
+			Handler:    _Msg_RegisterValidatorSecondaryKey_Handler,
+		},
+		{
+			MethodName: "InjectAttestations",
+			Handler:    _Msg_InjectAttestations_Handler,
+		},
+		{
+			MethodName: "BroadcastData",
+			Handler:    _Msg_BroadcastData_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "example/secondarykeys/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSecondaryKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSecondaryKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSecondaryKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSecondaryKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSecondaryKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSecondaryKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateSecondaryKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateSecondaryKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateSecondaryKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewKeySignature) > 0 {
+		i -= len(m.NewKeySignature)
+		copy(dAtA[i:], m.NewKeySignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewKeySignature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CurrentKeySignature) > 0 {
+		i -= len(m.CurrentKeySignature)
+		copy(dAtA[i:], m.CurrentKeySignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CurrentKeySignature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewPublicKey) > 0 {
+		i -= len(m.NewPublicKey)
+		copy(dAtA[i:], m.NewPublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewPublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateSecondaryKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateSecondaryKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateSecondaryKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSecondaryKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSecondaryKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSecondaryKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Lockdown {
+		i--
+		if m.Lockdown {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSecondaryKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSecondaryKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSecondaryKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSecondarySignatureRequired) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSecondarySignatureRequired) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSecondarySignatureRequired) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Required {
+		i--
+		if m.Required {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSecondarySignatureRequiredResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSecondarySignatureRequiredResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSecondarySignatureRequiredResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSecondaryKeySetMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSecondaryKeySetMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSecondaryKeySetMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.NewKeySignature) > 0 {
+		i -= len(m.NewKeySignature)
+		copy(dAtA[i:], m.NewKeySignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewKeySignature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Weight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddSecondaryKeySetMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddSecondaryKeySetMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddSecondaryKeySetMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSecondaryKeySetMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSecondaryKeySetMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSecondaryKeySetMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveSecondaryKeySetMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveSecondaryKeySetMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveSecondaryKeySetMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.NewPublicKey != nil {
+		{
+			size, err := m.NewPublicKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Submitter) > 0 {
+		i -= len(m.Submitter)
+		copy(dAtA[i:], m.Submitter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Submitter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRecoverAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRecoverAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRecoverAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExecuteTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecovery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecovery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecovery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRecoveryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRecoveryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRecoveryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signatures) > 0 {
+		for iNdEx := len(m.Signatures) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Signatures[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.SessionKeySignature) > 0 {
+		i -= len(m.SessionKeySignature)
+		copy(dAtA[i:], m.SessionKeySignature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SessionKeySignature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpiryTime != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for iNdEx := len(m.MsgTypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MsgTypeUrls[iNdEx])
+			copy(dAtA[i:], m.MsgTypeUrls[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSessionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSessionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSessionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeSessionKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeSessionKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeSessionKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterValidatorSecondaryKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterValidatorSecondaryKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterValidatorSecondaryKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PublicKey) > 0 {
+		i -= len(m.PublicKey)
+		copy(dAtA[i:], m.PublicKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PublicKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterValidatorSecondaryKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterValidatorSecondaryKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterValidatorSecondaryKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInjectAttestations) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInjectAttestations) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInjectAttestations) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.InjectedTx.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgInjectAttestationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgInjectAttestationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgInjectAttestationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBroadcastData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBroadcastData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBroadcastData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBroadcastDataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBroadcastDataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBroadcastDataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterSecondaryKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterSecondaryKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRotateSecondaryKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
+	l = len(m.NewPublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CurrentKeySignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewKeySignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRotateSecondaryKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeSecondaryKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Lockdown {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRevokeSecondaryKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSecondarySignatureRequired) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Required {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetSecondarySignatureRequiredResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddSecondaryKeySetMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovTx(uint64(m.Weight))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	l = len(m.NewKeySignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAddSecondaryKeySetMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSecondaryKeySetMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRemoveSecondaryKeySetMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRecoverAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Submitter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewPublicKey != nil {
+		l = m.NewPublicKey.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRecoverAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExecuteTime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelRecovery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRecoveryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.MsgTypeUrls) > 0 {
+		for _, s := range m.MsgTypeUrls {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SessionKeySignature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signatures) > 0 {
+		for _, e := range m.Signatures {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRegisterSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeSessionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeSessionKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterValidatorSecondaryKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
+	l = len(m.PublicKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterValidatorSecondaryKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgInjectAttestations) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.InjectedTx.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgInjectAttestationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBroadcastData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBroadcastDataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSecondaryKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSecondaryKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSecondaryKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSecondaryKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSecondaryKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSecondaryKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateSecondaryKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateSecondaryKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateSecondaryKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPublicKey = append(m.NewPublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewPublicKey == nil {
+				m.NewPublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentKeySignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentKeySignature = append(m.CurrentKeySignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.CurrentKeySignature == nil {
+				m.CurrentKeySignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKeySignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewKeySignature = append(m.NewKeySignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewKeySignature == nil {
+				m.NewKeySignature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateSecondaryKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateSecondaryKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateSecondaryKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSecondaryKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSecondaryKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSecondaryKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lockdown", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Lockdown = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSecondaryKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSecondaryKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSecondaryKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSecondarySignatureRequired) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSecondarySignatureRequired: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSecondarySignatureRequired: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Required", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Required = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetSecondarySignatureRequiredResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetSecondarySignatureRequiredResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetSecondarySignatureRequiredResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSecondaryKeySetMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSecondaryKeySetMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSecondaryKeySetMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewKeySignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewKeySignature = append(m.NewKeySignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewKeySignature == nil {
+				m.NewKeySignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, SecondaryKeySignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddSecondaryKeySetMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSecondaryKeySetMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSecondaryKeySetMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSecondaryKeySetMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSecondaryKeySetMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSecondaryKeySetMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, SecondaryKeySignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSecondaryKeySetMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSecondaryKeySetMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSecondaryKeySetMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Submitter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Submitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPublicKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewPublicKey == nil {
+				m.NewPublicKey = &any.Any{}
+			}
+			if err := m.NewPublicKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, SecondaryKeySignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRecoverAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRecoverAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRecoverAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecuteTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExecuteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRecovery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecovery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecovery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRecoveryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRecoveryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRecoveryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrls = append(m.MsgTypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SessionKeySignature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SessionKeySignature = append(m.SessionKeySignature[:0], dAtA[iNdEx:postIndex]...)
+			if m.SessionKeySignature == nil {
+				m.SessionKeySignature = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, SecondaryKeySignature{})
+			if err := m.Signatures[len(m.Signatures)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSessionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSessionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSessionKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSessionKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSessionKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeSessionKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeSessionKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeSessionKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterValidatorSecondaryKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterValidatorSecondaryKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterValidatorSecondaryKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = append(m.PublicKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PublicKey == nil {
+				m.PublicKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgBroadcastData) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRegisterValidatorSecondaryKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterValidatorSecondaryKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterValidatorSecondaryKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgBroadcastDataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgInjectAttestations) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -592,10 +6486,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInjectAttestations: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInjectAttestations: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -632,7 +6526,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InjectedTx", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -659,7 +6553,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.InjectedTx.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -684,7 +6578,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgInjectAttestationsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -707,10 +6601,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgInjectAttestationsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgInjectAttestationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
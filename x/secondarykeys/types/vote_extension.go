@@ -0,0 +1,159 @@
+package types
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// VoteExtensionVersion is the version of the vote extension and injected
+// transaction encodings. It follows the magic prefix and is bumped when the
+// messages change incompatibly.
+const VoteExtensionVersion byte = 1
+
+const (
+	// MaxVoteExtensionSize is the maximum size of an encoded vote extension.
+	MaxVoteExtensionSize = 256
+
+	// MaxInjectedTxSize is the maximum size of an encoded injected
+	// transaction. It holds the extended commit of the whole validator set.
+	MaxInjectedTxSize = 1 << 20
+
+	// InjectedTxGasLimit is the gas limit of the injected transaction. Its
+	// message does not touch the module's store; only the message router
+	// reads the circuit breaker. Proposals reserve it in the block gas limit.
+	InjectedTxGasLimit = 100_000
+)
+
+var (
+	// VoteExtensionMagic prefixes an encoded VoteExtension.
+	VoteExtensionMagic = []byte{0x00, 's', 'k', 'v'}
+
+	// InjectedTxMagic prefixes an encoded InjectedVoteExtensionTx. A protobuf
+	// message cannot start with a zero byte, so the injected transaction is
+	// never mistaken for a regular one and vice versa.
+	InjectedTxMagic = []byte{0x00, 's', 'k', 't'}
+)
+
+// InjectedTxAuthority is the authority of MsgInjectAttestations: the module
+// account, whose key no one holds.
+var InjectedTxAuthority = authtypes.NewModuleAddress(ModuleName)
+
+// ValidateBlockMaxGas returns an error if the block gas limit maxGas leaves no
+// room for the injected transaction. A limit of 0 or -1 is unlimited.
+func ValidateBlockMaxGas(maxGas int64) error {
+	if maxGas > 0 && maxGas <= InjectedTxGasLimit {
+		return errorsmod.Wrapf(ErrInvalidParams, "block gas limit %d leaves no room for the %d gas of the signature tx", maxGas, InjectedTxGasLimit)
+	}
+	return nil
+}
+
+// EncodeVoteExtension encodes ext for ExtendVote.
+func EncodeVoteExtension(ext *VoteExtension) ([]byte, error) {
+	return encodeVersioned(VoteExtensionMagic, ext, MaxVoteExtensionSize)
+}
+
+// DecodeVoteExtension decodes a vote extension encoded by EncodeVoteExtension.
+func DecodeVoteExtension(bz []byte) (VoteExtension, error) {
+	var ext VoteExtension
+	err := decodeVersioned(VoteExtensionMagic, bz, &ext, MaxVoteExtensionSize)
+	return ext, err
+}
+
+// IsInjectedTx reports whether tx is an injected vote extension transaction,
+// which may still fail to decode.
+func IsInjectedTx(tx []byte) bool {
+	return bytes.HasPrefix(tx, InjectedTxMagic)
+}
+
+// EncodeInjectedVoteExtensionTx encodes tx for PrepareProposal.
+func EncodeInjectedVoteExtensionTx(tx *InjectedVoteExtensionTx) ([]byte, error) {
+	return encodeVersioned(InjectedTxMagic, tx, MaxInjectedTxSize)
+}
+
+// DecodeInjectedVoteExtensionTx decodes a transaction encoded by
+// EncodeInjectedVoteExtensionTx.
+func DecodeInjectedVoteExtensionTx(bz []byte) (InjectedVoteExtensionTx, error) {
+	var tx InjectedVoteExtensionTx
+	err := decodeVersioned(InjectedTxMagic, bz, &tx, MaxInjectedTxSize)
+	return tx, err
+}
+
+func encodeVersioned(magic []byte, msg proto.Message, maxSize int) ([]byte, error) {
+	bz, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	size := len(magic) + 1 + len(bz)
+	if size > maxSize {
+		return nil, errorsmod.Wrapf(ErrInvalidVoteExtension, "size %d exceeds %d", size, maxSize)
+	}
+
+	out := make([]byte, 0, size)
+	out = append(out, magic...)
+	out = append(out, VoteExtensionVersion)
+	return append(out, bz...), nil
+}
+
+// decodeVersioned checks the size, magic prefix and version of bz before
+// decoding it into msg. The encoding must be canonical, so a message with
+// unknown fields or several encodings is rejected.
+func decodeVersioned(magic, bz []byte, msg proto.Message, maxSize int) error {
+	if len(bz) > maxSize {
+		return errorsmod.Wrapf(ErrInvalidVoteExtension, "size %d exceeds %d", len(bz), maxSize)
+	}
+	if !bytes.HasPrefix(bz, magic) || len(bz) == len(magic) {
+		return errorsmod.Wrap(ErrInvalidVoteExtension, "missing magic prefix")
+	}
+	if version := bz[len(magic)]; version != VoteExtensionVersion {
+		return errorsmod.Wrapf(ErrInvalidVoteExtension, "unsupported version %d", version)
+	}
+
+	payload := bz[len(magic)+1:]
+	if err := proto.Unmarshal(payload, msg); err != nil {
+		return errorsmod.Wrap(ErrInvalidVoteExtension, err.Error())
+	}
+	canonical, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(canonical, payload) {
+		return errorsmod.Wrap(ErrInvalidVoteExtension, "non-canonical encoding")
+	}
+	return nil
+}
+
+// NewSignerBitmap returns the signers bitmap of an AggregateSignature over n
+// votes with the bits at indexes set.
+func NewSignerBitmap(n int, indexes []int) []byte {
+	bitmap := make([]byte, (n+7)/8)
+	for _, i := range indexes {
+		bitmap[i/8] |= 1 << (i % 8)
+	}
+	return bitmap
+}
+
+// SignerIndexes returns the indexes of the votes set in the signers bitmap of
+// an AggregateSignature over n votes. The bitmap must be exactly long enough
+// for n votes, with no bit set beyond them, and name at least one signer.
+func SignerIndexes(bitmap []byte, n int) ([]int, error) {
+	if len(bitmap) != (n+7)/8 {
+		return nil, errorsmod.Wrapf(ErrInvalidVoteExtension, "signer bitmap of %d bytes for %d votes", len(bitmap), n)
+	}
+	var indexes []int
+	for i := 0; i < len(bitmap)*8; i++ {
+		if bitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if i >= n {
+			return nil, errorsmod.Wrapf(ErrInvalidVoteExtension, "signer bitmap sets bit %d beyond %d votes", i, n)
+		}
+		indexes = append(indexes, i)
+	}
+	if len(indexes) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidVoteExtension, "signer bitmap names no signers")
+	}
+	return indexes, nil
+}
@@ -0,0 +1,104 @@
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"example/x/secondarykeys/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeVoteExtension(t *testing.T) {
+	ext := types.VoteExtension{Signature: bytes.Repeat([]byte{1}, 65)}
+	bz, err := types.EncodeVoteExtension(&ext)
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(bz, types.VoteExtensionMagic))
+
+	withVersion := func(version byte) []byte {
+		out := append([]byte{}, bz...)
+		out[len(types.VoteExtensionMagic)] = version
+		return out
+	}
+
+	tests := []struct {
+		desc  string
+		bz    []byte
+		valid bool
+	}{
+		{desc: "valid", bz: bz, valid: true},
+		{desc: "empty", bz: nil},
+		{desc: "json", bz: []byte(`{"Signature":"AQ=="}`)},
+		{desc: "injected tx magic", bz: append(append([]byte{}, types.InjectedTxMagic...), bz[len(types.VoteExtensionMagic):]...)},
+		{desc: "magic without version", bz: types.VoteExtensionMagic},
+		{desc: "unsupported version", bz: withVersion(types.VoteExtensionVersion + 1)},
+		{desc: "truncated", bz: bz[:len(bz)-1]},
+		{desc: "unknown field", bz: append(append([]byte{}, bz...), 0x10, 0x01)},
+		{desc: "too large", bz: append(append([]byte{}, bz...), make([]byte, types.MaxVoteExtensionSize)...)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			decoded, err := types.DecodeVoteExtension(tc.bz)
+			if tc.valid {
+				require.NoError(t, err)
+				require.Equal(t, ext, decoded)
+			} else {
+				require.ErrorIs(t, err, types.ErrInvalidVoteExtension)
+			}
+		})
+	}
+
+	_, err = types.EncodeVoteExtension(&types.VoteExtension{Signature: make([]byte, types.MaxVoteExtensionSize)})
+	require.ErrorIs(t, err, types.ErrInvalidVoteExtension)
+}
+
+func TestDecodeInjectedVoteExtensionTx(t *testing.T) {
+	tx := types.InjectedVoteExtensionTx{
+		ValidatorSignatures: []types.VoteExtensionSignature{{ValidatorAddress: []byte{1}, Signature: []byte{2}}},
+		ExtendedCommitInfo: abci.ExtendedCommitInfo{
+			Round: 1,
+			Votes: []abci.ExtendedVoteInfo{{Validator: abci.Validator{Address: []byte{1}, Power: 10}, VoteExtension: []byte{3}}},
+		},
+	}
+	bz, err := types.EncodeInjectedVoteExtensionTx(&tx)
+	require.NoError(t, err)
+	require.True(t, types.IsInjectedTx(bz))
+
+	decoded, err := types.DecodeInjectedVoteExtensionTx(bz)
+	require.NoError(t, err)
+	require.Equal(t, tx, decoded)
+
+	// regular txs are protobuf encoded and never start with the magic prefix
+	require.False(t, types.IsInjectedTx([]byte{0x0a, 0x00}))
+	require.False(t, types.IsInjectedTx([]byte(`{"validator_signatures":[]}`)))
+
+	_, err = types.DecodeInjectedVoteExtensionTx(append(bz, make([]byte, types.MaxInjectedTxSize)...))
+	require.ErrorIs(t, err, types.ErrInvalidVoteExtension)
+}
+
+func TestSignerBitmap(t *testing.T) {
+	bitmap := types.NewSignerBitmap(10, []int{0, 3, 9})
+	require.Equal(t, []byte{0b00001001, 0b00000010}, bitmap)
+
+	indexes, err := types.SignerIndexes(bitmap, 10)
+	require.NoError(t, err)
+	require.Equal(t, []int{0, 3, 9}, indexes)
+
+	tests := []struct {
+		desc   string
+		bitmap []byte
+		n      int
+	}{
+		{desc: "too short", bitmap: []byte{1}, n: 10},
+		{desc: "too long", bitmap: []byte{1, 0}, n: 8},
+		{desc: "bit beyond the votes", bitmap: []byte{0b00000100}, n: 2},
+		{desc: "no signers", bitmap: []byte{0, 0}, n: 10},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := types.SignerIndexes(tc.bitmap, tc.n)
+			require.ErrorIs(t, err, types.ErrInvalidVoteExtension)
+		})
+	}
+}
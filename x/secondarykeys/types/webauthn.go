@@ -0,0 +1,110 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// WebAuthnTypeGet is the client data type of an assertion.
+	WebAuthnTypeGet = "webauthn.get"
+
+	// WebAuthnFlagUserPresent is the user present (UP) flag of the
+	// authenticator data.
+	WebAuthnFlagUserPresent byte = 0x01
+	// WebAuthnFlagUserVerified is the user verified (UV) flag of the
+	// authenticator data.
+	WebAuthnFlagUserVerified byte = 0x04
+
+	// webAuthnAuthDataMinSize is the size of the rpIdHash, flags and
+	// signCount the authenticator data starts with.
+	webAuthnAuthDataMinSize = sha256.Size + 1 + 4
+)
+
+// webAuthnClientData holds the members of the client data JSON the module
+// checks.
+type webAuthnClientData struct {
+	Type      string `json:"type"`
+	Challenge string `json:"challenge"`
+}
+
+// WebAuthnRPIDHash returns the hash of the relying party ID rpID that the
+// authenticator data starts with.
+func WebAuthnRPIDHash(rpID string) []byte {
+	hash := sha256.Sum256([]byte(rpID))
+	return hash[:]
+}
+
+// WebAuthnSignedBytes returns the bytes an authenticator signs for an
+// assertion: the authenticator data followed by the hash of the client data
+// JSON.
+func WebAuthnSignedBytes(authenticatorData, clientDataJSON []byte) []byte {
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	signed := make([]byte, 0, len(authenticatorData)+len(clientDataHash))
+	signed = append(signed, authenticatorData...)
+	return append(signed, clientDataHash[:]...)
+}
+
+// VerifyWebAuthnAssertion verifies that sig, an encoded WebAuthnAssertion, is
+// an assertion of the P-256 key pubKey whose challenge is the 32 byte digest
+// hash, made for one of the relying party IDs rpIDs with the user present.
+//
+// The origin of the client data is not checked: the relying party ID already
+// binds the credential to its site, and the chain cannot tell the origins
+// serving it. The signature counter is not checked either, since passkeys
+// synced between devices report zero. Unlike KEY_TYPE_SECP256R1 signatures,
+// high s values are accepted because authenticators do not normalise them;
+// the assertion is covered by the transaction's primary signature.
+func VerifyWebAuthnAssertion(pubKey, hash, sig []byte, rpIDs []string) error {
+	var assertion WebAuthnAssertion
+	if err := assertion.Unmarshal(sig); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSecondarySignature, "invalid webauthn assertion: %s", err)
+	}
+
+	authData := assertion.AuthenticatorData
+	if len(authData) < webAuthnAuthDataMinSize {
+		return errorsmod.Wrapf(ErrInvalidSecondarySignature, "authenticator data too short: %d bytes", len(authData))
+	}
+	if !webAuthnRPIDAllowed(authData[:sha256.Size], rpIDs) {
+		return errorsmod.Wrap(ErrInvalidSecondarySignature, "relying party ID not allowed")
+	}
+	if authData[sha256.Size]&WebAuthnFlagUserPresent == 0 {
+		return errorsmod.Wrap(ErrInvalidSecondarySignature, "user not present")
+	}
+
+	var clientData webAuthnClientData
+	if err := json.Unmarshal(assertion.ClientDataJson, &clientData); err != nil {
+		return errorsmod.Wrapf(ErrInvalidSecondarySignature, "invalid client data: %s", err)
+	}
+	if clientData.Type != WebAuthnTypeGet {
+		return errorsmod.Wrapf(ErrInvalidSecondarySignature, "client data type %q, expected %q", clientData.Type, WebAuthnTypeGet)
+	}
+	challenge, err := base64.RawURLEncoding.DecodeString(clientData.Challenge)
+	if err != nil || !bytes.Equal(challenge, hash) {
+		return errorsmod.Wrap(ErrInvalidSecondarySignature, "challenge does not match the signed digest")
+	}
+
+	key, ok := secp256r1PubKey(pubKey)
+	if !ok {
+		return errorsmod.Wrap(ErrInvalidPublicKey, "invalid P-256 point")
+	}
+	digest := sha256.Sum256(WebAuthnSignedBytes(authData, assertion.ClientDataJson))
+	if !ecdsa.VerifyASN1(key, digest[:], assertion.Signature) {
+		return errorsmod.Wrap(ErrInvalidSecondarySignature, "webauthn signature verification failed")
+	}
+	return nil
+}
+
+func webAuthnRPIDAllowed(rpIDHash []byte, rpIDs []string) bool {
+	for _, rpID := range rpIDs {
+		if bytes.Equal(rpIDHash, WebAuthnRPIDHash(rpID)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,201 @@
+package types_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"example/common"
+	"example/x/secondarykeys/types"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// A WebAuthn assertion for the relying party ID example.com, signed by the
+// software authenticator of common with the key derived from
+// Keccak256("webauthn"), over the challenge Keccak256("message").
+const (
+	webAuthnVectorPubKey     = "0229f0a6dbae7696dab81abe4c4f45d2e021160a5be4558caace28fb98fee29b67"
+	webAuthnVectorChallenge  = "c2baf6c66618acd49fb133cebc22f55bd907fe9f0d69a726d45b7539ba6bbe08"
+	webAuthnVectorAuthData   = "a379a6f6eeafb9a55e378c118034e2751e682fab9f2d30ab13d2125586ce19470500000001"
+	webAuthnVectorClientData = `{"type":"webauthn.get","challenge":"wrr2xmYYrNSfsTPOvCL1W9kH_p8Naacm1Ft1Obprvgg","origin":"https://example.com","crossOrigin":false}`
+	webAuthnVectorSignature  = "30450221008ec3e335b4ad59a9a9e276e42be805bfff75fcf9eb11fa11b5393530389af07d0220439603bc0dff549334fe8c63eec5e51fa83c5395c40d5f04ae86ef92479705c2"
+)
+
+func mustDecodeHex(t *testing.T, s string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(s)
+	require.NoError(t, err)
+	return b
+}
+
+func TestWebAuthnAssertionVector(t *testing.T) {
+	pubKey := mustDecodeHex(t, webAuthnVectorPubKey)
+	challenge := mustDecodeHex(t, webAuthnVectorChallenge)
+	assertion := types.WebAuthnAssertion{
+		AuthenticatorData: mustDecodeHex(t, webAuthnVectorAuthData),
+		ClientDataJson:    []byte(webAuthnVectorClientData),
+		Signature:         mustDecodeHex(t, webAuthnVectorSignature),
+	}
+	sig, err := assertion.Marshal()
+	require.NoError(t, err)
+
+	require.NoError(t, types.ValidateAccountPublicKey(types.KeyType_KEY_TYPE_WEBAUTHN, pubKey))
+	secondaryPubKey := types.NewSecondaryPubKey(types.KeyType_KEY_TYPE_WEBAUTHN, pubKey)
+	require.NoError(t, secondaryPubKey.VerifySignature(challenge, sig, []string{"example.org", "example.com"}))
+	require.ErrorContains(t, secondaryPubKey.VerifySignature(challenge, sig, []string{"example.org"}), "relying party ID not allowed")
+	require.ErrorContains(t, secondaryPubKey.VerifySignature(challenge, sig, nil), "relying party ID not allowed")
+	require.ErrorContains(t, secondaryPubKey.VerifySignature(crypto.Keccak256([]byte("other")), sig, []string{"example.com"}), "challenge does not match")
+
+	// the derived key of the software authenticator is the key of the vector
+	priv, err := common.WebAuthnPrivKeyFromSeed(crypto.Keccak256([]byte("webauthn")), "example.com", "https://example.com")
+	require.NoError(t, err)
+	require.Equal(t, pubKey, priv.PubKey())
+
+	// assertions are not plain P-256 signatures
+	require.False(t, types.VerifySignature(types.KeyType_KEY_TYPE_WEBAUTHN, pubKey, challenge, sig))
+}
+
+func TestVerifyWebAuthnAssertion(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	pubKey := elliptic.MarshalCompressed(elliptic.P256(), priv.X, priv.Y)
+	hash := crypto.Keccak256([]byte("message"))
+	rpIDs := []string{"example.com"}
+
+	authData := func(rpID string, flags byte) []byte {
+		return append(types.WebAuthnRPIDHash(rpID), flags, 0, 0, 0, 7)
+	}
+	clientData := func(typ string, challenge []byte) []byte {
+		return []byte(fmt.Sprintf(`{"type":%q,"challenge":%q,"origin":"https://example.com"}`, typ, base64.RawURLEncoding.EncodeToString(challenge)))
+	}
+	sign := func(authData, clientDataJSON []byte) types.WebAuthnAssertion {
+		digest := sha256.Sum256(types.WebAuthnSignedBytes(authData, clientDataJSON))
+		signature, err := ecdsa.SignASN1(rand.Reader, priv, digest[:])
+		require.NoError(t, err)
+		return types.WebAuthnAssertion{AuthenticatorData: authData, ClientDataJson: clientDataJSON, Signature: signature}
+	}
+	valid := sign(authData("example.com", types.WebAuthnFlagUserPresent), clientData(types.WebAuthnTypeGet, hash))
+
+	tests := []struct {
+		name      string
+		assertion func() types.WebAuthnAssertion
+		expErrMsg string
+	}{
+		{
+			name:      "valid",
+			assertion: func() types.WebAuthnAssertion { return valid },
+		},
+		{
+			name: "high s",
+			assertion: func() types.WebAuthnAssertion {
+				var sig struct{ R, S *big.Int }
+				_, err := asn1.Unmarshal(valid.Signature, &sig)
+				require.NoError(t, err)
+				sig.S.Sub(elliptic.P256().Params().N, sig.S)
+				highS := valid
+				highS.Signature, err = asn1.Marshal(sig)
+				require.NoError(t, err)
+				return highS
+			},
+		},
+		{
+			name: "wrong challenge",
+			assertion: func() types.WebAuthnAssertion {
+				return sign(authData("example.com", types.WebAuthnFlagUserPresent), clientData(types.WebAuthnTypeGet, crypto.Keccak256([]byte("other"))))
+			},
+			expErrMsg: "challenge does not match",
+		},
+		{
+			name: "relying party ID not allowed",
+			assertion: func() types.WebAuthnAssertion {
+				return sign(authData("evil.com", types.WebAuthnFlagUserPresent), clientData(types.WebAuthnTypeGet, hash))
+			},
+			expErrMsg: "relying party ID not allowed",
+		},
+		{
+			name: "user not present",
+			assertion: func() types.WebAuthnAssertion {
+				return sign(authData("example.com", types.WebAuthnFlagUserVerified), clientData(types.WebAuthnTypeGet, hash))
+			},
+			expErrMsg: "user not present",
+		},
+		{
+			name: "registration client data",
+			assertion: func() types.WebAuthnAssertion {
+				return sign(authData("example.com", types.WebAuthnFlagUserPresent), clientData("webauthn.create", hash))
+			},
+			expErrMsg: "client data type",
+		},
+		{
+			name: "tampered client data",
+			assertion: func() types.WebAuthnAssertion {
+				tampered := valid
+				tampered.ClientDataJson = append(append([]byte{}, valid.ClientDataJson...), ' ')
+				return tampered
+			},
+			expErrMsg: "signature verification failed",
+		},
+		{
+			name: "short authenticator data",
+			assertion: func() types.WebAuthnAssertion {
+				return sign(types.WebAuthnRPIDHash("example.com"), clientData(types.WebAuthnTypeGet, hash))
+			},
+			expErrMsg: "authenticator data too short",
+		},
+		{
+			name: "invalid client data",
+			assertion: func() types.WebAuthnAssertion {
+				return sign(authData("example.com", types.WebAuthnFlagUserPresent), []byte("{"))
+			},
+			expErrMsg: "invalid client data",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertion := tc.assertion()
+			sig, err := assertion.Marshal()
+			require.NoError(t, err)
+
+			err = types.VerifyWebAuthnAssertion(pubKey, hash, sig, rpIDs)
+			if tc.expErrMsg != "" {
+				require.ErrorIs(t, err, types.ErrInvalidSecondarySignature)
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+
+	require.ErrorContains(t, types.VerifyWebAuthnAssertion(pubKey, hash, []byte{0xff}, rpIDs), "invalid webauthn assertion")
+}
+
+func TestWebAuthnPrivKey(t *testing.T) {
+	_, err := common.WebAuthnPrivKeyFromSeed(make([]byte, 31), "example.com", "https://example.com")
+	require.Error(t, err)
+
+	authenticator, err := common.WebAuthnPrivKeyFromSeed(crypto.Keccak256([]byte("passkey")), "example.com", "https://example.com")
+	require.NoError(t, err)
+	require.Equal(t, types.KeyType_KEY_TYPE_WEBAUTHN, authenticator.KeyType())
+
+	hash := crypto.Keccak256([]byte("message"))
+	pubKey := types.NewSecondaryPubKey(types.KeyType_KEY_TYPE_WEBAUTHN, authenticator.PubKey())
+	for i := 0; i < 2; i++ {
+		sig, err := authenticator.Sign(hash)
+		require.NoError(t, err)
+		require.NoError(t, pubKey.VerifySignature(hash, sig, []string{"example.com"}))
+
+		// the signature counter of the authenticator data counts up
+		var assertion types.WebAuthnAssertion
+		require.NoError(t, assertion.Unmarshal(sig))
+		require.Equal(t, []byte{0, 0, 0, byte(i + 1)}, assertion.AuthenticatorData[33:37])
+	}
+}